@@ -21,13 +21,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/pause"
 	"k8s.io/minikube/pkg/minikube/reason"
 )
 
@@ -36,10 +39,15 @@ var (
 	done            = make(chan struct{})
 	mu              sync.Mutex
 	runtimePaused   bool
-	version         = "0.0.1"
-
-	runtime  = flag.String("container-runtime", "docker", "Container runtime to use for (un)pausing")
-	interval = flag.Duration("interval", time.Minute*1, "Interval of inactivity for pause to occur")
+	state           pause.AutoPauseStatus
+	version         = "0.0.2"
+
+	runtime            = flag.String("container-runtime", "docker", "Container runtime to use for (un)pausing")
+	interval           = flag.Duration("interval", time.Minute*1, "Interval of inactivity for pause to occur")
+	listenAddress      = flag.String("listen-address", fmt.Sprintf("0.0.0.0:%d", constants.AutoPausePort), "Address to serve unpause requests, /status and /metrics on")
+	namespaces         = flag.String("namespaces", "kube-system", "Comma separated list of namespaces to pause")
+	excludedNamespaces = flag.String("excluded-namespaces", "kube-system", "Comma separated list of namespaces whose containers are not considered workloads when measuring CPU load")
+	cpuThreshold       = flag.Float64("cpu-threshold", 0, "Workload CPU usage, in percent of one core, above which the cluster is considered active. 0 disables the check")
 )
 
 func main() {
@@ -55,11 +63,15 @@ func main() {
 		for {
 			select {
 			case <-tickerChannel.C:
+				if workloadBusy() {
+					continue
+				}
 				tickerChannel.Stop()
 				runPause()
 			case <-unpauseRequests:
 				tickerChannel.Stop()
 				log.Println("Got request")
+				recordActivity()
 				runUnpause()
 				tickerChannel.Reset(*interval)
 
@@ -68,9 +80,11 @@ func main() {
 		}
 	}()
 
+	http.HandleFunc("/status", statusHandler)
+	http.HandleFunc("/metrics", metricsHandler)
 	http.HandleFunc("/", handler) // each request calls handler
-	fmt.Printf("Starting auto-pause server %s at port 8080 \n", version)
-	log.Fatal(http.ListenAndServe("0.0.0.0:8080", nil))
+	fmt.Printf("Starting auto-pause server %s at %s \n", version, *listenAddress)
+	log.Fatal(http.ListenAndServe(*listenAddress, nil))
 }
 
 // handler echoes the Path component of the requested URL.
@@ -80,6 +94,33 @@ func handler(w http.ResponseWriter, _ *http.Request) {
 	fmt.Fprint(w, "allow")
 }
 
+// newRuntime returns the container runtime manager of the node the daemon runs on
+func newRuntime() (cruntime.Manager, command.Runner) {
+	r := command.NewExecRunner(true)
+	cr, err := cruntime.New(cruntime.Config{Type: *runtime, Runner: r})
+	if err != nil {
+		exit.Error(reason.InternalNewRuntime, "Failed runtime", err)
+	}
+	return cr, r
+}
+
+// splitNamespaces parses a comma separated namespace flag
+func splitNamespaces(s string) []string {
+	var ns []string
+	for n := range strings.SplitSeq(s, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			ns = append(ns, n)
+		}
+	}
+	return ns
+}
+
+func recordActivity() {
+	mu.Lock()
+	defer mu.Unlock()
+	state.LastActivity = time.Now()
+}
+
 func runPause() {
 	mu.Lock()
 	defer mu.Unlock()
@@ -89,19 +130,15 @@ func runPause() {
 	}
 	log.Println("Pausing...")
 
-	r := command.NewExecRunner(true)
-
-	cr, err := cruntime.New(cruntime.Config{Type: *runtime, Runner: r})
-	if err != nil {
-		exit.Error(reason.InternalNewRuntime, "Failed runtime", err)
-	}
-
-	uids, err := cluster.Pause(cr, r, []string{"kube-system"})
+	cr, r := newRuntime()
+	uids, err := cluster.Pause(cr, r, splitNamespaces(*namespaces))
 	if err != nil {
 		exit.Error(reason.GuestPause, "Pause", err)
 	}
 
 	runtimePaused = true
+	state.Pauses++
+	state.PausedSince = time.Now()
 
 	log.Printf("Paused %d containers", len(uids))
 }
@@ -115,18 +152,14 @@ func runUnpause() {
 	}
 	log.Println("Unpausing...")
 
-	r := command.NewExecRunner(true)
-
-	cr, err := cruntime.New(cruntime.Config{Type: *runtime, Runner: r})
-	if err != nil {
-		exit.Error(reason.InternalNewRuntime, "Failed runtime", err)
-	}
-
+	cr, r := newRuntime()
 	uids, err := cluster.Unpause(cr, r, nil)
 	if err != nil {
 		exit.Error(reason.GuestUnpause, "Unpause", err)
 	}
 	runtimePaused = false
+	state.Unpauses++
+	state.PausedSince = time.Time{}
 
 	log.Printf("Unpaused %d containers", len(uids))
 }
@@ -135,15 +168,15 @@ func alreadyPaused() {
 	mu.Lock()
 	defer mu.Unlock()
 
-	r := command.NewExecRunner(true)
-	cr, err := cruntime.New(cruntime.Config{Type: *runtime, Runner: r})
-	if err != nil {
-		exit.Error(reason.InternalNewRuntime, "Failed runtime", err)
-	}
-
-	runtimePaused, err = cluster.CheckIfPaused(cr, []string{"kube-system"})
+	cr, _ := newRuntime()
+	var err error
+	runtimePaused, err = cluster.CheckIfPaused(cr, splitNamespaces(*namespaces))
 	if err != nil {
 		exit.Error(reason.GuestCheckPaused, "Fail check if container paused", err)
 	}
+	state.LastActivity = time.Now()
+	if runtimePaused {
+		state.PausedSince = time.Now()
+	}
 	log.Printf("containers paused status: %t", runtimePaused)
 }
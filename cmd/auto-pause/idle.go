@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"log"
+
+	"k8s.io/minikube/pkg/minikube/cruntime"
+)
+
+// workloadBusy returns true and records activity if the running containers outside of
+// the excluded namespaces use more CPU than the configured threshold.
+func workloadBusy() bool {
+	if *cpuThreshold <= 0 {
+		return false
+	}
+	cr, _ := newRuntime()
+	cpu, err := workloadCPU(cr, splitNamespaces(*excludedNamespaces))
+	if err != nil {
+		// fall back to request based idle detection
+		log.Printf("unable to measure workload CPU: %v", err)
+		return false
+	}
+	if cpu < *cpuThreshold {
+		return false
+	}
+	log.Printf("Workload CPU usage %.1f%% is above %.1f%%, not pausing", cpu, *cpuThreshold)
+	recordActivity()
+	return true
+}
+
+// workloadCPU returns the summed CPU usage of the running containers outside of the excluded namespaces
+func workloadCPU(cr cruntime.Manager, excluded []string) (float64, error) {
+	ids, err := cr.ListContainers(cruntime.ListContainersOptions{State: cruntime.Running})
+	if err != nil {
+		return 0, err
+	}
+	skip := map[string]bool{}
+	if len(excluded) > 0 {
+		eids, err := cr.ListContainers(cruntime.ListContainersOptions{State: cruntime.Running, Namespaces: excluded})
+		if err != nil {
+			return 0, err
+		}
+		for _, id := range eids {
+			skip[id] = true
+		}
+	}
+	var workloads []string
+	for _, id := range ids {
+		if !skip[id] {
+			workloads = append(workloads, id)
+		}
+	}
+
+	stats, err := cr.ContainerStats(workloads)
+	if err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, s := range stats {
+		total += s.CPUPercent
+	}
+	return total, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"k8s.io/minikube/pkg/minikube/pause"
+)
+
+// currentState returns a copy of the daemon state
+func currentState() pause.AutoPauseStatus {
+	mu.Lock()
+	defer mu.Unlock()
+	st := state
+	st.Paused = runtimePaused
+	return st
+}
+
+// statusHandler serves the daemon state as JSON
+func statusHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(currentState()); err != nil {
+		log.Printf("failed to write status: %v", err)
+	}
+}
+
+// metricsHandler serves the daemon state in the Prometheus text exposition format
+func metricsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := writeMetrics(w, currentState()); err != nil {
+		log.Printf("failed to write metrics: %v", err)
+	}
+}
+
+func writeMetrics(w io.Writer, st pause.AutoPauseStatus) error {
+	paused := 0
+	if st.Paused {
+		paused = 1
+	}
+	lastActivity := 0.0
+	if !st.LastActivity.IsZero() {
+		lastActivity = float64(st.LastActivity.UnixNano()) / 1e9
+	}
+	metrics := []struct {
+		name  string
+		kind  string
+		help  string
+		value any
+	}{
+		{"auto_pause_paused", "gauge", "Whether the cluster is paused by auto-pause.", paused},
+		{"auto_pause_pauses_total", "counter", "Number of times the cluster was paused.", st.Pauses},
+		{"auto_pause_unpauses_total", "counter", "Number of times the cluster was unpaused.", st.Unpauses},
+		{"auto_pause_last_activity_timestamp_seconds", "gauge", "Unix time of the last observed cluster activity.", lastActivity},
+	}
+	for _, m := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %v\n", m.name, m.help, m.name, m.kind, m.name, m.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/pause"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	st := pause.AutoPauseStatus{
+		Paused:       true,
+		LastActivity: time.Unix(1700000000, 0),
+		Pauses:       3,
+		Unpauses:     2,
+	}
+	var b bytes.Buffer
+	if err := writeMetrics(&b, st); err != nil {
+		t.Fatalf("writeMetrics: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		"# TYPE auto_pause_paused gauge\nauto_pause_paused 1\n",
+		"# TYPE auto_pause_pauses_total counter\nauto_pause_pauses_total 3\n",
+		"auto_pause_unpauses_total 2\n",
+		"auto_pause_last_activity_timestamp_seconds 1.7e+09\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("writeMetrics output missing %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestSplitNamespaces(t *testing.T) {
+	got := splitNamespaces(" kube-system, ,istio-system,")
+	if strings.Join(got, "|") != "kube-system|istio-system" {
+		t.Errorf("splitNamespaces = %q, want [kube-system istio-system]", got)
+	}
+	if got := splitNamespaces(""); got != nil {
+		t.Errorf("splitNamespaces(\"\") = %q, want nil", got)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/minikube/pkg/minikube/audit"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	auditCommand string
+	auditSince   string
+	auditUntil   string
+	auditFailed  bool
+	auditOutput  string
+)
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Query the audit log of minikube commands",
+	Long: `Query the audit log of the minikube commands run on this machine.
+
+Commands can be filtered by the profile and user they ran as (--profile and --user), by command, and by the time they started.
+Commands that did not complete, such as ones that exited with an error, are shown without an end time and can be selected with --failed.`,
+	Example: `minikube audit --command delete --profile demo
+minikube audit --command start --since 168h --output csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube audit [flags]")
+		}
+
+		f := audit.Filter{
+			Command: auditCommand,
+			Failed:  auditFailed,
+		}
+		// --profile and --user are global flags with defaults, so only filter on them if they were set explicitly
+		if cmd.Flags().Changed(config.ProfileName) {
+			f.Profile = viper.GetString(config.ProfileName)
+		}
+		if cmd.Flags().Changed(config.UserFlag) {
+			f.User = viper.GetString(config.UserFlag)
+		}
+		var err error
+		now := time.Now()
+		if f.Since, err = parseAuditTime(auditSince, now); err != nil {
+			exit.Message(reason.Usage, "Invalid --since value: {{.error}}", out.V{"error": err})
+		}
+		if f.Until, err = parseAuditTime(auditUntil, now); err != nil {
+			exit.Message(reason.Usage, "Invalid --until value: {{.error}}", out.V{"error": err})
+		}
+
+		entries, err := audit.Query(f)
+		if err != nil {
+			exit.Error(reason.InternalAuditQuery, "querying audit log", err)
+		}
+
+		switch strings.ToLower(auditOutput) {
+		case "table":
+			if len(entries) == 0 {
+				out.Styled(style.Empty, "No audit entries found.")
+				return
+			}
+			printAuditTable(os.Stdout, entries)
+		case "json":
+			err = printAuditJSON(os.Stdout, entries)
+		case "csv":
+			err = printAuditCSV(os.Stdout, entries)
+		default:
+			exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'table', 'json', 'csv'", auditOutput))
+		}
+		if err != nil {
+			exit.Error(reason.InternalAuditQuery, "writing audit entries", err)
+		}
+	},
+}
+
+// parseAuditTime parses a --since or --until value, which is either a duration before now, an RFC3339 time or a date.
+func parseAuditTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a duration (e.g. 24h), an RFC3339 time or a date (YYYY-MM-DD)", s)
+}
+
+// auditFields returns the columns of an entry shown in table and csv output
+func auditFields(e audit.Entry) []string {
+	end, duration := "", ""
+	if e.Completed() {
+		end = e.EndTime.Format(time.RFC1123)
+		duration = e.Duration.String()
+	}
+	return []string{e.Command, e.Args, e.Profile, e.User, e.Version, e.StartTime.Format(time.RFC1123), end, duration}
+}
+
+var auditHeaders = []string{"Command", "Args", "Profile", "User", "Version", "Start Time", "End Time", "Duration"}
+
+func printAuditTable(w io.Writer, entries []audit.Entry) {
+	data := [][]string{}
+	for _, e := range entries {
+		data = append(data, auditFields(e))
+	}
+	table := tablewriter.NewWriter(w)
+	table.Header(auditHeaders)
+	table.Options(
+		tablewriter.WithHeaderAutoFormat(tw.Off),
+	)
+	if err := table.Bulk(data); err != nil {
+		exit.Error(reason.InternalAuditQuery, "adding audit entries to table", err)
+	}
+	if err := table.Render(); err != nil {
+		exit.Error(reason.InternalAuditQuery, "rendering audit table", err)
+	}
+}
+
+func printAuditJSON(w io.Writer, entries []audit.Entry) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+func printAuditCSV(w io.Writer, entries []audit.Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(auditHeaders); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := cw.Write(auditFields(e)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func init() {
+	auditCmd.Flags().StringVar(&auditCommand, "command", "", "Only show entries of this command, e.g. start or delete")
+	auditCmd.Flags().StringVar(&auditSince, "since", "", "Only show commands started after this time, given as a duration before now (e.g. 24h), an RFC3339 time or a date (YYYY-MM-DD)")
+	auditCmd.Flags().StringVar(&auditUntil, "until", "", "Only show commands started before this time, given as a duration before now (e.g. 1h), an RFC3339 time or a date (YYYY-MM-DD)")
+	auditCmd.Flags().BoolVar(&auditFailed, "failed", false, "Only show commands that did not complete successfully")
+	auditCmd.Flags().StringVarP(&auditOutput, "output", "o", "table", "The output format. One of 'table', 'json', 'csv'")
+}
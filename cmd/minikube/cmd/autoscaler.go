@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/autoscaler"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	autoscalerAddress      string
+	autoscalerPort         int
+	autoscalerDrainTimeout time.Duration
+	autoscalerCert         string
+	autoscalerKey          string
+	autoscalerCACert       string
+)
+
+// autoscalerCmd represents the autoscaler command
+var autoscalerCmd = &cobra.Command{
+	Use:   "autoscaler",
+	Short: "Serve the cluster autoscaler cloud provider for the node pools of a cluster",
+	Long: `Implements the externalgrpc cloud provider of the cluster autoscaler on top of the node pools of the cluster,
+so that pending pods start new nodes and idle nodes are deleted, within the bounds set with --min-nodes and --max-nodes
+on 'minikube nodepool create' or 'minikube nodepool autoscale'.
+
+The cloud provider listens on the gateway of the cluster network, or on the loopback interface with drivers which forward the gateway to it, and only accepts clients with a certificate signed by the minikube CA.
+Unless --cert, --key and --cacert are set, minikube generates the certificates and stores the cloud config with a client certificate in the kube-system/cluster-autoscaler-cloud-config secret.
+Run the cluster autoscaler in the kube-system namespace with --cloud-provider=externalgrpc, mounting that secret at /etc/cluster-autoscaler and passing --cloud-config=/etc/cluster-autoscaler/cloud-config.
+The command runs in the foreground until interrupted.`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube autoscaler [flags]")
+		}
+		if (autoscalerCert == "") != (autoscalerKey == "") || (autoscalerCert == "") != (autoscalerCACert == "") {
+			exit.Message(reason.Usage, "--cert, --key and --cacert must be set together")
+		}
+
+		options := flags.CommandOptions()
+		cname := ClusterFlagValue()
+		co := mustload.Healthy(cname, options)
+		if driver.BareMetal(co.Config.Driver) {
+			exit.Message(reason.DrvUnsupportedMulti, "none driver does not support multi-node clusters")
+		}
+
+		var pools []string
+		for _, p := range autoscaler.AutoscaledPools(*co.Config) {
+			pools = append(pools, fmt.Sprintf("%s (%d-%d)", p.Name, p.MinNodes, p.MaxNodes))
+		}
+		if len(pools) == 0 {
+			exit.Message(reason.Usage, "No node pool of cluster {{.cluster}} can be autoscaled, set its bounds with 'minikube nodepool autoscale POOL_NAME --max-nodes=N'", out.V{"cluster": cname})
+		}
+
+		ip := net.ParseIP(autoscalerAddress)
+		if autoscalerAddress == "" {
+			var err error
+			ip, err = cluster.ListenIP(co.CP.Host, cname)
+			if err != nil {
+				exit.Error(reason.IfHostIP, "Error getting the host IP address to use from within the VM", err)
+			}
+		} else if ip == nil {
+			exit.Message(reason.Usage, "--address must be an IP address, got {{.address}}", out.V{"address": autoscalerAddress})
+		}
+		lis, err := net.Listen("tcp", net.JoinHostPort(ip.String(), fmt.Sprint(autoscalerPort)))
+		if err != nil {
+			exit.Error(reason.IfAutoscaler, "listen for the cluster autoscaler", err)
+		}
+		address := net.JoinHostPort(constants.HostAlias, fmt.Sprint(lis.Addr().(*net.TCPAddr).Port))
+
+		certs := autoscaler.Certs{CACert: autoscalerCACert, ServerCert: autoscalerCert, ServerKey: autoscalerKey}
+		if autoscalerCert == "" {
+			certs, err = autoscaler.GenerateCerts(cname, []net.IP{ip})
+			if err != nil {
+				exit.Error(reason.IfAutoscaler, "generate certificates", err)
+			}
+		}
+		creds, err := autoscaler.ServerCredentials(certs)
+		if err != nil {
+			exit.Error(reason.IfAutoscaler, "load certificates", err)
+		}
+
+		client, err := kapi.Client(cname)
+		if err != nil {
+			exit.Error(reason.InternalKubernetesClient, "error creating clientset", err)
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		out.Step(style.Running, "Serving the cluster autoscaler cloud provider for node pools {{.pools}} on {{.address}}", out.V{"pools": strings.Join(pools, ", "), "address": lis.Addr()})
+		if certs.ClientCert != "" {
+			if err := autoscaler.SaveCloudConfig(ctx, client, certs, address); err != nil {
+				exit.Error(reason.IfAutoscaler, "save the cloud config of the cluster autoscaler", err)
+			}
+			out.Styled(style.Tip, "Run the cluster autoscaler in the kube-system namespace with --cloud-provider=externalgrpc --cloud-config={{.config}}, mounting secret {{.secret}} at {{.dir}}",
+				out.V{"config": path.Join(autoscaler.CloudConfigDir, "cloud-config"), "secret": autoscaler.CloudConfigSecret, "dir": autoscaler.CloudConfigDir})
+		} else {
+			out.Styled(style.Tip, "Run the cluster autoscaler with --cloud-provider=externalgrpc and this cloud config, with a client certificate signed by {{.cacert}}:", out.V{"cacert": autoscalerCACert})
+			out.String(autoscaler.CloudConfig(address, "/path/to"))
+		}
+
+		p := autoscaler.NewProvider(co.Config, client, autoscalerDrainTimeout, options)
+		if err := p.Serve(ctx, lis, grpc.Creds(creds)); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			exit.Error(reason.IfAutoscaler, "serve the cluster autoscaler", err)
+		}
+	},
+}
+
+func init() {
+	autoscalerCmd.Flags().StringVar(&autoscalerAddress, "address", "", "IP address to listen on. Defaults to the gateway of the cluster network, or to the loopback address where the driver forwards the gateway to it.")
+	autoscalerCmd.Flags().IntVar(&autoscalerPort, "port", 8086, "Port to listen on.")
+	autoscalerCmd.Flags().DurationVar(&autoscalerDrainTimeout, "drain-timeout", 5*time.Minute, "How long to wait for the pods of a removed node to be evicted, for example while a PodDisruptionBudget blocks the eviction")
+	autoscalerCmd.Flags().StringVar(&autoscalerCert, "cert", "", "Server certificate, instead of one generated by minikube. Requires --key and --cacert.")
+	autoscalerCmd.Flags().StringVar(&autoscalerKey, "key", "", "Server key, instead of one generated by minikube.")
+	autoscalerCmd.Flags().StringVar(&autoscalerCACert, "cacert", "", "CA certificate to verify the client certificate of the cluster autoscaler with, instead of the minikube CA.")
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	cachePruneOlderThan time.Duration
+	cachePruneUnused    bool
+	cachePruneDryRun    bool
+)
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Removes cached images and preloaded tarballs from the host",
+	Long: `Removes the images and the preloaded tarballs cached on the host which are older than --older-than, or which are --unused:
+not needed by the Kubernetes version or the enabled addons of any profile, and not added by 'minikube cache add'.
+When both are given, only the files matching both are removed.`,
+	Example: `minikube cache prune --unused --dry-run
+
+minikube cache prune --older-than 720h`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube cache prune [--older-than DURATION] [--unused] [--dry-run]")
+		}
+		if cachePruneOlderThan <= 0 && !cachePruneUnused {
+			exit.Message(reason.Usage, "Please pass --older-than, --unused or both, to select the files to remove")
+		}
+
+		files, err := machine.PruneCache(machine.CachePruneOptions{OlderThan: cachePruneOlderThan, Unused: cachePruneUnused, DryRun: cachePruneDryRun})
+		var total int64
+		for _, f := range files {
+			total += f.Size
+			out.Step(style.Deleted, "{{.kind}} {{.path}} ({{.size}})", out.V{"kind": f.Kind, "path": f.Path, "size": units.HumanSizeWithPrecision(float64(f.Size), 3)})
+		}
+		if err != nil {
+			exit.Error(reason.HostDelCache, "Failed to prune the cache", err)
+		}
+		size := units.HumanSizeWithPrecision(float64(total), 3)
+		if cachePruneDryRun {
+			out.Styled(style.Notice, "Would free {{.size}} by removing {{.count}} files.", out.V{"size": size, "count": len(files)})
+			return
+		}
+		out.Styled(style.Notice, "Freed {{.size}} by removing {{.count}} files.", out.V{"size": size, "count": len(files)})
+	},
+}
+
+func init() {
+	cachePruneCmd.Flags().DurationVar(&cachePruneOlderThan, "older-than", 0, "Remove the files which were last written longer ago than this, e.g. 720h")
+	cachePruneCmd.Flags().BoolVar(&cachePruneUnused, "unused", false, "Remove the files which no profile needs, and which were not added with 'minikube cache add'")
+	cachePruneCmd.Flags().BoolVar(&cachePruneDryRun, "dry-run", false, "Only show what would be removed")
+	cacheCmd.AddCommand(cachePruneCmd)
+}
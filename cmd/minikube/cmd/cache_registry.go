@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/docker/go-units"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/registrycache"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var cacheRegistryCmd = &cobra.Command{
+	Use:   "registry",
+	Short: "Manage the pull-through registry caches shared by all the profiles",
+	Long:  "Manage the pull-through registry caches that run on the host for the registries passed to 'minikube start --registry-cache', which are shared by all the profiles.",
+}
+
+var cacheRegistryStatusCmd = &cobra.Command{
+	Use:     "status",
+	Short:   "Shows the state of the registry caches",
+	Long:    "Shows the state of the registry caches, along with how much disk their cached images take.",
+	Example: "minikube cache registry status",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube cache registry status")
+		}
+
+		statuses, err := registrycache.Statuses()
+		if err != nil {
+			exit.Error(reason.HostRegistryCache, "reading registry caches", err)
+		}
+		if len(statuses) == 0 {
+			out.Styled(style.Empty, "No registry caches, start a cluster with --registry-cache to create them.")
+			return
+		}
+
+		data := [][]string{}
+		for _, s := range statuses {
+			state := "Stopped"
+			if s.Running {
+				state = "Running"
+			}
+			size := "-"
+			if s.Size >= 0 {
+				size = units.HumanSizeWithPrecision(float64(s.Size), 3)
+			}
+			data = append(data, []string{s.Registry, s.MirrorURL(), s.OCIBin, s.Name(), state, size})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header("Registry", "Mirror", "Engine", "Container", "Status", "Size")
+		table.Options(
+			tablewriter.WithHeaderAutoFormat(tw.Off),
+		)
+		if err := table.Bulk(data); err != nil {
+			klog.Error("Error while bulk render table: ", err)
+		}
+		if err := table.Render(); err != nil {
+			klog.Error("Error while rendering registry cache table: ", err)
+		}
+	},
+}
+
+var cacheRegistryPruneCmd = &cobra.Command{
+	Use:   "prune [REGISTRY...]",
+	Short: "Removes registry caches along with their cached images",
+	Long: `Removes the registry caches of the given registries along with their cached images, or every registry cache if none is given.
+Clusters started with --registry-cache create their caches again on their next start.`,
+	Example: "minikube cache registry prune docker.io",
+	Run: func(_ *cobra.Command, args []string) {
+		pruned, err := registrycache.Prune(args...)
+		for _, c := range pruned {
+			out.Step(style.Deleted, "Removed the registry cache of {{.registry}}", out.V{"registry": c.Registry})
+		}
+		if err != nil {
+			exit.Error(reason.HostRegistryCache, "removing registry caches", err)
+		}
+		if len(pruned) == 0 {
+			out.Styled(style.Empty, "No registry caches to remove.")
+			return
+		}
+		out.Styled(style.Notice, "Removed {{.count}} registry caches.", out.V{"count": strconv.Itoa(len(pruned))})
+	},
+}
+
+func init() {
+	cacheRegistryCmd.AddCommand(cacheRegistryStatusCmd)
+	cacheRegistryCmd.AddCommand(cacheRegistryPruneCmd)
+	cacheCmd.AddCommand(cacheRegistryCmd)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/chaos"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	chaosFor       time.Duration
+	chaosPercent   int
+	chaosForceFill bool
+)
+
+// chaosCmd represents the set of chaos subcommands
+var chaosCmd = &cobra.Command{
+	Use:   "chaos",
+	Short: "Inject reversible faults into the nodes of a cluster",
+	Long: `Injects faults into the nodes of a cluster, to test how workloads cope with them.
+Faults stay in place until they are reverted by "minikube chaos recover", or after the duration given with --for.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube chaos [node-kill|node-pause|network-partition|disk-fill|apiserver-restart|list|recover]")
+	},
+}
+
+// chaosTarget returns the named node of the current cluster, exiting if it does not exist
+func chaosTarget(name string) chaos.Target {
+	options := flags.CommandOptions()
+	api, cc := mustload.Partial(ClusterFlagValue(), options)
+	n, _, err := node.Retrieve(*cc, name)
+	if err != nil {
+		exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
+	}
+	return chaos.Target{API: api, Config: cc, Node: *n, Options: options}
+}
+
+// injectFault injects the fault, then reverts it after --for, if set
+func injectFault(t chaos.Target, f chaos.Fault) {
+	machineName := config.MachineName(*t.Config, t.Node)
+	if err := chaos.Inject(t, f); err != nil {
+		exit.Error(reason.GuestChaos, "failed to inject fault", err)
+	}
+	out.Step(style.Notice, "Injected {{.action}} into node {{.name}}.", out.V{"action": f.Action, "name": machineName})
+
+	if chaosFor == 0 {
+		out.Styled(style.Tip, `To revert it, run: "{{.command}}"`, out.V{"command": mustload.ExampleCmd(t.Config.Name, "chaos recover "+machineName)})
+		return
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	out.Step(style.Waiting, "Reverting in {{.duration}}, or on Ctrl-C ...", out.V{"duration": chaosFor})
+	select {
+	case <-time.After(chaosFor):
+	case <-ctx.Done():
+	}
+
+	if err := chaos.Revert(t, f); err != nil {
+		exit.Error(reason.GuestChaos, "failed to revert fault", err)
+	}
+	out.Step(style.Happy, "Reverted {{.action}} of node {{.name}}.", out.V{"action": f.Action, "name": machineName})
+}
+
+// chaosActionCmd returns the command injecting the action into the node given as argument
+func chaosActionCmd(action, short, long string, validate func(chaos.Target)) *cobra.Command {
+	return &cobra.Command{
+		Use:     action + " NODE_NAME",
+		Short:   short,
+		Long:    long,
+		Example: "minikube chaos " + action + " m02 --for 30s",
+		Run: func(_ *cobra.Command, args []string) {
+			if len(args) != 1 {
+				exit.Message(reason.Usage, "Usage: minikube chaos {{.action}} NODE_NAME", out.V{"action": action})
+			}
+			if chaosFor < 0 {
+				exit.Message(reason.Usage, "--for must not be negative")
+			}
+			t := chaosTarget(args[0])
+			if validate != nil {
+				validate(t)
+			}
+			f := chaos.Fault{Action: action, Node: t.Node.Name, Since: time.Now()}
+			if action == chaos.DiskFill {
+				f.Percent = chaosPercent
+			}
+			injectFault(t, f)
+		},
+	}
+}
+
+var chaosNodeKillCmd = chaosActionCmd(chaos.NodeKill,
+	"Kills the machine of a node",
+	"Kills the machine of a node, as if it lost power. Recovering starts the node again.",
+	nil)
+
+var chaosNodePauseCmd = chaosActionCmd(chaos.NodePause,
+	"Pauses the kubelet and all the containers of a node",
+	"Stops the kubelet and pauses all the containers of a node, so that it stops responding while its machine keeps running.",
+	nil)
+
+var chaosNetworkPartitionCmd = chaosActionCmd(chaos.NetworkPartition,
+	"Cuts a node off from the other nodes of the cluster",
+	"Drops all the traffic between a node, including its pods, and the other nodes of the cluster. The node stays reachable from the host.",
+	func(t chaos.Target) {
+		if len(t.Config.Nodes) < 2 {
+			exit.Message(reason.Usage, "Partitioning the network needs a cluster with more than one node")
+		}
+	})
+
+var chaosDiskFillCmd = chaosActionCmd(chaos.DiskFill,
+	"Fills the disk of a node",
+	"Allocates a file on the disk of a node, until the disk is --percent full.",
+	func(t chaos.Target) {
+		if chaosPercent <= 0 || chaosPercent > 100 {
+			exit.Message(reason.Usage, "--percent must be between 1 and 100")
+		}
+		if driver.IsKIC(t.Config.Driver) && !chaosForceFill {
+			exit.Message(reason.Usage, "The {{.driver}} driver shares its disk with the host, which would be filled as well. Use --force to fill it anyway.", out.V{"driver": t.Config.Driver})
+		}
+	})
+
+var chaosAPIServerRestartCmd = &cobra.Command{
+	Use:     "apiserver-restart [NODE_NAME]",
+	Short:   "Restarts the Kubernetes API server",
+	Long:    "Stops the API server container of a control-plane node, the primary one by default, which the kubelet then starts again.",
+	Example: "minikube chaos apiserver-restart",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.Message(reason.Usage, "Usage: minikube chaos apiserver-restart [NODE_NAME]")
+		}
+		name := ""
+		if len(args) == 1 {
+			name = args[0]
+		}
+		t := chaosTarget(name)
+		if !t.Node.ControlPlane {
+			exit.Message(reason.Usage, "Node {{.name}} is not a control-plane node", out.V{"name": config.MachineName(*t.Config, t.Node)})
+		}
+		if err := chaos.RestartAPIServer(t); err != nil {
+			exit.Error(reason.GuestChaos, "failed to restart the API server", err)
+		}
+		out.Step(style.Restarting, "Stopped the API server of node {{.name}}, the kubelet will start it again.", out.V{"name": config.MachineName(*t.Config, t.Node)})
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{chaosNodeKillCmd, chaosNodePauseCmd, chaosNetworkPartitionCmd, chaosDiskFillCmd} {
+		c.Flags().DurationVar(&chaosFor, "for", 0, "Revert the fault after this duration, or on Ctrl-C. By default the fault stays until \"minikube chaos recover\".")
+		chaosCmd.AddCommand(c)
+	}
+	chaosDiskFillCmd.Flags().IntVar(&chaosPercent, "percent", 90, "How full to make the disk, in percent")
+	chaosDiskFillCmd.Flags().BoolVar(&chaosForceFill, "force", false, "Fill the disk even if it is shared with the host")
+	chaosCmd.AddCommand(chaosAPIServerRestartCmd)
+}
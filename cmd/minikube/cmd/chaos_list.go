@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/chaos"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var chaosListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "Lists the faults injected into the cluster",
+	Long:    "Lists the faults injected into the cluster which have not been reverted yet.",
+	Example: "minikube chaos list",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube chaos list")
+		}
+
+		cname := ClusterFlagValue()
+		faults, err := chaos.Faults(cname)
+		if err != nil {
+			exit.Error(reason.GuestChaos, "listing faults", err)
+		}
+		if len(faults) == 0 {
+			out.Styled(style.Empty, "No faults injected into cluster {{.cluster}}.", out.V{"cluster": cname})
+			return
+		}
+
+		data := [][]string{}
+		for _, f := range faults {
+			percent := ""
+			if f.Percent != 0 {
+				percent = strconv.Itoa(f.Percent) + "%"
+			}
+			data = append(data, []string{f.Action, f.Node, percent, f.Since.Format(time.RFC3339)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header("Fault", "Node", "Percent", "Since")
+		table.Options(
+			tablewriter.WithHeaderAutoFormat(tw.Off),
+		)
+		if err := table.Bulk(data); err != nil {
+			klog.Error("Error while bulk render table: ", err)
+		}
+		if err := table.Render(); err != nil {
+			klog.Error("Error while rendering fault table: ", err)
+		}
+	},
+}
+
+func init() {
+	chaosCmd.AddCommand(chaosListCmd)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/chaos"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var chaosRecoverCmd = &cobra.Command{
+	Use:     "recover [NODE_NAME]",
+	Short:   "Reverts the faults injected into the cluster",
+	Long:    "Reverts the faults injected into a node, or into all the nodes of the cluster, latest first.",
+	Example: "minikube chaos recover m02",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.Message(reason.Usage, "Usage: minikube chaos recover [NODE_NAME]")
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		faults, err := chaos.Faults(cc.Name)
+		if err != nil {
+			exit.Error(reason.GuestChaos, "listing faults", err)
+		}
+		if len(args) == 1 {
+			n, _, err := node.Retrieve(*cc, args[0])
+			if err != nil {
+				exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
+			}
+			faults = slices.DeleteFunc(faults, func(f chaos.Fault) bool { return f.Node != n.Name })
+		}
+		if len(faults) == 0 {
+			out.Styled(style.Empty, "No faults to revert.")
+			return
+		}
+
+		// the latest faults may depend on the earlier ones, such as a pause on a node killed and started again
+		slices.Reverse(faults)
+		var errs []error
+		for _, f := range faults {
+			n, _, err := node.Retrieve(*cc, f.Node)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			machineName := config.MachineName(*cc, *n)
+			if err := chaos.Revert(chaos.Target{API: api, Config: cc, Node: *n, Options: options}, f); err != nil {
+				out.FailureT("Failed to revert {{.action}} of node {{.name}}: {{.error}}", out.V{"action": f.Action, "name": machineName, "error": err})
+				errs = append(errs, err)
+				continue
+			}
+			out.Step(style.Happy, "Reverted {{.action}} of node {{.name}}.", out.V{"action": f.Action, "name": machineName})
+		}
+		if err := errors.Join(errs...); err != nil {
+			exit.Error(reason.GuestChaos, "failed to revert faults", err)
+		}
+	},
+}
+
+func init() {
+	chaosCmd.AddCommand(chaosRecoverCmd)
+}
@@ -169,9 +169,28 @@ var settings = []Setting{
 		set:  SetBool,
 	},
 	{
-		name: config.MaxAuditEntries,
+		name:      config.MaxAuditEntries,
+		set:       SetInt,
+		callbacks: []setFn{DeprecatedMaxAuditEntriesMsg},
+	},
+	{
+		name:        config.MaxAuditFileSize,
+		set:         SetString,
+		validations: []setFn{IsValidFileSize},
+	},
+	{
+		name: config.MaxAuditFiles,
 		set:  SetInt,
 	},
+	{
+		name:        config.MaxAuditAge,
+		set:         SetString,
+		validations: []setFn{IsValidDuration},
+	},
+	{
+		name: config.CompressAuditFiles,
+		set:  SetBool,
+	},
 	{
 		name:        config.DNSServers,
 		set:         SetStringSlice,
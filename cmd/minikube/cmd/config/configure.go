@@ -23,6 +23,8 @@ import (
 	"net"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -56,7 +58,7 @@ var addonsConfigureCmd = &cobra.Command{
 	Use:   "configure ADDON_NAME",
 	Short: "Configures the addon w/ADDON_NAME within minikube (example: minikube addons configure registry-creds). For a list of available addons use: minikube addons list",
 	Long:  "Configures the addon w/ADDON_NAME within minikube (example: minikube addons configure registry-creds). For a list of available addons use: minikube addons list",
-	Run: func(_ *cobra.Command, args []string) {
+	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) != 1 {
 			exit.Message(reason.Usage, "usage: minikube addons configure ADDON_NAME")
 		}
@@ -64,6 +66,22 @@ var addonsConfigureCmd = &cobra.Command{
 		options := flags.CommandOptions()
 		profile := ClusterFlagValue()
 		addon := args[0]
+		if addon != "registry-creds" && (registryCredsFromFiles() || registryCredsRefresh != 0 || cmd.Flags().Changed("namespaces")) {
+			exit.Message(reason.Usage, "--credentials-file, --host-docker-config, --namespaces and --refresh only apply to the registry-creds addon")
+		}
+		if registryCredsRefresh < 0 {
+			exit.Message(reason.Usage, "--refresh must not be negative")
+		}
+		if (registryCredsRefresh != 0 || cmd.Flags().Changed("namespaces")) && !registryCredsFromFiles() {
+			exit.Message(reason.Usage, "--refresh and --namespaces need --credentials-file or --host-docker-config")
+		}
+		if registryCredsRefresher {
+			if registryCredsRefresh <= 0 {
+				exit.Message(reason.Usage, "--refresher needs --refresh")
+			}
+			refreshRegistryCreds(profile)
+			return
+		}
 		addonConfig := loadAddonConfigFile(addon, addonConfigFile)
 
 		// allows for additional prompting of information when enabling addons
@@ -128,6 +146,14 @@ func unpauseWholeCluster(co mustload.ClusterController) {
 
 func init() {
 	addonsConfigureCmd.Flags().StringVarP(&addonConfigFile, "config-file", "f", "", "An optional configuration file to read addon specific configs from instead of being prompted each time.")
+	addonsConfigureCmd.Flags().StringSliceVar(&registryCredsFiles, "credentials-file", nil, "registry-creds: files in the docker config.json format holding the credentials of any registry, directly or through credential helpers. Disables prompting.")
+	addonsConfigureCmd.Flags().BoolVar(&registryCredsHostDocker, "host-docker-config", false, "registry-creds: read the credentials of any registry from the docker config of the host and its credential helpers. Disables prompting.")
+	addonsConfigureCmd.Flags().DurationVar(&registryCredsRefresh, "refresh", 0, "registry-creds: read the credentials again at this interval in the background, to keep short-lived tokens valid, and copy them into the namespaces created since")
+	addonsConfigureCmd.Flags().StringSliceVar(&registryCredsNamespaces, "namespaces", []string{"default"}, "registry-creds: the namespaces to copy the credentials of --credentials-file and --host-docker-config into")
+	addonsConfigureCmd.Flags().BoolVar(&registryCredsRefresher, "refresher", false, "registry-creds: refresh the credentials in the foreground, used by --refresh")
+	if err := addonsConfigureCmd.Flags().MarkHidden("refresher"); err != nil {
+		klog.Warningf("Failed to hide refresher flag: %v\n", err)
+	}
 	AddonsCmd.AddCommand(addonsConfigureCmd)
 }
 
@@ -224,6 +250,20 @@ func processAutoPauseConfig(profile string, _ *addonConfig, options *run.Command
 	}
 
 	cfg.AutoPauseInterval = intervalTime
+
+	thresholdInput := AskForStaticValueOptional("-- Enter the workload CPU usage, in percent of one core, above which the cluster is not paused (0 to disable): ")
+	if thresholdInput != "" {
+		threshold, err := strconv.ParseFloat(thresholdInput, 64)
+		if err != nil || threshold < 0 {
+			out.ErrT(style.Fatal, "CPU threshold must be a number greater than or equal to 0: {{.value}}", out.V{"value": thresholdInput})
+		} else {
+			cfg.AutoPauseCPUThreshold = threshold
+		}
+	}
+	namespacesInput := AskForStaticValueOptional("-- Enter the comma separated namespaces whose containers are not workloads (default kube-system): ")
+	if namespacesInput != "" {
+		cfg.AutoPauseExcludedNamespaces = strings.Split(namespacesInput, ",")
+	}
 	if err = config.SaveProfile(profile, cfg); err != nil {
 		out.ErrT(style.Fatal, "Failed to save config {{.profile}}", out.V{"profile": profile})
 	}
@@ -17,16 +17,39 @@ limitations under the License.
 package config
 
 import (
+	"context"
+	"errors"
 	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"k8s.io/klog/v2"
 
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/process"
 	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/registryauth"
 	"k8s.io/minikube/pkg/minikube/service"
+	"k8s.io/minikube/pkg/minikube/style"
 )
 
 const configDefaultValue = "changeme"
 
+var (
+	registryCredsFiles      []string
+	registryCredsHostDocker bool
+	registryCredsRefresh    time.Duration
+	registryCredsNamespaces []string
+	// registryCredsRefresher is set for the detached process refreshing the credentials
+	registryCredsRefresher bool
+)
+
 // Top level configs for RegistryCreds addons
 type registryCredsAddonConfig struct {
 	EnableAWSEcr string                         `json:"enableAWSEcr"`
@@ -91,7 +114,7 @@ func processRegistryCredsConfig(profile string, ac *addonConfig) {
 	acrPassword := configDefaultValue
 
 	regCredsConf := &ac.RegistryCreds
-	awsEcrAction := regCredsConf.EnableAWSEcr // regCredsConf. "enableAWSEcr")
+	awsEcrAction := registryCredsAction(regCredsConf.EnableAWSEcr)
 
 	switch awsEcrAction {
 	case "prompt", "":
@@ -120,7 +143,7 @@ func processRegistryCredsConfig(profile string, ac *addonConfig) {
 	}
 
 	gcrPath := ""
-	gcrAction := regCredsConf.EnableGCR
+	gcrAction := registryCredsAction(regCredsConf.EnableGCR)
 
 	switch gcrAction {
 	case "prompt", "":
@@ -155,7 +178,7 @@ func processRegistryCredsConfig(profile string, ac *addonConfig) {
 		}
 	}
 
-	dockerRegistryAction := regCredsConf.EnableDockerRegistry
+	dockerRegistryAction := registryCredsAction(regCredsConf.EnableDockerRegistry)
 
 	switch dockerRegistryAction {
 	case "prompt", "":
@@ -176,7 +199,7 @@ func processRegistryCredsConfig(profile string, ac *addonConfig) {
 		out.Ln("Disabling Docker Registry.  Invalid value for enableDockerRegistry (%s).  Must be one of 'disable', 'enable' or 'prompt'", dockerRegistryAction)
 	}
 
-	acrAction := regCredsConf.EnableACR
+	acrAction := registryCredsAction(regCredsConf.EnableACR)
 
 	switch acrAction {
 	case "prompt", "":
@@ -278,4 +301,186 @@ func processRegistryCredsConfig(profile string, ac *addonConfig) {
 	if err != nil {
 		out.WarningT("ERROR creating `registry-creds-acr` secret")
 	}
+
+	if registryCredsFromFiles() {
+		syncRegistryCreds(profile)
+	}
+}
+
+// registryCredsFromFiles returns whether the credentials of arbitrary registries are read from files
+func registryCredsFromFiles() bool {
+	return len(registryCredsFiles) != 0 || registryCredsHostDocker
+}
+
+// registryCredsSources returns the sources of the credentials of arbitrary registries, if any was given
+func registryCredsSources() []registryauth.Source {
+	var sources []registryauth.Source
+	if registryCredsHostDocker {
+		d, err := registryauth.HostDockerConfig()
+		if err != nil {
+			exit.Error(reason.HostRegistryCreds, "locating the docker config of the host", err)
+		}
+		sources = append(sources, d)
+	}
+	// the files override the host, so that CI can replace some of its credentials
+	for _, f := range registryCredsFiles {
+		sources = append(sources, registryauth.DockerConfig{Path: f})
+	}
+	return sources
+}
+
+// registryCredsAction returns how to configure one of the built-in registry providers: the providers
+// that are not configured are prompted for, unless the credentials come from files, which must not prompt
+func registryCredsAction(action string) string {
+	if action == "" && registryCredsFromFiles() {
+		return "disable"
+	}
+	return action
+}
+
+// registryCredsRefresherPID is the pid file of the process refreshing the credentials of the cluster
+func registryCredsRefresherPID(profile string) string {
+	return filepath.Join(localpath.Profile(profile), "registry-creds-refresh.pid")
+}
+
+// registryCredsRefresherLog is where the process refreshing the credentials of the cluster writes its output
+func registryCredsRefresherLog(profile string) string {
+	return filepath.Join(localpath.Profile(profile), "registry-creds-refresh.log")
+}
+
+// copyRegistryCreds copies the credentials of the sources into the pull secret of the namespaces,
+// and returns how many registries they hold credentials of
+func copyRegistryCreds(ctx context.Context, profile string, sources []registryauth.Source) (int, error) {
+	creds, err := registryauth.Merge(sources...)
+	if err != nil {
+		return 0, err
+	}
+	data, err := registryauth.DockerConfigJSON(creds)
+	if err != nil {
+		return 0, err
+	}
+	client, err := service.K8s.GetCoreClient(profile)
+	if err != nil {
+		return 0, err
+	}
+	return len(creds), registryauth.SyncPullSecrets(ctx, client, registryCredsNamespaces, data)
+}
+
+// syncRegistryCreds copies the credentials of the sources into a pull secret of the namespaces given with --namespaces,
+// and replaces the process refreshing them, if any, with a new one when --refresh is given
+func syncRegistryCreds(profile string) {
+	sources := registryCredsSources()
+	stopRegistryCredsRefresher(profile)
+
+	n, err := copyRegistryCreds(context.Background(), profile, sources)
+	if err != nil {
+		exit.Error(reason.HostRegistryCreds, "syncing registry credentials", err)
+	}
+	if n == 0 {
+		out.WarningT("No registry credentials found")
+	}
+	out.Step(style.Ready, "Copied the credentials of {{.count}} registries into the {{.secret}} pull secret of the namespaces {{.namespaces}}", out.V{"count": n, "secret": registryauth.PullSecretName, "namespaces": strings.Join(registryCredsNamespaces, ", ")})
+	if registryCredsRefresh == 0 {
+		return
+	}
+
+	pid, err := startRegistryCredsRefresher(profile)
+	if err != nil {
+		exit.Error(reason.HostRegistryCreds, "starting the refresh of the registry credentials", err)
+	}
+	out.Step(style.Waiting, "Refreshing the registry credentials every {{.interval}} in the background (pid {{.pid}}), its output is written to {{.log}}", out.V{"interval": registryCredsRefresh, "pid": pid, "log": registryCredsRefresherLog(profile)})
+	out.Styled(style.Tip, "To stop refreshing them, run this command again without --refresh, or delete the cluster")
+}
+
+// startRegistryCredsRefresher runs this command again as a detached process, which refreshes the credentials
+// every --refresh, and returns its pid
+func startRegistryCredsRefresher(profile string) (int, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return 0, err
+	}
+	args := []string{"addons", "configure", "registry-creds", "-p", profile, "--refresh", registryCredsRefresh.String(), "--namespaces", strings.Join(registryCredsNamespaces, ","), "--refresher", "--alsologtostderr"}
+	if registryCredsHostDocker {
+		args = append(args, "--host-docker-config")
+	}
+	for _, f := range registryCredsFiles {
+		// the refresher must find the files wherever it runs from
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, "--credentials-file", abs)
+	}
+
+	logfile, err := os.OpenFile(registryCredsRefresherLog(profile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer logfile.Close()
+
+	cmd := exec.Command(exe, args...)
+	cmd.Stdout = logfile
+	cmd.Stderr = logfile
+	process.Detach(cmd)
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+	klog.Infof("started the registry credentials refresher with pid %d", cmd.Process.Pid)
+	if err := process.WritePidfile(registryCredsRefresherPID(profile), cmd.Process.Pid); err != nil {
+		return 0, err
+	}
+	return cmd.Process.Pid, cmd.Process.Release()
+}
+
+// stopRegistryCredsRefresher stops the process refreshing the credentials of the cluster, if any
+func stopRegistryCredsRefresher(profile string) {
+	pidfile := registryCredsRefresherPID(profile)
+	pid, err := process.ReadPidfile(pidfile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			klog.Warningf("reading %s: %v", pidfile, err)
+		}
+		return
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		klog.Warningf("locating minikube: %v", err)
+		return
+	}
+	if err := process.Kill(pid, filepath.Base(exe)); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		klog.Warningf("stopping the registry credentials refresher %d: %v", pid, err)
+		return
+	}
+	if err := os.Remove(pidfile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		klog.Warningf("removing %s: %v", pidfile, err)
+	}
+}
+
+// refreshRegistryCreds copies the credentials into the namespaces every --refresh, so that short-lived tokens
+// stay valid and namespaces created since get them, until it is stopped or the cluster is deleted
+func refreshRegistryCreds(profile string) {
+	sources := registryCredsSources()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	ticker := time.NewTicker(registryCredsRefresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := os.Stat(localpath.Profile(profile)); errors.Is(err, os.ErrNotExist) {
+				klog.Infof("cluster %s was deleted, exiting", profile)
+				return
+			}
+			// a failed refresh keeps the previous credentials, which may still be valid
+			n, err := copyRegistryCreds(ctx, profile, sources)
+			if err != nil {
+				klog.Warningf("failed to refresh the registry credentials: %v", err)
+				continue
+			}
+			klog.Infof("refreshed the credentials of %d registries", n)
+		}
+	}
 }
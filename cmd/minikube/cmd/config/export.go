@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"sigs.k8s.io/yaml"
+)
+
+var exportOutput string
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Prints the cluster definition of a profile",
+	Long: `Prints the cluster definition of an existing profile.
+The output can be checked into a repository and used to recreate the cluster with 'minikube start --config-file'.`,
+	Example: "minikube config export -p demo > cluster.yaml\nminikube start --config-file cluster.yaml",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "usage: minikube config export")
+		}
+		_, cc := mustload.Partial(ClusterFlagValue(), flags.CommandOptions())
+		data, err := exportClusterDefinition(*cc, exportOutput)
+		if err != nil {
+			exit.Message(reason.Usage, "error exporting cluster definition: {{.error}}", out.V{"error": err})
+		}
+		fmt.Print(string(data))
+	},
+}
+
+func init() {
+	configExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "yaml", "Output format. Accepted values: [yaml, json]")
+	ConfigCmd.AddCommand(configExportCmd)
+}
+
+// exportClusterDefinition encodes the cluster definition of cc in the given format
+func exportClusterDefinition(cc config.ClusterConfig, format string) ([]byte, error) {
+	def := config.NewClusterDefinition(cc)
+	switch strings.ToLower(format) {
+	case "yaml":
+		return yaml.Marshal(def)
+	case "json":
+		data, err := json.MarshalIndent(def, "", "    ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, fmt.Errorf("invalid output format %q, must be one of: yaml, json", format)
+	}
+}
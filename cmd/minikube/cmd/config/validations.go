@@ -24,8 +24,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	units "github.com/docker/go-units"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/driver"
@@ -46,6 +48,12 @@ func RequiresRestartMsg(_, _ string) error {
 	return nil
 }
 
+// DeprecatedMaxAuditEntriesMsg is the message shown when setting MaxAuditEntries, which is no longer used
+func DeprecatedMaxAuditEntriesMsg(name, _ string) error {
+	out.WarningT("{{.key}} is deprecated and ignored, the audit log is now rotated once it reaches {{.size}}, keeping {{.files}} rotated logs", out.V{"key": name, "size": config.MaxAuditFileSize, "files": config.MaxAuditFiles})
+	return nil
+}
+
 // IsValidDiskSize checks if a string is a valid disk size
 func IsValidDiskSize(_, disksize string) error {
 	_, err := units.FromHumanSize(disksize)
@@ -55,6 +63,22 @@ func IsValidDiskSize(_, disksize string) error {
 	return nil
 }
 
+// IsValidFileSize checks if a string is a valid file size
+func IsValidFileSize(name, size string) error {
+	if _, err := units.FromHumanSize(size); err != nil {
+		return fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return nil
+}
+
+// IsValidDuration checks if a string is a valid duration, such as 720h
+func IsValidDuration(name, val string) error {
+	if _, err := time.ParseDuration(val); err != nil {
+		return fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return nil
+}
+
 // IsValidCPUs checks if a string is a valid number of CPUs
 func IsValidCPUs(name, cpus string) error {
 	if cpus == constants.MaxResources || cpus == constants.NoLimit {
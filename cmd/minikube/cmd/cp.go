@@ -17,6 +17,8 @@ limitations under the License.
 package cmd
 
 import (
+	"io"
+	"os/exec"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -27,7 +29,7 @@ import (
 	"strings"
 
 	"k8s.io/minikube/cmd/minikube/cmd/flags"
-	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/archive"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/machine"
@@ -44,47 +46,222 @@ type remotePath struct {
 
 // cpCmd represents the cp command, similar to docker cp
 var cpCmd = &cobra.Command{
-	Use:   "cp <source node name>:<source file path> <target node name>:<target file absolute path>",
-	Short: "Copy the specified file into minikube",
-	Long: `Copy the specified file into minikube, it will be saved at path <target file absolute path> in your minikube.
+	Use:   "cp <source node name>:<source path>... <target node name>:<target absolute path>",
+	Short: "Copy the specified files and directories into minikube",
+	Long: `Copy the specified files and directories into minikube, they will be saved at path <target absolute path> in your minikube.
 Default target node controlplane and If <source node name> is omitted, It will trying to copy from host.
+Directories are copied recursively and sources may be glob patterns; file modes and modification times are preserved.
+When several sources are given, or the target is an existing directory, the sources are copied into it.
+A source of "-" reads a tar archive from stdin and extracts it into the target directory, and a target of "-"
+writes a tar archive of the sources to stdout. All the files are transferred over a single connection to the node.
 
 Example Command : "minikube cp a.txt /home/docker/b.txt" +
                   "minikube cp a.txt minikube-m02:/home/docker/b.txt"
-                  "minikube cp minikube-m01:a.txt minikube-m02:/home/docker/b.txt"`,
+                  "minikube cp minikube-m01:a.txt minikube-m02:/home/docker/b.txt"
+                  "minikube cp fixtures/ 'testdata/*.json' /home/docker/data/"
+                  "minikube cp - minikube-m02:/home/docker/data < fixtures.tar"`,
 	Run: func(_ *cobra.Command, args []string) {
-		if len(args) != 2 {
+		if len(args) < 2 {
 			exit.Message(reason.Usage, `Please specify the path to copy:
 	minikube cp <source file path> <target file absolute path> (example: "minikube cp a/b.txt /copied.txt")`)
 		}
 
 		options := flags.CommandOptions()
-		srcPath := args[0]
-		dstPath := setDstFileNameFromSrc(args[1], srcPath)
-		src := newRemotePath(srcPath)
-		dst := newRemotePath(dstPath)
-		validateArgs(src, dst)
+		srcArgs, dstArg := args[:len(args)-1], args[len(args)-1]
+		dst := newRemotePath(dstArg)
+		if len(srcArgs) == 1 && srcArgs[0] != "-" && dstArg != "-" {
+			dst = newRemotePath(setDstFileNameFromSrc(dstArg, srcArgs[0]))
+		}
+		var srcs []*remotePath
+		for _, arg := range srcArgs {
+			src := newRemotePath(arg)
+			validateArgs(src, dst)
+			if len(srcs) > 0 && src.node != srcs[0].node {
+				exit.Message(reason.Usage, "All the sources must be on the same node")
+			}
+			if arg == "-" && len(srcArgs) > 1 {
+				exit.Message(reason.Usage, "Reading from stdin can not be combined with other sources")
+			}
+			srcs = append(srcs, src)
+		}
 
 		co := mustload.Running(ClusterFlagValue(), options)
-		var runner command.Runner
-
+		var srcRunner, dstRunner command.Runner
+		if srcs[0].node != "" {
+			srcRunner = remoteCommandRunner(&co, srcs[0].node)
+		}
 		if dst.node != "" {
-			runner = remoteCommandRunner(&co, dst.node)
-		} else if src.node == "" {
+			dstRunner = remoteCommandRunner(&co, dst.node)
+		} else if srcs[0].node == "" {
 			// if node name not explicitly specified in both of source and target,
 			// consider target is control-plane node for backward compatibility.
-			runner = co.CP.Runner
-		} else {
-			runner = command.NewExecRunner(false)
+			dstRunner = co.CP.Runner
 		}
 
-		fa := copyableFile(&co, src, dst)
-		if err := runner.Copy(fa); err != nil {
-			exit.Error(reason.InternalCommandRunner, fmt.Sprintf("Fail to copy file %s", fa.GetSourcePath()), err)
+		if err := copyPaths(srcRunner, dstRunner, srcs, dst.path); err != nil {
+			exit.Error(reason.InternalCommandRunner, fmt.Sprintf("Fail to copy %s", strings.Join(srcArgs, " ")), err)
 		}
 	},
 }
 
+// copyPaths copies the sources to the target as a single tar stream. A nil runner stands for the host.
+func copyPaths(srcRunner, dstRunner command.Runner, srcs []*remotePath, dst string) error {
+	switch {
+	case srcs[0].path == "-":
+		cmd := archive.ExtractCmd(dst)
+		cmd.Stdin = os.Stdin
+		return command.Stream(dstRunner, cmd)
+	case dst == "-":
+		paths, err := nodeSourcePaths(srcRunner, srcs)
+		if err != nil {
+			return err
+		}
+		cmd := archive.PackCmd(paths...)
+		cmd.Stdout = os.Stdout
+		return command.Stream(srcRunner, cmd)
+	case srcRunner == nil:
+		return copyFromHost(dstRunner, srcs, dst)
+	default:
+		return copyFromNode(srcRunner, dstRunner, srcs, dst)
+	}
+}
+
+// copyFromHost copies host sources to the node of dstRunner
+func copyFromHost(dstRunner command.Runner, srcs []*remotePath, dst string) error {
+	var paths []string
+	for _, src := range srcs {
+		matches, err := filepath.Glob(src.path)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", src.path, err)
+		}
+		if len(matches) == 0 {
+			exit.Message(reason.HostPathMissing, "Cannot find directory {{.path}} for copy", out.V{"path": src.path})
+		}
+		paths = append(paths, matches...)
+	}
+
+	bases := make([]string, len(paths))
+	for i, p := range paths {
+		bases[i] = filepath.Base(p)
+	}
+	intoDir := len(paths) > 1 || nodeIsDir(dstRunner, dst)
+	dir, names := copyTargets(dst, bases, intoDir, pt.Dir, pt.Base)
+
+	var sources []archive.Source
+	for i, p := range paths {
+		sources = append(sources, archive.Source{Path: p, Name: names[i]})
+	}
+	return archive.Pipe(func(w io.Writer) error {
+		return archive.Pack(w, sources...)
+	}, func(r io.Reader) error {
+		cmd := archive.ExtractCmd(dir)
+		cmd.Stdin = r
+		return command.Stream(dstRunner, cmd)
+	})
+}
+
+// copyFromNode copies the sources from the node of srcRunner to the node of dstRunner, or to the host if it is nil
+func copyFromNode(srcRunner, dstRunner command.Runner, srcs []*remotePath, dst string) error {
+	paths, err := nodeSourcePaths(srcRunner, srcs)
+	if err != nil {
+		return err
+	}
+	bases := make([]string, len(paths))
+	for i, p := range paths {
+		bases[i] = pt.Base(p)
+	}
+
+	var dir string
+	var names []string
+	if dstRunner == nil {
+		fi, err := os.Stat(dst)
+		intoDir := len(paths) > 1 || (err == nil && fi.IsDir())
+		dir, names = copyTargets(dst, bases, intoDir, filepath.Dir, filepath.Base)
+	} else {
+		intoDir := len(paths) > 1 || nodeIsDir(dstRunner, dst)
+		dir, names = copyTargets(dst, bases, intoDir, pt.Dir, pt.Base)
+	}
+	rename := renameTopLevel(bases, names)
+
+	return archive.Pipe(func(w io.Writer) error {
+		cmd := archive.PackCmd(paths...)
+		cmd.Stdout = w
+		return command.Stream(srcRunner, cmd)
+	}, func(r io.Reader) error {
+		if dstRunner == nil {
+			return archive.Pipe(func(w io.Writer) error {
+				return archive.Rewrite(r, w, rename)
+			}, func(r io.Reader) error {
+				return archive.Extract(r, dir)
+			})
+		}
+		return archive.Pipe(func(w io.Writer) error {
+			return archive.Rewrite(r, w, rename)
+		}, func(r io.Reader) error {
+			cmd := archive.ExtractCmd(dir)
+			cmd.Stdin = r
+			return command.Stream(dstRunner, cmd)
+		})
+	})
+}
+
+// nodeSourcePaths expands the glob patterns of the node sources
+func nodeSourcePaths(r command.Runner, srcs []*remotePath) ([]string, error) {
+	var paths []string
+	for _, src := range srcs {
+		if !strings.ContainsAny(src.path, "*?[") {
+			paths = append(paths, src.path)
+			continue
+		}
+		// an empty IFS keeps the shell from splitting the pattern on spaces, but not from expanding it
+		rr, err := r.RunCmd(exec.Command("sudo", "sh", "-c", `IFS=; for f in $0; do if [ -e "$f" ] || [ -L "$f" ]; then echo "$f"; fi; done`, src.path))
+		if err != nil {
+			return nil, fmt.Errorf("expanding %s: %w", src.path, err)
+		}
+		var matches []string
+		for _, m := range strings.Split(rr.Stdout.String(), "\n") {
+			if m != "" {
+				matches = append(matches, m)
+			}
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no such file or directory: %s", src.path)
+		}
+		paths = append(paths, matches...)
+	}
+	return paths, nil
+}
+
+// nodeIsDir returns whether p is an existing directory on the node
+func nodeIsDir(r command.Runner, p string) bool {
+	_, err := r.RunCmd(exec.Command("sudo", "test", "-d", p))
+	return err == nil
+}
+
+// copyTargets returns the directory the sources are extracted into, and the name of each source in it.
+// The sources are copied into dst when intoDir is set or dst ends with a separator, otherwise the single source is copied as dst.
+func copyTargets(dst string, bases []string, intoDir bool, dir, base func(string) string) (string, []string) {
+	if intoDir || strings.HasSuffix(dst, "/") || strings.HasSuffix(dst, string(filepath.Separator)) {
+		return dst, bases
+	}
+	return dir(dst), []string{base(dst)}
+}
+
+// renameTopLevel returns a function renaming the top level archive entries from their base names to their target names
+func renameTopLevel(bases, names []string) func(string) string {
+	m := map[string]string{}
+	for i, b := range bases {
+		m[b] = names[i]
+	}
+	return func(name string) string {
+		first, rest, _ := strings.Cut(name, "/")
+		if n, ok := m[first]; ok {
+			first = n
+		}
+		return pt.Join(first, rest)
+	}
+}
+
 // setDstFileNameFromSrc sets the src filename as dst filename
 // when the dst file name is not provided and ends with a `/`.
 // Otherwise this function is a no-op and returns the passed dst.
@@ -165,40 +342,6 @@ func remoteCommandRunner(co *mustload.ClusterController, nodeName string) comman
 	return runner
 }
 
-func copyableFile(co *mustload.ClusterController, src, dst *remotePath) assets.CopyableFile {
-	// get assets.CopyableFile from minikube node
-	if src.node != "" {
-		runner := remoteCommandRunner(co, src.node)
-		f, err := runner.ReadableFile(src.path)
-		if err != nil {
-			out.ErrLn("%v", fmt.Errorf("getting file from %s node: %w", src.node, err))
-			os.Exit(1)
-		}
-
-		fakeWriter := func(_ []byte) (n int, err error) {
-			return 0, nil
-		}
-
-		return assets.NewBaseCopyableFile(f, fakeWriter, pt.Dir(dst.path), pt.Base(dst.path))
-	}
-
-	if _, err := os.Stat(src.path); err != nil {
-		if os.IsNotExist(err) {
-			exit.Message(reason.HostPathMissing, "Cannot find directory {{.path}} for copy", out.V{"path": src})
-		} else {
-			exit.Error(reason.HostPathStat, "stat failed", err)
-		}
-	}
-
-	fa, err := assets.NewFileAsset(src.path, pt.Dir(dst.path), pt.Base(dst.path), "0644")
-	if err != nil {
-		out.ErrLn("%v", fmt.Errorf("getting file asset: %w", err))
-		os.Exit(1)
-	}
-
-	return fa
-}
-
 func validateArgs(src, dst *remotePath) {
 	if src.path == "" {
 		exit.Message(reason.Usage, "Source {{.path}} can not be empty", out.V{"path": src.path})
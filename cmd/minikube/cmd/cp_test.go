@@ -17,7 +17,17 @@ limitations under the License.
 package cmd
 
 import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
+
+	"k8s.io/minikube/pkg/minikube/archive"
+	"k8s.io/minikube/pkg/minikube/command"
 )
 
 func TestParsePath(t *testing.T) {
@@ -83,3 +93,77 @@ func TestSetDstFileNameFromSrc(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyTargets(t *testing.T) {
+	cases := []struct {
+		dst       string
+		bases     []string
+		intoDir   bool
+		wantDir   string
+		wantNames []string
+	}{
+		{"/c/d", []string{"a"}, false, "/c", []string{"d"}},
+		{"/c/d", []string{"a"}, true, "/c/d", []string{"a"}},
+		{"/c/d/", []string{"a"}, false, "/c/d/", []string{"a"}},
+		{"/c", []string{"a", "b"}, true, "/c", []string{"a", "b"}},
+	}
+
+	for _, c := range cases {
+		dir, names := copyTargets(c.dst, c.bases, c.intoDir, path.Dir, path.Base)
+		if dir != c.wantDir || strings.Join(names, ",") != strings.Join(c.wantNames, ",") {
+			t.Errorf("copyTargets(%q, %v, %v) = %q, %v, want %q, %v", c.dst, c.bases, c.intoDir, dir, names, c.wantDir, c.wantNames)
+		}
+	}
+}
+
+func TestRenameTopLevel(t *testing.T) {
+	rename := renameTopLevel([]string{"src", "other"}, []string{"dst", "other"})
+	cases := map[string]string{
+		"src":         "dst",
+		"src/a/b":     "dst/a/b",
+		"other/a":     "other/a",
+		"srcfile":     "srcfile",
+		"unknown/src": "unknown/src",
+	}
+	for in, want := range cases {
+		if got := rename(in); got != want {
+			t.Errorf("rename(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCopyFromHost(t *testing.T) {
+	src := t.TempDir()
+	for _, name := range []string{"a.json", "b.json", "c.txt"} {
+		if err := os.WriteFile(filepath.Join(src, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	extract := (&command.RunResult{Args: archive.ExtractCmd("/data").Args}).Command()
+	f := command.NewFakeCommandRunner()
+	f.SetCommandToOutput(map[string]string{extract: ""})
+
+	srcs := []*remotePath{{path: filepath.Join(src, "*.json")}}
+	if err := copyFromHost(f, srcs, "/data"); err != nil {
+		t.Fatalf("copyFromHost: %v", err)
+	}
+
+	stream, err := f.GetFileToContents(extract)
+	if err != nil {
+		t.Fatalf("nothing was streamed to the node: %v", err)
+	}
+	var got []string
+	tr := tar.NewReader(bytes.NewBufferString(stream))
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			break
+		}
+		got = append(got, h.Name)
+	}
+	sort.Strings(got)
+	if want := "a.json,b.json"; strings.Join(got, ",") != want {
+		t.Errorf("archive entries = %v, want %s", got, want)
+	}
+}
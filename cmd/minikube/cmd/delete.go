@@ -108,6 +108,10 @@ var hostAndDirsDeleter = func(api libmachine.API, cc *config.ClusterConfig, prof
 	deleteProfileDirectory(profileName)
 	deleteMachineDirectories(cc)
 
+	if err := machine.DeleteSnapshots(profileName); err != nil {
+		out.FailureT("Failed to remove snapshots: {{.error}}", out.V{"error": err})
+	}
+
 	if err := deleteConfig(profileName); err != nil {
 		return err
 	}
@@ -602,12 +606,22 @@ func killMountProcess() error {
 		localpath.Profile(profile),
 	}
 
+	// the killed processes can't remove their NFS exports
+	defer func() {
+		if err := cluster.UnexportProfileNFS(profile); err != nil {
+			out.FailureT("Failed removing the NFS export: {{.error}}", out.V{"error": err})
+		}
+	}()
 	for _, path := range paths {
 		if err := killProcess(path); err != nil {
 			return err
 		}
 	}
 
+	// the processes serving the mounts are gone
+	if err := os.Remove(filepath.Join(localpath.Profile(profile), constants.MountPIDsFileName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("while removing mount pids file: %w", err)
+	}
 	return nil
 }
 
@@ -18,21 +18,28 @@ package cmd
 
 import (
 	"io"
+	"maps"
 	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/minikube/cmd/minikube/cmd/flags"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/image"
 	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
 	docker "k8s.io/minikube/third_party/go-dockerclient"
 )
 
@@ -355,6 +362,65 @@ $ minikube image ls
 	},
 }
 
+var (
+	imagePruneAll    bool
+	imagePruneDryRun bool
+)
+
+var pruneImageCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove unused images from the nodes",
+	Long: `Removes the dangling images, which have no tag left, from the nodes of the cluster, or with --all every image that no container uses.
+The images that Kubernetes and the enabled addons need, and the ones added with 'minikube cache add', are always kept.`,
+	Example: `
+$ minikube image prune
+
+$ minikube image prune --all --node m02 --dry-run
+`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube image prune [--node NODE] [--all] [--dry-run]")
+		}
+		options := flags.CommandOptions()
+		profile, err := config.LoadProfile(viper.GetString(config.ProfileName))
+		if err != nil {
+			exit.Error(reason.Usage, "loading profile", err)
+		}
+		name := ""
+		if nodeName != "" {
+			n, _, err := node.Retrieve(*profile.Config, nodeName)
+			if err != nil {
+				exit.Message(reason.GuestNodeRetrieve, "Node {{.name}} was not found: {{.error}}", out.V{"name": nodeName, "error": err})
+			}
+			name = n.Name
+		}
+
+		pruned, err := machine.PruneImages(profile, name, cruntime.PruneImagesOptions{All: imagePruneAll, DryRun: imagePruneDryRun}, options)
+		var total float64
+		count := 0
+		for _, m := range slices.Sorted(maps.Keys(pruned)) {
+			for _, img := range pruned[m] {
+				size, _ := strconv.ParseFloat(img.Size, 64)
+				total += size
+				count++
+				ref := img.ID
+				if len(img.RepoTags) > 0 {
+					ref = strings.Join(img.RepoTags, ", ")
+				}
+				out.Step(style.Deleted, "{{.node}}: {{.image}} ({{.size}})", out.V{"node": m, "image": ref, "size": units.HumanSizeWithPrecision(size, 3)})
+			}
+		}
+		if err != nil {
+			exit.Error(reason.GuestImageRemove, "Failed to prune images", err)
+		}
+		if imagePruneDryRun {
+			out.Styled(style.Notice, "Would free {{.size}} by removing {{.count}} images.", out.V{"size": units.HumanSizeWithPrecision(total, 3), "count": count})
+			return
+		}
+		out.Styled(style.Notice, "Freed {{.size}} by removing {{.count}} images.", out.V{"size": units.HumanSizeWithPrecision(total, 3), "count": count})
+	},
+}
+
 var tagImageCmd = &cobra.Command{
 	Use:   "tag",
 	Short: "Tag images",
@@ -420,5 +486,9 @@ func init() {
 	listImageCmd.Flags().StringVar(&format, "format", "short", "Format output. One of: short|table|json|yaml")
 	imageCmd.AddCommand(listImageCmd)
 	imageCmd.AddCommand(tagImageCmd)
+	pruneImageCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to prune the images of. Defaults to all the nodes.")
+	pruneImageCmd.Flags().BoolVar(&imagePruneAll, "all", false, "Remove every image that no container uses, not only the dangling ones")
+	pruneImageCmd.Flags().BoolVar(&imagePruneDryRun, "dry-run", false, "Only show what would be removed")
+	imageCmd.AddCommand(pruneImageCmd)
 	imageCmd.AddCommand(pushImageCmd)
 }
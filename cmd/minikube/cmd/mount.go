@@ -31,7 +31,10 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/detect"
 	"k8s.io/minikube/pkg/minikube/driver"
@@ -48,7 +51,7 @@ import (
 
 const (
 	// nineP is the value of --type used for the 9p filesystem.
-	nineP                     = "9p"
+	nineP                     = cluster.MountType9P
 	defaultMount9PVersion     = "9p2000.L"
 	mount9PVersionDescription = "Specify the 9p version that the mount should use"
 	defaultMountGID           = "docker"
@@ -61,7 +64,7 @@ const (
 	defaultMountPort          = 0
 	mountPortDescription      = "Specify the port that the mount should be setup on, where 0 means any free port."
 	defaultMountType          = nineP
-	mountTypeDescription      = "Specify the mount filesystem type (supported types: 9p)"
+	mountTypeDescription      = "Specify the mount filesystem type (supported types: 9p, virtiofs, nfs, bind)"
 	defaultMountUID           = "docker"
 	mountUIDDescription       = "Default user id used for the mount"
 )
@@ -83,14 +86,12 @@ var (
 	mountOptionsValue []string
 )
 
-// supportedFilesystems is a map of filesystem types to not warn against.
-var supportedFilesystems = map[string]bool{nineP: true}
-
 // mountCmd represents the mount command
 var mountCmd = &cobra.Command{
 	Use:   "mount [flags] <source directory>:<target directory>",
 	Short: "Mounts the specified directory into minikube",
-	Long:  `Mounts the specified directory into minikube.`,
+	Long: `Mounts the specified directory into minikube, while the command is running.
+Use 'minikube mount add' to mount directories in the background, and to mount them again on every start with --persistent.`,
 	Run: func(_ *cobra.Command, args []string) {
 		if isKill {
 			if err := killMountProcess(); err != nil {
@@ -105,23 +106,7 @@ var mountCmd = &cobra.Command{
 		}
 
 		options := flags.CommandOptions()
-		mountString := args[0]
-		idx := strings.LastIndex(mountString, ":")
-		if idx == -1 { // no ":" was present
-			exit.Message(reason.Usage, `mount argument "{{.value}}" must be in form: <source directory>:<target directory>`, out.V{"value": mountString})
-		}
-		hostPath := mountString[:idx]
-		vmPath := mountString[idx+1:]
-		if _, err := os.Stat(hostPath); err != nil {
-			if os.IsNotExist(err) {
-				exit.Message(reason.HostPathMissing, "Cannot find directory {{.path}} for mount", out.V{"path": hostPath})
-			} else {
-				exit.Error(reason.HostPathStat, "stat failed", err)
-			}
-		}
-		if len(vmPath) == 0 || !strings.HasPrefix(vmPath, "/") {
-			exit.Message(reason.Usage, "Target directory {{.path}} must be an absolute path", out.V{"path": vmPath})
-		}
+		hostPath, vmPath := parseMountArg(args[0])
 		var debugVal int
 		if klog.V(1).Enabled() {
 			debugVal = 1 // ufs.StartServer takes int debug param
@@ -131,6 +116,16 @@ var mountCmd = &cobra.Command{
 		if co.CP.Host.Driver.DriverName() == driver.None {
 			exit.Message(reason.Usage, `'none' driver does not support 'minikube mount' command`)
 		}
+		if err := cluster.ValidateMountType(co.Config.Driver, mountType); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+		if err := validateMountIDs(mountType, uid, gid); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+		if mountType == cluster.MountTypeBind || mountType == cluster.MountTypeVirtiofs {
+			mountShared(co, hostPath, vmPath)
+			return
+		}
 		if driver.IsQEMU(co.Config.Driver) && pkgnetwork.IsBuiltinQEMU(co.Config.Network) {
 			msg := "minikube mount is not currently implemented with the builtin network on QEMU"
 			if runtime.GOOS == "darwin" {
@@ -188,42 +183,59 @@ var mountCmd = &cobra.Command{
 			cfg.Options[parts[0]] = parts[1]
 		}
 
-		if runtime.GOOS == "linux" && !detect.IsNinePSupported() {
+		if cfg.Type == nineP && runtime.GOOS == "linux" && !detect.IsNinePSupported() {
 			exit.Message(reason.HostUnsupported, "The host does not support filesystem 9p.")
 
 		}
 
-		// An escape valve to allow future hackers to try NFS, VirtFS, or other FS types.
-		if !supportedFilesystems[cfg.Type] {
-			out.WarningT("{{.type}} is not yet a supported filesystem. We will try anyways!", out.V{"type": cfg.Type})
-		}
-
 		bindIP := ip.String() // the ip to listen on the user's host machine
 		if driver.IsKIC(co.CP.Host.Driver.DriverName()) && runtime.GOOS != "linux" {
 			bindIP = "127.0.0.1"
 		}
 		out.Step(style.Mounting, "Mounting host path {{.sourcePath}} into VM as {{.destinationPath}} ...", out.V{"sourcePath": hostPath, "destinationPath": vmPath})
 		out.Infof("Mount type:   {{.name}}", out.V{"name": cfg.Type})
-		out.Infof("User ID:      {{.userID}}", out.V{"userID": cfg.UID})
-		out.Infof("Group ID:     {{.groupID}}", out.V{"groupID": cfg.GID})
-		out.Infof("Version:      {{.version}}", out.V{"version": cfg.Version})
-		out.Infof("Message Size: {{.size}}", out.V{"size": cfg.MSize})
+		if cfg.Type == nineP {
+			out.Infof("User ID:      {{.userID}}", out.V{"userID": cfg.UID})
+			out.Infof("Group ID:     {{.groupID}}", out.V{"groupID": cfg.GID})
+			out.Infof("Version:      {{.version}}", out.V{"version": cfg.Version})
+			out.Infof("Message Size: {{.size}}", out.V{"size": cfg.MSize})
+		}
 		out.Infof("Options:      {{.options}}", out.V{"options": cfg.Options})
-		out.Infof("Bind Address: {{.Address}}", out.V{"Address": net.JoinHostPort(bindIP, fmt.Sprint(port))})
+		if cfg.Type == nineP {
+			out.Infof("Bind Address: {{.Address}}", out.V{"Address": net.JoinHostPort(bindIP, fmt.Sprint(port))})
+		}
 
 		var wg sync.WaitGroup
-		pidchan := make(chan int)
-		if cfg.Type == nineP {
-			wg.Add(1)
-			go func(pid chan int) {
-				pid <- os.Getpid()
+		pid := os.Getpid()
+		source := ip.String()
+		unexport := func() {}
+		wg.Add(1)
+		switch cfg.Type {
+		case cluster.MountTypeNFS:
+			absPath, err := filepath.Abs(hostPath)
+			if err != nil {
+				exit.Error(reason.HostPathStat, "Error resolving the directory to mount", err)
+			}
+			id := cluster.NFSExportID(co.Config.Name, absPath)
+			out.Styled(style.Fileserver, "Exporting {{.path}} with the NFS server of the host to {{.ip}} ...", out.V{"path": absPath, "ip": co.CP.IP})
+			if err := cluster.ExportNFS(id, absPath, co.CP.IP.String()); err != nil {
+				exit.Error(reason.HostMountNFS, "Error exporting the directory with the NFS server of the host", err)
+			}
+			unexport = func() {
+				if err := cluster.UnexportNFS(id); err != nil {
+					out.FailureT("Failed removing the NFS export: {{.error}}", out.V{"error": err})
+				}
+			}
+			source = fmt.Sprintf("%s:%s", ip, absPath)
+			// the NFS server of the host serves the mount, wg is only released by a signal
+		default:
+			go func() {
 				out.Styled(style.Fileserver, "Userspace file server: ")
 				ufs.StartServer(net.JoinHostPort(bindIP, strconv.Itoa(port)), debugVal, hostPath)
 				out.Step(style.Stopped, "Userspace file server is shutdown")
 				wg.Done()
-			}(pidchan)
+			}()
 		}
-		pid := <-pidchan
 
 		// Unmount if Ctrl-C or kill request is received.
 		c := make(chan os.Signal, 1)
@@ -240,13 +252,18 @@ var mountCmd = &cobra.Command{
 				if err != nil {
 					out.FailureT("Failed removing pid from pidfile: {{.error}}", out.V{"error": err})
 				}
+				if err := cluster.RemoveMountPID(co.Config.Name, vmPath); err != nil {
+					out.FailureT("Failed removing pid from pidfile: {{.error}}", out.V{"error": err})
+				}
+				unexport()
 
 				exit.Message(reason.Interrupted, "Received {{.name}} signal", out.V{"name": sig})
 			}
 		}()
 
-		err = cluster.Mount(co.CP.Runner, ip.String(), vmPath, cfg, pid)
+		err = cluster.Mount(co.CP.Runner, source, vmPath, cfg, pid)
 		if err != nil {
+			unexport()
 			if rtErr, ok := err.(*cluster.MountError); ok && rtErr.ErrorType == cluster.MountErrorConnect {
 				exit.Error(reason.GuestMountCouldNotConnect, "mount could not connect", rtErr)
 			}
@@ -271,6 +288,91 @@ func init() {
 	mountCmd.Flags().IntVar(&mSize, constants.MountMSizeFlag, defaultMountMSize, mountMSizeDescription)
 }
 
+// parseMountArg returns the host and node directories of a <source directory>:<target directory> argument
+func parseMountArg(mountString string) (string, string) {
+	idx := strings.LastIndex(mountString, ":")
+	if idx == -1 { // no ":" was present
+		exit.Message(reason.Usage, `mount argument "{{.value}}" must be in form: <source directory>:<target directory>`, out.V{"value": mountString})
+	}
+	hostPath := mountString[:idx]
+	vmPath := mountString[idx+1:]
+	if _, err := os.Stat(hostPath); err != nil {
+		if os.IsNotExist(err) {
+			exit.Message(reason.HostPathMissing, "Cannot find directory {{.path}} for mount", out.V{"path": hostPath})
+		} else {
+			exit.Error(reason.HostPathStat, "stat failed", err)
+		}
+	}
+	if len(vmPath) == 0 || !strings.HasPrefix(vmPath, "/") {
+		exit.Message(reason.Usage, "Target directory {{.path}} must be an absolute path", out.V{"path": vmPath})
+	}
+	return hostPath, vmPath
+}
+
+// mountShared bind mounts hostPath at vmPath in the control plane, when hostPath is inside of the host directory
+// shared with the node from its start. No process serves the mount, so it lasts until the node stops.
+func mountShared(co mustload.ClusterController, hostPath, vmPath string) {
+	absPath, err := filepath.Abs(hostPath)
+	if err != nil {
+		exit.Error(reason.HostPathStat, "Error resolving the directory to mount", err)
+	}
+	sharedHost, sharedNode, ok := sharedDir(co.Config, mountType)
+	if !ok {
+		exit.Message(reason.Usage, "The {{.type}} mount type needs a host directory shared with the node from its start, try starting minikube with '--mount-string=<host directory>:<node directory> --mount-type={{.type}}'", out.V{"type": mountType})
+	}
+	src, ok := cluster.SharedPath(sharedHost, sharedNode, absPath)
+	if !ok {
+		exit.Message(reason.Usage, "{{.path}} is not inside of {{.shared}}, the host directory shared with the node", out.V{"path": absPath, "shared": sharedHost})
+	}
+
+	cfg := &cluster.MountConfig{Type: cluster.MountTypeBind, Options: map[string]string{}}
+	for _, o := range mountOptionsValue {
+		k, v, _ := strings.Cut(o, "=")
+		cfg.Options[k] = v
+	}
+	out.Step(style.Mounting, "Mounting host path {{.sourcePath}} into VM as {{.destinationPath}} ...", out.V{"sourcePath": absPath, "destinationPath": vmPath})
+	out.Infof("Mount type:   {{.name}}", out.V{"name": mountType})
+	out.Infof("Node path:    {{.path}}", out.V{"path": src})
+	if err := cluster.Mount(co.CP.Runner, src, vmPath, cfg, 0); err != nil {
+		exit.Error(reason.GuestMount, "mount failed", err)
+	}
+	out.Step(style.Success, "Successfully mounted {{.sourcePath}} to {{.destinationPath}}", out.V{"sourcePath": absPath, "destinationPath": vmPath})
+	out.Ln("")
+	out.Styled(style.Notice, "NOTE: The mount does not need this process, unmount it with 'minikube ssh -- sudo umount {{.path}}'", out.V{"path": vmPath})
+}
+
+// validateMountIDs rejects a user or group other than the default for the mount types which can't map them:
+// the nfs export maps every access to the host user, and virtiofs and bind mounts keep the owners of the host files
+func validateMountIDs(mountType, uid, gid string) error {
+	if mountType == nineP || (uid == defaultMountUID && gid == defaultMountGID) {
+		return nil
+	}
+	return fmt.Errorf("the %s mount type does not support setting the user and group IDs, the files keep the owners of the host", mountType)
+}
+
+// sharedDir returns the host directory shared with the nodes from their start by the mount string, and its path in the nodes.
+// Containers share it as a volume and VMs with a virtiofs device.
+func sharedDir(cc *config.ClusterConfig, mountType string) (string, string, bool) {
+	if cc.MountString == "" {
+		return "", "", false
+	}
+	switch {
+	case driver.IsKIC(cc.Driver) && mountType == cluster.MountTypeBind:
+		m, err := oci.ParseMountString(cc.MountString)
+		if err != nil || m.HostPath == "" {
+			return "", "", false
+		}
+		return m.HostPath, m.ContainerPath, true
+	case (driver.SupportsVirtiofsMounts(cc.Driver) || cc.MountType == cluster.MountTypeVirtiofs) && mountType == cluster.MountTypeVirtiofs:
+		m, err := virtiofs.ParseMount(cc.MountString)
+		if err != nil {
+			return "", "", false
+		}
+		return m.HostPath, m.GuestPath, true
+	}
+	return "", "", false
+}
+
 // getPort uses the requested port or asks the kernel for a free open port that is ready to use
 func getPort() (int, error) {
 	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", mountPort))
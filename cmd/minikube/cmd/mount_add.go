@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// mountWaitTimeout is how long to wait for a mount served in the background to be done
+const mountWaitTimeout = time.Minute
+
+// placeholders for flag values
+var (
+	mountAddType       string
+	mountAddUID        string
+	mountAddGID        string
+	mountAddOptions    []string
+	mountAddPersistent bool
+)
+
+var mountAddCmd = &cobra.Command{
+	Use:   "add [flags] <source directory>:<target directory>",
+	Short: "Mounts a directory into minikube in the background.",
+	Long: `Mounts the specified directory into the primary control-plane node, served by a process in the background until minikube stop.
+Persistent mounts are mounted again by every minikube start.`,
+	Example: `minikube mount add --persistent ~/src/api:/src/api
+minikube mount add --type nfs ~/data:/data`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube mount add <source directory>:<target directory>")
+		}
+		hostPath, nodePath := parseMountArg(args[0])
+		hostPath, err := filepath.Abs(hostPath)
+		if err != nil {
+			exit.Error(reason.HostPathStat, "Error resolving the directory to mount", err)
+		}
+
+		options := flags.CommandOptions()
+		co := mustload.Running(ClusterFlagValue(), options)
+		if driver.IsNone(co.Config.Driver) {
+			exit.Message(reason.Usage, `'none' driver does not support 'minikube mount' command`)
+		}
+		if err := cluster.ValidateMountType(co.Config.Driver, mountAddType); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+		if err := validateMountIDs(mountAddType, mountAddUID, mountAddGID); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+		for _, m := range co.Config.Mounts {
+			if m.NodePath == nodePath {
+				exit.Message(reason.Usage, "{{.path}} is already mounted from {{.host}}, remove it first with: minikube mount remove {{.path}}", out.V{"path": nodePath, "host": m.HostPath})
+			}
+		}
+
+		m := config.Mount{
+			HostPath:   hostPath,
+			NodePath:   nodePath,
+			Type:       mountAddType,
+			UID:        mountAddUID,
+			GID:        mountAddGID,
+			Options:    mountAddOptions,
+			Persistent: mountAddPersistent,
+		}
+		out.Step(style.Mounting, "Mounting host path {{.sourcePath}} into VM as {{.destinationPath}} ...", out.V{"sourcePath": hostPath, "destinationPath": nodePath})
+		pid, err := node.StartMount(co.Config.Name, m)
+		if err != nil {
+			exit.Error(reason.GuestMount, "mount failed", err)
+		}
+		if pid != 0 {
+			if err := waitForMount(co.Config.Name, nodePath, pid, mountWaitTimeout); err != nil {
+				exit.Message(reason.GuestMount, "mount failed: {{.error}}, run 'minikube mount {{.mount}}' to see its output", out.V{"error": err, "mount": hostPath + ":" + nodePath})
+			}
+		}
+
+		co.Config.Mounts = append(co.Config.Mounts, m)
+		if err := config.SaveProfile(co.Config.Name, co.Config); err != nil {
+			exit.Error(reason.HostSaveProfile, "saving profile", err)
+		}
+		out.Step(style.Success, "Successfully mounted {{.sourcePath}} to {{.destinationPath}}", out.V{"sourcePath": hostPath, "destinationPath": nodePath})
+		if !m.Persistent {
+			out.Styled(style.Tip, "The mount lasts until minikube stop, add it with --persistent to mount it again on every start")
+		}
+	},
+}
+
+// waitForMount waits for the process serving a mount in the background to record its pid, which it does once mounted
+func waitForMount(profile, target string, pid int, timeout time.Duration) error {
+	for start := time.Now(); time.Since(start) < timeout; time.Sleep(500 * time.Millisecond) {
+		pids, err := cluster.MountPIDs(profile)
+		if err != nil {
+			return err
+		}
+		if pids[target] == pid {
+			return nil
+		}
+		if running, _ := isMinikubeProcess(pid); !running {
+			return fmt.Errorf("the mount process exited")
+		}
+	}
+	return fmt.Errorf("timed out after %s", timeout)
+}
+
+func init() {
+	mountAddCmd.Flags().StringVar(&mountAddType, constants.MountTypeFlag, defaultMountType, mountTypeDescription)
+	mountAddCmd.Flags().StringVar(&mountAddUID, constants.MountUIDFlag, defaultMountUID, mountUIDDescription)
+	mountAddCmd.Flags().StringVar(&mountAddGID, constants.MountGIDFlag, defaultMountGID, mountGIDDescription)
+	mountAddCmd.Flags().StringSliceVar(&mountAddOptions, constants.MountOptionsFlag, defaultMountOptions(), mountOptionsDescription)
+	mountAddCmd.Flags().BoolVar(&mountAddPersistent, "persistent", false, "Mount the directory again on every minikube start")
+	mountCmd.AddCommand(mountAddCmd)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var mountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the mounts added with minikube mount add.",
+	Long:  "Lists the mounts of a cluster added with minikube mount add, with the process serving each of them.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube mount list")
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		api.Close()
+		if len(cc.Mounts) == 0 {
+			out.Styled(style.Empty, "No mounts found. To add one, run: minikube mount add --persistent <source directory>:<target directory>")
+			return
+		}
+		pids, err := cluster.MountPIDs(cc.Name)
+		if err != nil {
+			klog.Warningf("error loading mount pids: %v", err)
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header("Host Path", "Node Path", "Type", "Persistent", "PID")
+		table.Options(
+			tablewriter.WithHeaderAutoFormat(tw.Off),
+		)
+		if err := table.Bulk(mountTable(cc.Mounts, pids, func(pid int) bool {
+			running, _ := isMinikubeProcess(pid)
+			return running
+		})); err != nil {
+			klog.Error("Error while bulk render table: ", err)
+		}
+		if err := table.Render(); err != nil {
+			klog.Error("Error while rendering mount table: ", err)
+		}
+	},
+}
+
+// mountTable returns a table row per mount, with the pid of the process serving it if it is running
+func mountTable(mounts []config.Mount, pids map[string]int, running func(int) bool) [][]string {
+	data := [][]string{}
+	for _, m := range mounts {
+		pid := "-"
+		if p := pids[m.NodePath]; p != 0 && running(p) {
+			pid = strconv.Itoa(p)
+		}
+		persistent := "no"
+		if m.Persistent {
+			persistent = "yes"
+		}
+		data = append(data, []string{m.HostPath, m.NodePath, m.Type, persistent, pid})
+	}
+	return data
+}
+
+func init() {
+	mountCmd.AddCommand(mountListCmd)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/libmachine/state"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var mountRemoveCmd = &cobra.Command{
+	Use:   "remove <target directory>",
+	Short: "Removes a mount added with minikube mount add.",
+	Long:  "Stops the process serving a mount added with minikube mount add, unmounts it from the node and forgets it.",
+	Example: `minikube mount remove /src/api
+minikube mount remove ~/src/api:/src/api`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube mount remove <target directory>")
+		}
+		nodePath := args[0]
+		if idx := strings.LastIndex(nodePath, ":"); idx != -1 {
+			nodePath = nodePath[idx+1:]
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		var kept []config.Mount
+		var removed config.Mount
+		for _, m := range cc.Mounts {
+			if m.NodePath != nodePath {
+				kept = append(kept, m)
+				continue
+			}
+			removed = m
+		}
+		if len(kept) == len(cc.Mounts) {
+			api.Close()
+			exit.Message(reason.Usage, "No mount found at {{.path}}, see: minikube mount list", out.V{"path": nodePath})
+		}
+
+		pids, err := cluster.MountPIDs(cc.Name)
+		if err != nil {
+			klog.Warningf("error loading mount pids: %v", err)
+		}
+		if pid := pids[nodePath]; pid != 0 {
+			if err := trySigKillProcess(pid); err != nil {
+				klog.Warningf("killing mount process %d: %v", pid, err)
+			}
+			if err := removePidFromFile(pid); err != nil {
+				out.FailureT("Failed removing pid from pidfile: {{.error}}", out.V{"error": err})
+			}
+			if err := cluster.RemoveMountPID(cc.Name, nodePath); err != nil {
+				out.FailureT("Failed removing pid from pidfile: {{.error}}", out.V{"error": err})
+			}
+		}
+		if removed.Type == cluster.MountTypeNFS {
+			// the killed process can't remove its NFS export
+			if err := cluster.UnexportNFS(cluster.NFSExportID(cc.Name, removed.HostPath)); err != nil {
+				out.FailureT("Failed removing the NFS export: {{.error}}", out.V{"error": err})
+			}
+		}
+
+		running := false
+		if cp, err := config.ControlPlane(*cc); err == nil {
+			st, err := machine.Status(api, config.MachineName(*cc, cp))
+			running = err == nil && st == state.Running.String()
+		}
+		api.Close()
+		if running {
+			co := mustload.Running(cc.Name, options)
+			out.Step(style.Unmount, "Unmounting {{.path}} ...", out.V{"path": nodePath})
+			if err := cluster.Unmount(co.CP.Runner, nodePath); err != nil {
+				out.FailureT("Failed unmount: {{.error}}", out.V{"error": err})
+			}
+		}
+
+		cc.Mounts = kept
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "saving profile", err)
+		}
+		out.Step(style.Deleted, "Removed the mount at {{.path}}", out.V{"path": nodePath})
+	},
+}
+
+func init() {
+	mountCmd.AddCommand(mountRemoveCmd)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// networkCmd represents the set of network subcommands
+var networkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "Emulate network conditions on the nodes of a cluster",
+	Long:  "Operations on the network of the nodes of a cluster",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube network [shape]")
+	},
+}
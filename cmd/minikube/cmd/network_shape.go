@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/netshape"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	shapeLatency time.Duration
+	shapeJitter  time.Duration
+	shapeLoss    string
+	shapeRate    string
+	shapeClear   bool
+)
+
+var networkShapeCmd = &cobra.Command{
+	Use:   "shape",
+	Short: "Emulates latency, packet loss and bandwidth limits on the network of nodes",
+	Long: `Emulates latency, packet loss and bandwidth limits on the traffic leaving nodes, towards the other nodes and the host, with tc qdiscs.
+The shaping is kept in the cluster config and applied again when the nodes are started.`,
+	Example: `minikube network shape --node m02 --latency 100ms --jitter 10ms --loss 1% --rate 10mbit
+minikube network shape --clear`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube network shape [--node NODE] [--latency DURATION] [--jitter DURATION] [--loss PERCENT] [--rate RATE] [--clear]")
+		}
+		shaping := cmd.Flags().Changed("latency") || cmd.Flags().Changed("jitter") || cmd.Flags().Changed("loss") || cmd.Flags().Changed("rate")
+		if shaping == shapeClear {
+			exit.Message(reason.Usage, "Either --clear, or at least one of --latency, --jitter, --loss and --rate, must be given")
+		}
+
+		var shape *config.NetworkShape
+		if shaping {
+			loss, err := netshape.ParseLoss(shapeLoss)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid --loss: {{.error}}", out.V{"error": err})
+			}
+			shape = &config.NetworkShape{Latency: shapeLatency, Jitter: shapeJitter, Loss: loss, Rate: shapeRate}
+			if err := netshape.Validate(*shape); err != nil {
+				exit.Message(reason.Usage, "Invalid network shape: {{.error}}", out.V{"error": err})
+			}
+		}
+
+		co := mustload.Running(ClusterFlagValue(), flags.CommandOptions())
+		if driver.IsNone(co.Config.Driver) {
+			exit.Message(reason.Usage, "The none driver runs Kubernetes on the host, whose network cannot be shaped")
+		}
+
+		nodes := co.Config.Nodes
+		if nodeName != "" {
+			n, _, err := node.Retrieve(*co.Config, nodeName)
+			if err != nil {
+				exit.Message(reason.GuestNodeRetrieve, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeName})
+			}
+			nodes = []config.Node{*n}
+		}
+
+		for _, n := range nodes {
+			machineName := config.MachineName(*co.Config, n)
+			h, err := machine.GetHost(co.API, *co.Config, n)
+			if err != nil {
+				exit.Error(reason.GuestLoadHost, "Error getting host", err)
+			}
+			r, err := machine.CommandRunner(h)
+			if err != nil {
+				exit.Error(reason.InternalCommandRunner, "Failed to get command runner", err)
+			}
+
+			n.NetworkShape = shape
+			if err := netshape.ApplyNode(r, n); err != nil {
+				var me *netshape.MissingModuleError
+				if errors.As(err, &me) {
+					exit.Message(reason.GuestNetworkShapeModule, "The kernel of node {{.name}} has no {{.module}} module, which is needed to shape its network", out.V{"name": machineName, "module": me.Module})
+				}
+				exit.Error(reason.GuestNetworkShape, "Failed to shape the network of node "+machineName, err)
+			}
+			if err := config.SaveNode(co.Config, &n); err != nil {
+				exit.Error(reason.HostSaveProfile, "failed to save node", err)
+			}
+
+			if shape == nil {
+				out.Step(style.Connectivity, "Cleared the network shaping of node {{.name}}.", out.V{"name": machineName})
+				continue
+			}
+			out.Step(style.Connectivity, "Shaped the network of node {{.name}}: {{.shape}}", out.V{"name": machineName, "shape": netshape.String(*shape)})
+		}
+	},
+}
+
+func init() {
+	networkShapeCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to shape the network of. Defaults to all the nodes.")
+	networkShapeCmd.Flags().DurationVar(&shapeLatency, "latency", 0, "The latency added to the packets leaving the node, such as 100ms")
+	networkShapeCmd.Flags().DurationVar(&shapeJitter, "jitter", 0, "The random variation of the latency, such as 10ms")
+	networkShapeCmd.Flags().StringVar(&shapeLoss, "loss", "", "The percentage of packets dropped, such as 1%")
+	networkShapeCmd.Flags().StringVar(&shapeRate, "rate", "", "The bandwidth of the node, in the tc rate syntax, such as 10mbit")
+	networkShapeCmd.Flags().BoolVar(&shapeClear, "clear", false, "Remove the shaping of the network")
+	networkCmd.AddCommand(networkShapeCmd)
+}
@@ -32,12 +32,18 @@ import (
 	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util"
 )
 
 var (
 	cpNode              bool
 	workerNode          bool
 	deleteNodeOnFailure bool
+	nodeCPUs            int
+	nodeMemory          string
+	nodeDiskSize        string
+	nodeLabels          map[string]string
+	nodeTaints          []string
 )
 
 var nodeAddCmd = &cobra.Command{
@@ -80,22 +86,12 @@ var nodeAddCmd = &cobra.Command{
 			Worker:            workerNode,
 			ControlPlane:      cpNode,
 			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			Labels:            nodeLabels,
+			Taints:            nodeTaints,
 		}
+		setNodeResources(cmd, cc.Driver, &n)
 
-		if len(cc.Nodes) == 1 {
-			if viper.GetString(memory) == "" {
-				sysLimit, containerLimit, err := memoryLimits(cc.Driver)
-				if err != nil {
-					klog.Warningf("Unable to query memory limits: %v", err)
-				}
-				totalNodes := len(cc.Nodes) + 1
-				cc.Memory = suggestMemoryAllocation(sysLimit, containerLimit, totalNodes)
-			}
-
-			if !cc.MultiNodeRequested && cni.IsDisabled(*cc) {
-				warnAboutMultiNodeCNI()
-			}
-		}
+		prepareMultiNode(cc, len(cc.Nodes)+1, n.Memory != 0)
 
 		register.Reg.SetStep(register.InitialSetup)
 		if err := node.Add(cc, n, deleteNodeOnFailure, options); err != nil {
@@ -113,10 +109,70 @@ var nodeAddCmd = &cobra.Command{
 	},
 }
 
+// prepareMultiNode adjusts a single node cluster which is about to grow to totalNodes nodes
+func prepareMultiNode(cc *config.ClusterConfig, totalNodes int, ownMemory bool) {
+	if len(cc.Nodes) != 1 {
+		return
+	}
+
+	if viper.GetString(memory) == "" && !ownMemory {
+		sysLimit, containerLimit, err := memoryLimits(cc.Driver)
+		if err != nil {
+			klog.Warningf("Unable to query memory limits: %v", err)
+		}
+		cc.Memory = suggestMemoryAllocation(sysLimit, containerLimit, totalNodes)
+	}
+
+	if !cc.MultiNodeRequested && cni.IsDisabled(*cc) {
+		warnAboutMultiNodeCNI()
+	}
+}
+
+// setNodeResources validates the resources, labels and taints requested for the new node and sets them on n
+func setNodeResources(cmd *cobra.Command, drvName string, n *config.Node) {
+	if cmd.Flags().Changed(cpus) {
+		if nodeCPUs < minimumCPUS {
+			exit.Message(reason.RsrcInsufficientCores, "Requested cpu count {{.requested_cpus}} is less than the minimum allowed of {{.minimum_cpus}}", out.V{"requested_cpus": nodeCPUs, "minimum_cpus": minimumCPUS})
+		}
+		n.CPUs = nodeCPUs
+	}
+	if cmd.Flags().Changed(memory) {
+		mem, err := util.CalculateSizeInMB(nodeMemory)
+		if err != nil {
+			exit.Message(reason.Usage, "Invalid memory size {{.memory}}: {{.error}}", out.V{"memory": nodeMemory, "error": err})
+		}
+		validateRequestedMemorySize(mem, drvName)
+		n.Memory = mem
+	}
+	if cmd.Flags().Changed(humanReadableDiskSize) {
+		if err := validateDiskSize(nodeDiskSize); err != nil {
+			exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+		}
+		if driver.IsKIC(drvName) {
+			out.WarningT("The {{.driver}} driver does not limit the disk size of nodes, ignoring --disk-size", out.V{"driver": drvName})
+		} else {
+			// validated above
+			n.DiskSize, _ = util.CalculateSizeInMB(nodeDiskSize)
+		}
+	}
+	if err := node.ValidateLabels(n.Labels); err != nil {
+		exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+	}
+	if err := node.ValidateTaints(n.Taints); err != nil {
+		exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+	}
+}
+
 func init() {
 	nodeAddCmd.Flags().BoolVar(&cpNode, "control-plane", false, "If set, added node will become a control-plane. Defaults to false. Currently only supported for existing HA (multi-control plane) clusters.")
 	nodeAddCmd.Flags().BoolVar(&workerNode, "worker", true, "If set, added node will be available as worker. Defaults to true.")
 	nodeAddCmd.Flags().BoolVar(&deleteNodeOnFailure, "delete-on-failure", false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
 
+	nodeAddCmd.Flags().IntVar(&nodeCPUs, cpus, 0, "Number of CPUs allocated to the new node. Defaults to the CPUs of the cluster.")
+	nodeAddCmd.Flags().StringVar(&nodeMemory, memory, "", "Amount of RAM allocated to the new node (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the memory of the cluster.")
+	nodeAddCmd.Flags().StringVar(&nodeDiskSize, humanReadableDiskSize, "", "Disk size allocated to the new node (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the disk size of the cluster. Not supported by the docker and podman drivers.")
+	nodeAddCmd.Flags().StringToStringVar(&nodeLabels, "labels", nil, "Labels to apply to the new Kubernetes node, for example: --labels=accelerator=gpu,size=large")
+	nodeAddCmd.Flags().StringSliceVar(&nodeTaints, "taints", nil, "Taints to apply to the new Kubernetes node in the key[=value]:effect form, for example: --taints=accelerator=gpu:NoSchedule")
+
 	nodeCmd.AddCommand(nodeAddCmd)
 }
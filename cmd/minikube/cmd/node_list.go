@@ -48,8 +48,12 @@ var nodeListCmd = &cobra.Command{
 			klog.Infof("%v", cc.Nodes)
 		}
 
-		for _, n := range cc.Nodes {
+		for _, n := range config.NodesByPool(*cc) {
 			machineName := config.MachineName(*cc, n)
+			if n.Pool != "" {
+				fmt.Printf("%s\t%s\t%s\n", machineName, n.IP, n.Pool)
+				continue
+			}
 			fmt.Printf("%s\t%s\n", machineName, n.IP)
 		}
 		os.Exit(0)
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	poolCount        int
+	poolDrainTimeout time.Duration
+	poolMinNodes     int
+	poolMaxNodes     int
+)
+
+// nodePoolCmd represents the set of nodepool subcommands
+var nodePoolCmd = &cobra.Command{
+	Use:   "nodepool",
+	Short: "Create, scale, or delete named pools of worker nodes",
+	Long:  "Operations on node pools, named groups of worker nodes sharing the same resources, labels and taints",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube nodepool [create|scale|autoscale|delete]")
+	},
+}
+
+// mustFindPool returns the named node pool of the cluster, exiting if it does not exist
+func mustFindPool(cc *config.ClusterConfig, name string) config.NodePool {
+	pool, err := node.FindPool(*cc, name)
+	if err != nil {
+		exit.Message(reason.GuestNodeRetrieve, "Node pool {{.name}} does not exist in cluster {{.cluster}}", out.V{"name": name, "cluster": cc.Name})
+	}
+	return *pool
+}
+
+// scaleNodePool brings the pool to count nodes, exiting on failure
+func scaleNodePool(cc *config.ClusterConfig, pool config.NodePool, count int, options *run.CommandOptions) {
+	if grow := count - len(node.PoolNodes(*cc, pool.Name)); grow > 0 {
+		prepareMultiNode(cc, len(cc.Nodes)+grow, pool.Memory != 0)
+	}
+
+	if err := node.ScalePool(cc, pool, count, poolDrainTimeout, options); err != nil {
+		exit.Error(reason.GuestNodePoolScale, "failed to scale node pool", err)
+	}
+	out.Step(style.Ready, "Node pool {{.name}} has {{.count}} nodes.", out.V{"name": pool.Name, "count": count})
+}
+
+// validateAutoscaleBounds checks the --min-nodes and --max-nodes flags
+func validateAutoscaleBounds() {
+	if poolMinNodes < 0 || poolMaxNodes < 0 {
+		exit.Message(reason.Usage, "--min-nodes and --max-nodes must not be negative")
+	}
+	if poolMaxNodes > 0 && poolMinNodes > poolMaxNodes {
+		exit.Message(reason.Usage, "--min-nodes {{.min}} is greater than --max-nodes {{.max}}", out.V{"min": poolMinNodes, "max": poolMaxNodes})
+	}
+}
+
+func addAutoscaleFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&poolMinNodes, "min-nodes", 0, "Minimum number of nodes the cluster autoscaler keeps in the pool.")
+	cmd.Flags().IntVar(&poolMaxNodes, "max-nodes", 0, "Maximum number of nodes the cluster autoscaler scales the pool to. The autoscaler leaves the pool alone when 0.")
+}
+
+func addDrainTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&poolDrainTimeout, "drain-timeout", 5*time.Minute, "How long to wait for the pods of a removed node to be evicted, for example while a PodDisruptionBudget blocks the eviction")
+}
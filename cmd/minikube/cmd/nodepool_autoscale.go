@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var nodePoolAutoscaleCmd = &cobra.Command{
+	Use:   "autoscale POOL_NAME",
+	Short: "Sets the bounds within which the cluster autoscaler scales a node pool.",
+	Long: `Sets the minimum and maximum number of nodes of a node pool, within which 'minikube autoscaler' lets the cluster autoscaler scale it.
+Setting --max-nodes to 0 stops autoscaling the pool. A running 'minikube autoscaler' picks up the change.`,
+	Example: "minikube nodepool autoscale workers --min-nodes=1 --max-nodes=5",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool autoscale POOL_NAME --min-nodes=N --max-nodes=M")
+		}
+		validateAutoscaleBounds()
+
+		options := flags.CommandOptions()
+		_, cc := mustload.Partial(ClusterFlagValue(), options)
+		pool := mustFindPool(cc, args[0])
+
+		for i := range cc.NodePools {
+			if cc.NodePools[i].Name == pool.Name {
+				cc.NodePools[i].MinNodes = poolMinNodes
+				cc.NodePools[i].MaxNodes = poolMaxNodes
+			}
+		}
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+
+		if poolMaxNodes == 0 {
+			out.Step(style.Ready, "Node pool {{.name}} is no longer autoscaled.", out.V{"name": pool.Name})
+			return
+		}
+		out.Step(style.Ready, "Node pool {{.name}} is autoscaled between {{.min}} and {{.max}} nodes.", out.V{"name": pool.Name, "min": poolMinNodes, "max": poolMaxNodes})
+	},
+}
+
+func init() {
+	addAutoscaleFlags(nodePoolAutoscaleCmd)
+	nodePoolCmd.AddCommand(nodePoolAutoscaleCmd)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var nodePoolCreateCmd = &cobra.Command{
+	Use:     "create POOL_NAME",
+	Short:   "Creates a node pool and starts its nodes.",
+	Long:    "Creates a named pool of worker nodes sharing the same resources, labels and taints, and starts its nodes in parallel. The nodes are labeled with " + node.PoolLabel + "=POOL_NAME.",
+	Example: "minikube nodepool create workers --count 3 --labels tier=web",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool create POOL_NAME [flags]")
+		}
+		name := args[0]
+		if err := node.ValidatePoolName(name); err != nil {
+			exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+		}
+		if poolCount < 0 {
+			exit.Message(reason.Usage, "The node count must not be negative, got {{.count}}", out.V{"count": poolCount})
+		}
+		validateAutoscaleBounds()
+		if poolMaxNodes > 0 && (poolCount < poolMinNodes || poolCount > poolMaxNodes) {
+			exit.Message(reason.Usage, "The node count {{.count}} is outside of the autoscaling bounds {{.min}}-{{.max}}", out.V{"count": poolCount, "min": poolMinNodes, "max": poolMaxNodes})
+		}
+		if _, ok := nodeLabels[node.PoolLabel]; ok {
+			exit.Message(reason.Usage, "The {{.label}} label is set by minikube and cannot be overridden", out.V{"label": node.PoolLabel})
+		}
+
+		options := flags.CommandOptions()
+		co := mustload.Healthy(ClusterFlagValue(), options)
+		cc := co.Config
+
+		if driver.BareMetal(cc.Driver) {
+			exit.Message(reason.DrvUnsupportedMulti, "none driver does not support multi-node clusters")
+		}
+		if _, err := node.FindPool(*cc, name); err == nil {
+			exit.Message(reason.Usage, "Node pool {{.name}} already exists in cluster {{.cluster}}", out.V{"name": name, "cluster": cc.Name})
+		}
+
+		n := config.Node{Labels: nodeLabels, Taints: nodeTaints}
+		setNodeResources(cmd, cc.Driver, &n)
+		pool := config.NodePool{
+			Name:     name,
+			CPUs:     n.CPUs,
+			Memory:   n.Memory,
+			DiskSize: n.DiskSize,
+			Labels:   n.Labels,
+			Taints:   n.Taints,
+			MinNodes: poolMinNodes,
+			MaxNodes: poolMaxNodes,
+		}
+
+		cc.NodePools = append(cc.NodePools, pool)
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+
+		out.Step(style.Happy, "Creating node pool {{.name}} with {{.count}} nodes in cluster {{.cluster}}", out.V{"name": name, "count": poolCount, "cluster": cc.Name})
+		register.Reg.SetStep(register.InitialSetup)
+		scaleNodePool(cc, pool, poolCount, options)
+	},
+}
+
+func init() {
+	nodePoolCreateCmd.Flags().IntVar(&poolCount, "count", 1, "Number of nodes in the pool.")
+	nodePoolCreateCmd.Flags().IntVar(&nodeCPUs, cpus, 0, "Number of CPUs allocated to each node of the pool. Defaults to the CPUs of the cluster.")
+	nodePoolCreateCmd.Flags().StringVar(&nodeMemory, memory, "", "Amount of RAM allocated to each node of the pool (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the memory of the cluster.")
+	nodePoolCreateCmd.Flags().StringVar(&nodeDiskSize, humanReadableDiskSize, "", "Disk size allocated to each node of the pool (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the disk size of the cluster. Not supported by the docker and podman drivers.")
+	nodePoolCreateCmd.Flags().StringToStringVar(&nodeLabels, "labels", nil, "Labels to apply to the Kubernetes nodes of the pool, for example: --labels=tier=web")
+	nodePoolCreateCmd.Flags().StringSliceVar(&nodeTaints, "taints", nil, "Taints to apply to the Kubernetes nodes of the pool in the key[=value]:effect form, for example: --taints=dedicated=web:NoSchedule")
+	addAutoscaleFlags(nodePoolCreateCmd)
+
+	nodePoolCmd.AddCommand(nodePoolCreateCmd)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var nodePoolDeleteCmd = &cobra.Command{
+	Use:     "delete POOL_NAME",
+	Short:   "Deletes a node pool and its nodes.",
+	Long:    "Drains and deletes the nodes of a node pool in parallel, then deletes the pool.",
+	Example: "minikube nodepool delete workers",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool delete POOL_NAME")
+		}
+
+		options := flags.CommandOptions()
+		co := mustload.Healthy(ClusterFlagValue(), options)
+		cc := co.Config
+		pool := mustFindPool(cc, args[0])
+
+		out.Step(style.DeletingHost, "Deleting node pool {{.name}} from cluster {{.cluster}}", out.V{"name": pool.Name, "cluster": cc.Name})
+		if err := node.ScalePool(cc, pool, 0, poolDrainTimeout, options); err != nil {
+			exit.Error(reason.GuestNodePoolScale, "failed to delete the nodes of the node pool", err)
+		}
+
+		cc.NodePools = slices.DeleteFunc(cc.NodePools, func(p config.NodePool) bool { return p.Name == pool.Name })
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+		out.Step(style.Deleted, "Node pool {{.name}} was successfully deleted.", out.V{"name": pool.Name})
+	},
+}
+
+func init() {
+	addDrainTimeoutFlag(nodePoolDeleteCmd)
+	nodePoolCmd.AddCommand(nodePoolDeleteCmd)
+}
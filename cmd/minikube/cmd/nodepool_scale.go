@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+var nodePoolScaleCmd = &cobra.Command{
+	Use:   "scale POOL_NAME COUNT",
+	Short: "Scales a node pool to the given number of nodes.",
+	Long: `Adds or removes nodes of a node pool, in parallel, until it has the given number of nodes.
+Nodes are removed newest first. Their pods are evicted, respecting PodDisruptionBudgets, and a node whose pods cannot be evicted within --drain-timeout is kept.`,
+	Example: "minikube nodepool scale workers 5",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 2 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool scale POOL_NAME COUNT")
+		}
+		count, err := strconv.Atoi(args[1])
+		if err != nil || count < 0 {
+			exit.Message(reason.Usage, "Invalid node count {{.count}}: must be a non-negative number", out.V{"count": args[1]})
+		}
+
+		options := flags.CommandOptions()
+		co := mustload.Healthy(ClusterFlagValue(), options)
+		pool := mustFindPool(co.Config, args[0])
+		if pool.MaxNodes > 0 && (count < pool.MinNodes || count > pool.MaxNodes) {
+			exit.Message(reason.Usage, "The node count {{.count}} is outside of the autoscaling bounds {{.min}}-{{.max}}", out.V{"count": count, "min": pool.MinNodes, "max": pool.MaxNodes})
+		}
+
+		register.Reg.SetStep(register.InitialSetup)
+		scaleNodePool(co.Config, pool, count, options)
+	},
+}
+
+func init() {
+	addDrainTimeoutFlag(nodePoolScaleCmd)
+	nodePoolCmd.AddCommand(nodePoolScaleCmd)
+}
@@ -251,6 +251,7 @@ func init() {
 				configCmd.ConfigCmd,
 				configCmd.ProfileCmd,
 				updateContextCmd,
+				upgradeCmd,
 			},
 		},
 		{
@@ -267,7 +268,14 @@ func init() {
 				sshCmd,
 				kubectlCmd,
 				nodeCmd,
+				nodePoolCmd,
+				autoscalerCmd,
 				cpCmd,
+				syncCmd,
+				snapshotCmd,
+				networkCmd,
+				chaosCmd,
+				scheduleCmd,
 			},
 		},
 		{
@@ -277,6 +285,7 @@ func init() {
 				sshHostCmd,
 				ipCmd,
 				logsCmd,
+				auditCmd,
 				updateCheckCmd,
 				versionCmd,
 				optionsCmd,
@@ -323,7 +332,8 @@ func setupViper() {
 	viper.SetDefault(config.ReminderWaitPeriodInHours, 24)
 	viper.SetDefault(config.WantNoneDriverWarning, true)
 	viper.SetDefault(config.WantVirtualBoxDriverWarning, true)
-	viper.SetDefault(config.MaxAuditEntries, 1000)
+	viper.SetDefault(config.MaxAuditFileSize, "10mb")
+	viper.SetDefault(config.MaxAuditFiles, 5)
 	viper.SetDefault(config.SkipAuditFlag, false)
 }
 
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/schedule"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var scheduleAddCmd = &cobra.Command{
+	Use:   "add [start|stop] SCHEDULE",
+	Short: "Adds a recurring start or stop of a cluster.",
+	Long: `Adds a recurring start or stop of a cluster, in the local timezone.
+SCHEDULE is either "HH:MM [days]", where days is daily (the default), weekdays, weekends or a list such as mon,wed,fri, or a five field cron expression.`,
+	Example: `minikube schedule add stop "19:00 weekdays"
+minikube schedule add start "08:30 weekdays"
+minikube schedule add stop "0 */2 * * sat,sun"`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 2 {
+			exit.Message(reason.Usage, "Usage: minikube schedule add [start|stop] SCHEDULE")
+		}
+		s := config.Schedule{Action: args[0], Spec: args[1]}
+		if err := schedule.Validate(s); err != nil {
+			exit.Message(reason.Usage, "Invalid schedule: {{.err}}", out.V{"err": err})
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		api.Close()
+		if !slices.Contains(cc.Schedules, s) {
+			cc.Schedules = append(cc.Schedules, s)
+			if err := config.SaveProfile(cc.Name, cc); err != nil {
+				exit.Error(reason.HostSaveProfile, "saving profile", err)
+			}
+		}
+		if err := schedule.EnsureScheduler(); err != nil {
+			exit.Error(reason.DaemonizeError, "starting scheduler", err)
+		}
+		out.Step(style.Waiting, "Scheduled {{.action}} of cluster {{.cluster}} at {{.spec}}", out.V{"action": s.Action, "cluster": cc.Name, "spec": s.Spec})
+	},
+}
+
+func init() {
+	scheduleCmd.AddCommand(scheduleAddCmd)
+}
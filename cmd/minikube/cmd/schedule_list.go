@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/schedule"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var scheduleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the recurring schedules of all clusters.",
+	Long:  "Lists the recurring starts and stops of all clusters, or only of the cluster given with --profile, and whether the scheduler running them is alive. The scheduler is started again if it is not, for example after a reboot.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube schedule list")
+		}
+
+		profiles, err := config.ListValidProfiles()
+		if err != nil {
+			klog.Warningf("error loading profiles: %v", err)
+		}
+		data := scheduleTable(profiles, cmd.Flags().Changed(config.ProfileName), time.Now())
+		if len(data) == 0 {
+			out.Styled(style.Empty, "No schedules found. To add one, run: minikube schedule add stop \"19:00 weekdays\"")
+			return
+		}
+		schedErr := schedule.ResumeScheduler(profiles)
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header("Profile", "Action", "Schedule", "Next")
+		table.Options(
+			tablewriter.WithHeaderAutoFormat(tw.Off),
+		)
+		if err := table.Bulk(data); err != nil {
+			klog.Error("Error while bulk render table: ", err)
+		}
+		if err := table.Render(); err != nil {
+			klog.Error("Error while rendering schedule table: ", err)
+		}
+
+		switch {
+		case schedErr != nil:
+			out.WarningT("Failed to start the scheduler, no schedule will run: {{.error}}", out.V{"error": schedErr})
+		case schedule.SchedulerRunning():
+			out.Styled(style.Running, "The scheduler is running")
+		default:
+			out.WarningT("The scheduler is not running, no schedule will run")
+		}
+	},
+}
+
+// resumeScheduler starts the scheduler again if the cluster has recurring schedules, so that they keep running after a reboot
+func resumeScheduler(cc *config.ClusterConfig) {
+	if len(cc.Schedules) == 0 {
+		return
+	}
+	if err := schedule.EnsureScheduler(); err != nil {
+		klog.Warningf("failed to start the scheduler for the schedules of %s: %v", cc.Name, err)
+	}
+}
+
+// scheduleTable returns a table row per schedule, only of the selected cluster if onlyCurrent is set
+func scheduleTable(profiles []*config.Profile, onlyCurrent bool, now time.Time) [][]string {
+	data := [][]string{}
+	for _, p := range profiles {
+		if p.Config == nil || onlyCurrent && p.Name != ClusterFlagValue() {
+			continue
+		}
+		for _, s := range p.Config.Schedules {
+			next := ""
+			if _, at := schedule.Next([]config.Schedule{s}, now); !at.IsZero() {
+				next = at.Format("Mon 2006-01-02 15:04")
+			}
+			data = append(data, []string{p.Name, s.Action, s.Spec, next})
+		}
+	}
+	return data
+}
+
+func init() {
+	scheduleCmd.AddCommand(scheduleListCmd)
+}
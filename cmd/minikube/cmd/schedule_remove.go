@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var scheduleRemoveCmd = &cobra.Command{
+	Use:   "remove [start|stop] [SCHEDULE]",
+	Short: "Removes recurring starts or stops of a cluster.",
+	Long:  "Removes the recurring schedules of a cluster that match the given action and schedule. Without arguments all schedules of the cluster are removed.",
+	Example: `minikube schedule remove stop "19:00 weekdays"
+minikube schedule remove start
+minikube schedule remove`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) > 2 {
+			exit.Message(reason.Usage, "Usage: minikube schedule remove [start|stop] [SCHEDULE]")
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		api.Close()
+
+		var kept []config.Schedule
+		for _, s := range cc.Schedules {
+			if len(args) > 0 && s.Action != args[0] || len(args) > 1 && s.Spec != args[1] {
+				kept = append(kept, s)
+			}
+		}
+		removed := len(cc.Schedules) - len(kept)
+		if removed == 0 {
+			out.Styled(style.Empty, "No matching schedules found for cluster {{.cluster}}.", out.V{"cluster": cc.Name})
+			return
+		}
+		cc.Schedules = kept
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "saving profile", err)
+		}
+		// the scheduler exits on its own once no profile has a schedule left
+		out.Step(style.Deleted, `Removed {{.count}} schedule{{if gt .count 1}}s{{end}} of cluster {{.cluster}}`, out.V{"count": removed, "cluster": cc.Name})
+	},
+}
+
+func init() {
+	scheduleCmd.AddCommand(scheduleRemoveCmd)
+}
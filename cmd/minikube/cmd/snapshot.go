@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// snapshotCmd represents the set of snapshot subcommands
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save, restore, or list snapshots of a stopped cluster",
+	Long:  "Operations on cluster snapshots. A snapshot captures the config, certificates, machines and node disks of a stopped cluster. For the docker and podman drivers only the /var volume of each node is saved, the rest of the container filesystem is left as is. Snapshots are removed with their profile by minikube delete.",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube snapshot [save|restore|list]")
+	},
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the snapshots of a cluster.",
+	Long:  "Lists the snapshots of a cluster.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube snapshot list")
+		}
+
+		cname := ClusterFlagValue()
+		snaps, err := machine.ListSnapshots(cname)
+		if err != nil {
+			exit.Error(reason.GuestSnapshotList, "listing snapshots", err)
+		}
+		if len(snaps) == 0 {
+			out.Styled(style.Empty, "No snapshots found for cluster {{.cluster}}.", out.V{"cluster": cname})
+			return
+		}
+
+		data := [][]string{}
+		for _, s := range snaps {
+			data = append(data, []string{s.Name, s.Driver, s.ContainerRuntime, s.KubernetesVersion, s.CreationTime.Format(time.RFC3339)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header("Snapshot", "Driver", "Runtime", "Version", "Created")
+		table.Options(
+			tablewriter.WithHeaderAutoFormat(tw.Off),
+		)
+		if err := table.Bulk(data); err != nil {
+			klog.Error("Error while bulk render table: ", err)
+		}
+		if err := table.Render(); err != nil {
+			klog.Error("Error while rendering snapshot table: ", err)
+		}
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotListCmd)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:     "restore SNAPSHOT_NAME",
+	Short:   "Restores a stopped cluster from a snapshot.",
+	Long:    "Replaces the config, certificates, machines and node disks of a stopped cluster with a previously saved snapshot. Nodes added after the snapshot was taken are deleted. For the docker and podman drivers only the /var volume of each node is saved, the rest of the container filesystem is left as is.",
+	Example: "minikube stop\nminikube snapshot restore baseline\nminikube start",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube snapshot restore SNAPSHOT_NAME")
+		}
+
+		options := flags.CommandOptions()
+		cname := ClusterFlagValue()
+		api, err := machine.NewAPIClient(options)
+		if err != nil {
+			exit.Error(reason.NewAPIClient, "libmachine failed", err)
+		}
+		defer api.Close()
+
+		out.Step(style.Resetting, "Restoring cluster {{.cluster}} from snapshot {{.name}} ...", out.V{"name": args[0], "cluster": cname})
+		if _, err := machine.RestoreSnapshot(api, cname, args[0]); err != nil {
+			exit.Error(reason.GuestSnapshotRestore, "restoring snapshot", err)
+		}
+		out.Step(style.Ready, "Cluster {{.cluster}} was restored from snapshot {{.name}}. Run \"minikube start\" to start it.", out.V{"name": args[0], "cluster": cname})
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var snapshotSaveCmd = &cobra.Command{
+	Use:     "save SNAPSHOT_NAME",
+	Short:   "Saves a snapshot of a stopped cluster.",
+	Long:    "Saves the config, certificates, machines and node disks of a stopped cluster, so that it can be restored later. For the docker and podman drivers only the /var volume of each node is saved, the rest of the container filesystem is left as is.",
+	Example: "minikube stop\nminikube snapshot save baseline",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube snapshot save SNAPSHOT_NAME")
+		}
+
+		options := flags.CommandOptions()
+		api, cc := mustload.Partial(ClusterFlagValue(), options)
+		defer api.Close()
+
+		out.Step(style.Caching, "Saving snapshot {{.name}} of cluster {{.cluster}} ...", out.V{"name": args[0], "cluster": cc.Name})
+		if _, err := machine.SaveSnapshot(api, *cc, args[0]); err != nil {
+			exit.Error(reason.GuestSnapshotSave, "saving snapshot", err)
+		}
+		out.Step(style.Ready, "Snapshot {{.name}} was successfully saved.", out.V{"name": args[0]})
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotSaveCmd)
+}
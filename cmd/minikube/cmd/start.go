@@ -57,6 +57,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
@@ -157,6 +158,7 @@ func platform() string {
 
 // runStart handles the executes the flow of "minikube start"
 func runStart(cmd *cobra.Command, _ []string) {
+	applyClusterDefinition(cmd)
 	options := flags.CommandOptions()
 
 	register.SetEventLogPath(localpath.EventLog(ClusterFlagValue()))
@@ -177,6 +179,9 @@ func runStart(cmd *cobra.Command, _ []string) {
 	if viper.GetBool(force) {
 		out.WarningT("minikube skips various validations when --force is supplied; this may lead to unexpected behavior")
 	}
+	if viper.IsSet(config.MaxAuditEntries) {
+		out.WarningT(`{{.key}} is deprecated and ignored, the audit log is now rotated once it reaches {{.size}}, keeping {{.files}} rotated logs. Run "minikube config unset {{.key}}" to resolve this warning.`, out.V{"key": config.MaxAuditEntries, "size": config.MaxAuditFileSize, "files": config.MaxAuditFiles})
+	}
 
 	// if --registry-mirror specified when run minikube start,
 	// take arg precedence over MINIKUBE_REGISTRY_MIRROR
@@ -204,6 +209,7 @@ func runStart(cmd *cobra.Command, _ []string) {
 
 	if existing != nil {
 		upgradeExistingConfig(cmd, existing)
+		resumeScheduler(existing)
 	} else {
 		validateProfileName()
 	}
@@ -525,14 +531,16 @@ func startWithDriver(cmd *cobra.Command, starter node.Starter, existing *config.
 		if existing != nil {
 			n = existing.Nodes[i]
 		} else {
-			nodeName := node.Name(i + 1)
 			n = config.Node{
-				Name:              nodeName,
-				Port:              starter.Cfg.APIServerPort,
-				KubernetesVersion: starter.Cfg.KubernetesConfig.KubernetesVersion,
-				ContainerRuntime:  starter.Cfg.KubernetesConfig.ContainerRuntime,
-				Worker:            true,
+				Name:   node.Name(i + 1),
+				Worker: true,
+			}
+			if i <= len(definitionNodes) {
+				n = definitionNodes[i-1]
 			}
+			n.Port = starter.Cfg.APIServerPort
+			n.KubernetesVersion = starter.Cfg.KubernetesConfig.KubernetesVersion
+			n.ContainerRuntime = starter.Cfg.KubernetesConfig.ContainerRuntime
 			if i < numCPNodes { // starter node is also counted as (primary) cp node
 				n.ControlPlane = true
 			}
@@ -1363,6 +1371,17 @@ func validateFlags(cmd *cobra.Command, drvName string) { //nolint:gocyclo
 		}
 	}
 
+	if cmd.Flags().Changed(mountTypeFlag) && viper.GetString(mountString) != "" {
+		if err := cluster.ValidateMountType(drvName, viper.GetString(mountTypeFlag)); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+	}
+	if viper.GetString(mountString) != "" {
+		if err := validateMountIDs(viper.GetString(mountTypeFlag), viper.GetString(mountUID), viper.GetString(mountGID)); err != nil {
+			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+		}
+	}
+
 	if cmd.Flags().Changed(autoPauseInterval) {
 		if err := validateAutoPauseInterval(viper.GetDuration(autoPauseInterval)); err != nil {
 			exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
@@ -1934,6 +1953,12 @@ $ minikube config unset kubernetes-version`)
 		paramVersion = old.KubernetesConfig.KubernetesVersion
 	}
 
+	return resolveKubernetesVersion(paramVersion)
+}
+
+// resolveKubernetesVersion turns a user provided Kubernetes version, including aliases such as "stable" and
+// versions without a patch number, into a full version with the "v" prefix
+func resolveKubernetesVersion(paramVersion string) (string, error) {
 	if paramVersion == "" || strings.EqualFold(paramVersion, "stable") {
 		paramVersion = constants.DefaultKubernetesVersion
 	} else if strings.EqualFold(strings.ToLower(paramVersion), "latest") || strings.EqualFold(strings.ToLower(paramVersion), "newest") {
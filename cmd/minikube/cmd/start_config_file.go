@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+var (
+	// definitionNodes are the nodes of the --config-file cluster definition after the primary control-plane, if any
+	definitionNodes []config.Node
+	// definitionNodePools are the node pools of the --config-file cluster definition, if any
+	definitionNodePools []config.NodePool
+)
+
+// definitionFlag is a flag value derived from a field of a cluster definition
+type definitionFlag struct {
+	field  string
+	flag   string
+	values []string
+}
+
+// applyClusterDefinition loads the --config-file cluster definition and applies it as if its values had been passed as flags
+func applyClusterDefinition(cmd *cobra.Command) {
+	path := viper.GetString(configFile)
+	if path == "" {
+		return
+	}
+
+	def, err := config.LoadClusterDefinition(path)
+	if err != nil {
+		exit.Message(reason.Usage, "Unable to load cluster definition {{.path}}: {{.error}}", out.V{"path": path, "error": err})
+	}
+
+	dfs := clusterDefinitionFlags(def)
+	conflicts := []string{}
+	for _, df := range dfs {
+		if cmd.Flags().Changed(df.flag) {
+			conflicts = append(conflicts, "--"+df.flag+" ("+df.field+")")
+		}
+	}
+	if len(conflicts) > 0 {
+		exit.Message(reason.Usage, "The following flags conflict with the cluster definition {{.path}}, set them in only one place: {{.flags}}", out.V{"path": path, "flags": strings.Join(conflicts, ", ")})
+	}
+
+	for _, df := range dfs {
+		for _, v := range df.values {
+			if err := cmd.Flags().Set(df.flag, v); err != nil {
+				exit.Message(reason.Usage, "Invalid value {{.value}} for {{.field}} in {{.path}}: {{.error}}", out.V{"value": v, "field": df.field, "path": path, "error": err})
+			}
+		}
+	}
+	definitionNodes = def.Nodes()
+	definitionNodePools = def.NodePools()
+	for _, n := range definitionNodes {
+		if err := node.ValidateLabels(n.Labels); err != nil {
+			exit.Message(reason.Usage, "Invalid labels for node {{.name}} in {{.path}}: {{.error}}", out.V{"name": n.Name, "path": path, "error": err})
+		}
+		if err := node.ValidateTaints(n.Taints); err != nil {
+			exit.Message(reason.Usage, "Invalid taints for node {{.name}} in {{.path}}: {{.error}}", out.V{"name": n.Name, "path": path, "error": err})
+		}
+	}
+	for _, p := range definitionNodePools {
+		if err := node.ValidatePoolName(p.Name); err != nil {
+			exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+		}
+		if err := node.ValidateLabels(p.Labels); err != nil {
+			exit.Message(reason.Usage, "Invalid labels for node pool {{.name}} in {{.path}}: {{.error}}", out.V{"name": p.Name, "path": path, "error": err})
+		}
+		if err := node.ValidateTaints(p.Taints); err != nil {
+			exit.Message(reason.Usage, "Invalid taints for node pool {{.name}} in {{.path}}: {{.error}}", out.V{"name": p.Name, "path": path, "error": err})
+		}
+	}
+	klog.Infof("applied cluster definition %s: %+v", path, dfs)
+}
+
+// clusterDefinitionFlags maps the non-empty fields of a cluster definition onto start flags
+func clusterDefinitionFlags(def *config.ClusterDefinition) []definitionFlag {
+	dfs := []definitionFlag{}
+	str := func(field, flag, v string) {
+		if v != "" {
+			dfs = append(dfs, definitionFlag{field: field, flag: flag, values: []string{v}})
+		}
+	}
+	slice := func(field, flag string, v []string) {
+		if len(v) > 0 {
+			dfs = append(dfs, definitionFlag{field: field, flag: flag, values: v})
+		}
+	}
+	boolean := func(field, flag string, v *bool) {
+		if v != nil {
+			dfs = append(dfs, definitionFlag{field: field, flag: flag, values: []string{strconv.FormatBool(*v)}})
+		}
+	}
+	integer := func(field, flag string, v int) {
+		if v != 0 {
+			dfs = append(dfs, definitionFlag{field: field, flag: flag, values: []string{strconv.Itoa(v)}})
+		}
+	}
+
+	s := def.Spec
+	str("metadata.name", config.ProfileName, def.Metadata.Name)
+	str("spec.driver", "driver", s.Driver)
+	str("spec.cpus", cpus, s.CPUs)
+	str("spec.memory", memory, s.Memory)
+	str("spec.diskSize", humanReadableDiskSize, s.DiskSize)
+	str("spec.containerRuntime", containerRuntime, s.ContainerRuntime)
+	boolean("spec.keepContext", keepContext, s.KeepContext)
+	boolean("spec.embedCerts", embedCerts, s.EmbedCerts)
+	str("spec.network", network, s.Network)
+	str("spec.subnet", subnet, s.Subnet)
+	str("spec.staticIP", staticIP, s.StaticIP)
+	str("spec.listenAddress", listenAddress, s.ListenAddress)
+	slice("spec.ports", ports, s.Ports)
+	slice("spec.insecureRegistry", "insecure-registry", s.InsecureRegistry)
+	slice("spec.registryMirror", "registry-mirror", s.RegistryMirror)
+	slice("spec.dockerEnv", "docker-env", s.DockerEnv)
+	slice("spec.dockerOpt", "docker-opt", s.DockerOpt)
+	str("spec.mountString", mountString, s.MountString)
+	str("spec.gpus", gpus, s.GPUs)
+	slice("spec.addons", config.AddonListFlag, s.Addons)
+
+	k := s.Kubernetes
+	str("spec.kubernetes.version", kubernetesVersion, k.Version)
+	str("spec.kubernetes.namespace", startNamespace, k.Namespace)
+	str("spec.kubernetes.apiServerName", apiServerName, k.APIServerName)
+	slice("spec.kubernetes.apiServerNames", "apiserver-names", k.APIServerNames)
+	slice("spec.kubernetes.apiServerIPs", "apiserver-ips", k.APIServerIPs)
+	integer("spec.kubernetes.apiServerPort", apiServerPort, k.APIServerPort)
+	str("spec.kubernetes.dnsDomain", dnsDomain, k.DNSDomain)
+	str("spec.kubernetes.serviceCIDR", serviceCIDR, k.ServiceCIDR)
+	str("spec.kubernetes.featureGates", featureGates, k.FeatureGates)
+	str("spec.kubernetes.imageRepository", imageRepository, k.ImageRepository)
+	str("spec.kubernetes.cni", cniFlag, k.CNI)
+	slice("spec.kubernetes.extraConfig", "extra-config", k.ExtraConfig)
+
+	integer("spec.nodes", nodes, len(s.Nodes))
+	if def.ControlPlanes() > 1 {
+		dfs = append(dfs, definitionFlag{field: "spec.nodes", flag: ha, values: []string{"true"}})
+	}
+	return dfs
+}
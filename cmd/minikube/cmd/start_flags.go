@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"runtime"
 	"slices"
 	"strings"
@@ -154,6 +155,10 @@ const (
 	vmnetOffloading         = "vmnet-offloading"
 	dnsServers              = config.DNSServers
 	mdns                    = config.MDNS
+	hostCredentials         = "host-credentials"
+	registryCache           = "registry-cache"
+	runtimeConfigPatch      = "runtime-config-patch"
+	configFile              = "config-file"
 )
 
 var (
@@ -170,6 +175,7 @@ func initMinikubeFlags() {
 	startCmd.Flags().Bool(force, false, "Force minikube to perform possibly dangerous operations")
 	startCmd.Flags().Bool(flags.Interactive, true, "Allow user prompts for more information")
 	startCmd.Flags().Bool(dryRun, false, "dry-run mode. Validates configuration, but does not mutate system state")
+	startCmd.Flags().String(configFile, "", fmt.Sprintf("Path to a YAML or JSON cluster definition (apiVersion: %s, kind: %s) to start the cluster from. Its values cannot also be passed as flags. See 'minikube config export'.", config.ClusterDefinitionAPIVersion, config.ClusterDefinitionKind))
 
 	startCmd.Flags().String(cpus, "2", fmt.Sprintf("Number of CPUs allocated to Kubernetes. Use %q to use the maximum number of CPUs. Use %q to not specify a limit (Docker/Podman only)", constants.MaxResources, constants.NoLimit))
 	startCmd.Flags().StringP(memory, "m", "", fmt.Sprintf("Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use %q to use the maximum amount of memory. Use %q to not specify a limit (Docker/Podman only)", constants.MaxResources, constants.NoLimit))
@@ -209,7 +215,7 @@ func initMinikubeFlags() {
 	startCmd.Flags().Bool(forceSystemd, false, "If set, force the container runtime to use systemd as cgroup manager. Defaults to false.")
 	startCmd.Flags().String(network, "", "network to run minikube with. Used by docker/podman, qemu, kvm, and vfkit drivers. If left empty, minikube will create a new network.")
 	startCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
-	startCmd.Flags().String(trace, "", "Send trace events. Options include: [gcp]")
+	startCmd.Flags().String(trace, "", "Send trace events. Options include: [gcp, otlp, file]")
 	startCmd.Flags().Int(extraDisks, 0, "Number of extra disks created and attached to the minikube VM (currently only implemented for hyperkit, kvm2, qemu2, vfkit, and krunkit drivers)")
 	startCmd.Flags().Duration(certExpiration, constants.DefaultCertExpiration, "Duration until minikube certificate expiration, defaults to three years (26280h).")
 	startCmd.Flags().String(binaryMirror, "", "Location to fetch kubectl, kubelet, & kubeadm binaries from.")
@@ -220,6 +226,9 @@ func initMinikubeFlags() {
 	startCmd.Flags().StringP(gpus, "g", "", "Allow pods to use your GPUs. Options include: [all,nvidia,amd] (Docker driver with Docker container-runtime only)")
 	startCmd.Flags().Duration(autoPauseInterval, time.Minute*1, "Duration of inactivity before the minikube VM is paused (default 1m0s)")
 	startCmd.Flags().String(preloadSrc, "auto", "Which source to download the preload from (valid options: gcs, github, auto). Defaults to auto (try github first, then gcs as failover).")
+	startCmd.Flags().Bool(hostCredentials, false, "If set, copy the registry credentials of the docker and podman configs of the host, and of their credential helpers, into the kubelet of every node when it starts, so that pods can pull private images without pull secrets.")
+	startCmd.Flags().String(runtimeConfigPatch, "", "A file merged into the configuration of the container runtime on every start of the nodes: TOML tables merged into /etc/containerd/config.toml for containerd or added to /etc/crio/crio.conf.d for cri-o, or a JSON object merged into /etc/docker/daemon.json for docker. An empty value removes the patch.")
+	startCmd.Flags().StringSlice(registryCache, nil, "Registries to pull images through a pull-through cache running on the host, which is shared by all the profiles, for example: docker.io,registry.k8s.io. The caches need docker or podman on the host.")
 }
 
 // initKubernetesFlags inits the commandline flags for Kubernetes related options
@@ -675,6 +684,7 @@ func generateNewConfigFromFlags(cmd *cobra.Command, k8sVersion string, rtime str
 
 	cc = config.ClusterConfig{
 		Name:                    ClusterFlagValue(),
+		NodePools:               definitionNodePools,
 		KeepContext:             viper.GetBool(keepContext),
 		EmbedCerts:              viper.GetBool(embedCerts),
 		MinikubeISO:             viper.GetString(isoURL),
@@ -736,6 +746,9 @@ func generateNewConfigFromFlags(cmd *cobra.Command, k8sVersion string, rtime str
 		SocketVMnetClientPath:   detect.SocketVMNetClientPath(),
 		SocketVMnetPath:         detect.SocketVMNetPath(),
 		StaticIP:                viper.GetString(staticIP),
+		HostCredentials:         viper.GetBool(hostCredentials),
+		RegistryCache:           viper.GetStringSlice(registryCache),
+		RuntimeConfigPatch:      loadRuntimeConfigPatch(rtime),
 		KubernetesConfig: config.KubernetesConfig{
 			KubernetesVersion:      k8sVersion,
 			ClusterName:            ClusterFlagValue(),
@@ -890,6 +903,22 @@ func upgradeExistingConfig(cmd *cobra.Command, cc *config.ClusterConfig) {
 	}
 }
 
+// loadRuntimeConfigPatch returns the content of the --runtime-config-patch file, once validated for the container runtime
+func loadRuntimeConfigPatch(rtime string) string {
+	file := viper.GetString(runtimeConfigPatch)
+	if file == "" {
+		return ""
+	}
+	patch, err := os.ReadFile(file)
+	if err != nil {
+		exit.Message(reason.Usage, "Unable to read the --runtime-config-patch file: {{.error}}", out.V{"error": err})
+	}
+	if err := cruntime.ValidateConfigPatch(rtime, patch); err != nil {
+		exit.Message(reason.Usage, "Invalid --runtime-config-patch file {{.file}}: {{.error}}", out.V{"file": file, "error": err})
+	}
+	return string(patch)
+}
+
 // updateExistingConfigFromFlags will update the existing config from the flags - used on a second start
 // skipping updating existing docker env, docker opt, InsecureRegistry, registryMirror, extra-config, apiserver-ips
 func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterConfig) config.ClusterConfig { //nolint to suppress cyclomatic complexity 45 of func `updateExistingConfigFromFlags` is high (> 30)
@@ -993,6 +1022,8 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	updateDurationFromFlag(cmd, &cc.AutoPauseInterval, autoPauseInterval)
 	updateBoolFromFlag(cmd, &cc.Rosetta, rosetta)
 	updateBoolFromFlag(cmd, &cc.VmnetOffloading, vmnetOffloading)
+	updateBoolFromFlag(cmd, &cc.HostCredentials, hostCredentials)
+	updateStringSliceFromFlag(cmd, &cc.RegistryCache, registryCache)
 
 	if cmd.Flags().Changed(kubernetesVersion) {
 		kubeVer, err := getKubernetesVersion(existing)
@@ -1004,6 +1035,14 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	if cmd.Flags().Changed(containerRuntime) {
 		cc.KubernetesConfig.ContainerRuntime = getContainerRuntime(existing)
 	}
+	if cmd.Flags().Changed(runtimeConfigPatch) {
+		cc.RuntimeConfigPatch = loadRuntimeConfigPatch(cc.KubernetesConfig.ContainerRuntime)
+	} else if cc.RuntimeConfigPatch != "" && cc.KubernetesConfig.ContainerRuntime != existing.KubernetesConfig.ContainerRuntime {
+		// the stored patch is in the format of the previous container runtime
+		if err := cruntime.ValidateConfigPatch(cc.KubernetesConfig.ContainerRuntime, []byte(cc.RuntimeConfigPatch)); err != nil {
+			exit.Message(reason.Usage, "The runtime config patch of the cluster does not apply to the {{.runtime}} container runtime: {{.error}}, pass a new one with --runtime-config-patch, or remove it with --runtime-config-patch=\"\"", out.V{"runtime": cc.KubernetesConfig.ContainerRuntime, "error": err})
+		}
+	}
 
 	if cmd.Flags().Changed("extra-config") {
 		cc.KubernetesConfig.ExtraOptions = getExtraOptions()
@@ -854,3 +854,45 @@ func TestValidateAutoPause(t *testing.T) {
 		}
 	}
 }
+
+func TestClusterDefinitionFlags(t *testing.T) {
+	def, err := cfg.ParseClusterDefinition([]byte(`apiVersion: minikube.sigs.k8s.io/v1alpha1
+kind: Cluster
+metadata:
+  name: demo
+spec:
+  memory: 4g
+  registryMirror: [https://mirror.example.com]
+  kubernetes:
+    extraConfig: [kubelet.max-pods=150, apiserver.v=3]
+  nodes:
+  - controlPlane: true
+  - controlPlane: true
+  - controlPlane: true
+  - {}
+`))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	got := map[string][]string{}
+	for _, df := range clusterDefinitionFlags(def) {
+		got[df.flag] = df.values
+	}
+	want := map[string][]string{
+		cfg.ProfileName:   {"demo"},
+		memory:            {"4g"},
+		"registry-mirror": {"https://mirror.example.com"},
+		"extra-config":    {"kubelet.max-pods=150", "apiserver.v=3"},
+		nodes:             {"4"},
+		ha:                {"true"},
+	}
+	if len(got) != len(want) {
+		t.Errorf("got flags %v, want %v", got, want)
+	}
+	for flag, values := range want {
+		if !slices.Equal(got[flag], values) {
+			t.Errorf("flag %q = %v, want %v", flag, got[flag], values)
+		}
+	}
+}
@@ -60,16 +60,25 @@ kubeconfig: {{.Kubeconfig}}
 {{- if .TimeToStop }}
 timeToStop: {{.TimeToStop}}
 {{- end }}
+{{- if .NextSchedule }}
+nextSchedule: {{.NextSchedule}}
+{{- end }}
 {{- if .DockerEnv }}
 docker-env: {{.DockerEnv}}
 {{- end }}
 {{- if .PodManEnv }}
 podman-env: {{.PodManEnv}}
 {{- end }}
+{{- if .AutoPausedSince }}
+auto-paused since: {{.AutoPausedSince}}
+{{- end }}
 
 `
 	workerStatusFormat = `{{.Name}}
 type: Worker
+{{- if .NodePool }}
+nodepool: {{.NodePool}}
+{{- end }}
 host: {{.Host}}
 kubelet: {{.Kubelet}}
 
@@ -95,6 +104,7 @@ var statusCmd = &cobra.Command{
 
 		cname := ClusterFlagValue()
 		api, cc := mustload.Partial(cname, options)
+		resumeScheduler(cc)
 
 		duration := watch
 		if !cmd.Flags().Changed("watch") || watch < 0 {
@@ -137,6 +137,12 @@ func stopProfile(profile string, options *run.CommandOptions) int {
 	if err := killMountProcess(); err != nil {
 		out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
 	}
+	if kept := persistentMounts(cc.Mounts); len(kept) != len(cc.Mounts) {
+		cc.Mounts = kept
+		if err := config.SaveProfile(profile, cc); err != nil {
+			out.WarningT("Unable to forget the mounts that are not persistent: {{.error}}", out.V{"error": err})
+		}
+	}
 
 	// stop nodes in reverse order, so last one being primary control-plane node, that will start first next time
 	for i := len(cc.Nodes) - 1; i >= 0; i-- {
@@ -158,6 +164,17 @@ func stopProfile(profile string, options *run.CommandOptions) int {
 	return stoppedNodes
 }
 
+// persistentMounts returns the mounts that minikube start mounts again
+func persistentMounts(mounts []config.Mount) []config.Mount {
+	var kept []config.Mount
+	for _, m := range mounts {
+		if m.Persistent {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
 func stop(api libmachine.API, machineName string) bool {
 	nonexistent := false
 
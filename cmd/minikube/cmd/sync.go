@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/filesync"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	syncWatch  bool
+	syncDelete bool
+	syncIgnore []string
+)
+
+// syncCmd represents the sync command
+var syncCmd = &cobra.Command{
+	Use:   "sync <host directory> [<node name>:]<node directory>...",
+	Short: "Sync a host directory to directories of the nodes",
+	Long: `Syncs a host directory to directories of the nodes, one-way, transferring only the files whose content or mode differs.
+If <node name> is omitted, the directory is synced to all the nodes of the cluster.
+With --watch, the changes of the host directory are then pushed to the nodes as they happen, until interrupted.
+The changes made on the nodes are not synced back, and are overwritten when the same files change on the host.
+
+The paths matched by the .gitignore files of the host directory, by --ignore patterns, and the .git directory are left out.
+A directory of a node can also be copied back to the host, once, with "minikube sync <node name>:<node directory> <host directory>".
+
+Example Command : "minikube sync ./src /app --watch"
+                  "minikube sync ./src minikube:/app minikube-m02:/app --delete --ignore 'node_modules/'"
+                  "minikube sync minikube:/app/logs ./logs"`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) < 2 {
+			exit.Message(reason.Usage, "Usage: minikube sync <host directory> [<node name>:]<node directory>...")
+		}
+		options := flags.CommandOptions()
+		o := filesync.Options{Ignore: syncIgnore, Delete: syncDelete}
+
+		src := newRemotePath(args[0])
+		if src.node != "" {
+			if len(args) != 2 || newRemotePath(args[1]).node != "" {
+				exit.Message(reason.Usage, "Usage: minikube sync <node name>:<node directory> <host directory>")
+			}
+			if syncWatch {
+				exit.Message(reason.Usage, "--watch is only supported when syncing from the host")
+			}
+			co := mustload.Running(ClusterFlagValue(), options)
+			t := filesync.Target{Node: src.node, Runner: remoteCommandRunner(&co, src.node), Dir: src.path}
+			reportSync([]filesync.Result{filesync.Pull(t, args[1], o)}, true)
+			return
+		}
+
+		co := mustload.Running(ClusterFlagValue(), options)
+		var targets []filesync.Target
+		for _, arg := range args[1:] {
+			dst := newRemotePath(arg)
+			if !pathIsAbs(dst.path) {
+				exit.Message(reason.Usage, "The node directory {{.path}} must be an absolute path", out.V{"path": dst.path})
+			}
+			if dst.node != "" {
+				targets = append(targets, filesync.Target{Node: dst.node, Runner: remoteCommandRunner(&co, dst.node), Dir: dst.path})
+				continue
+			}
+			for _, n := range co.Config.Nodes {
+				name := config.MachineName(*co.Config, n)
+				targets = append(targets, filesync.Target{Node: name, Runner: remoteCommandRunner(&co, name), Dir: dst.path})
+			}
+		}
+
+		s, err := filesync.NewSyncer(args[0], targets, o)
+		if err != nil {
+			exit.Error(reason.HostPathStat, "Failed to read the host directory", err)
+		}
+		reportSync(s.Sync(), true)
+		if !syncWatch {
+			return
+		}
+
+		out.Step(style.Waiting, "Watching {{.path}} for changes, press Ctrl-C to stop ...", out.V{"path": args[0]})
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		if err := s.Watch(ctx, func(rs []filesync.Result) { reportSync(rs, false) }); err != nil {
+			exit.Error(reason.GuestSync, "Failed to watch the host directory", err)
+		}
+	},
+}
+
+// pathIsAbs returns whether the slash separated path of a node is absolute
+func pathIsAbs(p string) bool {
+	return len(p) > 0 && p[0] == '/'
+}
+
+// reportSync prints the results of a sync, exiting on errors when fatal is set
+func reportSync(rs []filesync.Result, fatal bool) {
+	failed := false
+	for _, r := range rs {
+		if r.Err != nil {
+			failed = true
+			out.FailureT("Failed to sync with {{.node}}: {{.error}}", out.V{"node": r.Node, "error": r.Err})
+			continue
+		}
+		out.Step(style.Copying, "Synced {{.node}}: {{.copied}} copied, {{.deleted}} deleted", out.V{"node": r.Node, "copied": r.Copied, "deleted": r.Deleted})
+	}
+	if failed && fatal {
+		exit.Message(reason.GuestSync, "Failed to sync the directories")
+	}
+}
+
+func init() {
+	syncCmd.Flags().BoolVarP(&syncWatch, "watch", "w", false, "Keep pushing the changes of the host directory to the nodes until interrupted.")
+	syncCmd.Flags().BoolVar(&syncDelete, "delete", false, "Delete the files of the destination that do not exist in the source. Files removed from the host directory while watching are always deleted.")
+	syncCmd.Flags().StringSliceVar(&syncIgnore, "ignore", nil, "Patterns of the paths to leave out, in the syntax of .gitignore files, in addition to the .gitignore files of the host directory.")
+}
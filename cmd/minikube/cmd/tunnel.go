@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/gofrs/flock"
 	"github.com/spf13/cobra"
@@ -38,6 +41,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/process"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
 	"k8s.io/minikube/pkg/minikube/tunnel"
@@ -47,6 +51,7 @@ import (
 
 var cleanup bool
 var bindAddress string
+var background bool
 var lockHandle *flock.Flock
 
 // tunnelCmd represents the tunnel command
@@ -58,6 +63,10 @@ var tunnelCmd = &cobra.Command{
 		RootCmd.PersistentPreRun(cmd, args)
 	},
 	Run: func(_ *cobra.Command, _ []string) {
+		if outputFormat != "text" && outputFormat != "json" {
+			exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", outputFormat))
+		}
+		out.SetJSON(outputFormat == "json")
 		options := flags.CommandOptions()
 		manager := tunnel.NewManager()
 		cname := ClusterFlagValue()
@@ -71,6 +80,11 @@ var tunnelCmd = &cobra.Command{
 			exit.Message(reason.Unimplemented, msg)
 		}
 
+		if background {
+			startTunnelInBackground(cname)
+			return
+		}
+
 		if cleanup {
 			klog.Info("Checking for tunnels to cleanup...")
 			if err := manager.CleanupNotRunningTunnels(); err != nil {
@@ -91,7 +105,7 @@ var tunnelCmd = &cobra.Command{
 		}
 
 		ctrlC := make(chan os.Signal, 1)
-		signal.Notify(ctrlC, os.Interrupt)
+		signal.Notify(ctrlC, os.Interrupt, syscall.SIGTERM)
 		ctx, cancel := context.WithCancel(context.Background())
 		go func() {
 			<-ctrlC
@@ -108,6 +122,7 @@ var tunnelCmd = &cobra.Command{
 
 			outputTunnelStarted()
 			kicSSHTunnel := kic.NewSSHTunnel(ctx, sshPort, sshKey, bindAddress, clientset.CoreV1(), clientset.NetworkingV1())
+			serveTunnelStatus(ctx, cname, kicSSHTunnel.Status, cancel)
 			err = kicSSHTunnel.Start()
 			if err != nil {
 				exit.Error(reason.SvcTunnelStart, "error starting tunnel", err)
@@ -120,10 +135,76 @@ var tunnelCmd = &cobra.Command{
 		if err != nil {
 			exit.Error(reason.SvcTunnelStart, "error starting tunnel", err)
 		}
+		serveTunnelStatus(ctx, cname, manager.Status, cancel)
 		<-done
 	},
 }
 
+// serveTunnelStatus exposes the state of this tunnel on the status socket of the profile
+func serveTunnelStatus(ctx context.Context, cname string, status func() *tunnel.Status, stop func()) {
+	report := func() *tunnel.StatusReport {
+		st := status()
+		if st == nil {
+			// the first check has not completed yet
+			st = &tunnel.Status{}
+		}
+		st.TunnelID.MachineName = cname
+		st.TunnelID.Pid = os.Getpid()
+		return tunnel.NewStatusReport(st)
+	}
+	if err := tunnel.ServeStatus(ctx, tunnel.SocketPath(cname), report, stop); err != nil {
+		out.WarningT("Unable to serve the tunnel status, 'minikube tunnel status' will not work: {{.error}}", out.V{"error": err})
+	}
+}
+
+// startTunnelInBackground runs 'minikube tunnel' as a detached process and waits until it serves its status
+func startTunnelInBackground(cname string) {
+	if st, err := tunnel.QueryStatus(cname); err == nil {
+		exit.Message(reason.SvcTunnelAlreadyRunning, "A tunnel is already running for cluster {{.cluster}} (pid {{.pid}}), to stop it run: minikube tunnel stop", out.V{"cluster": cname, "pid": st.Pid})
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		exit.Error(reason.SvcTunnelStart, "locating minikube", err)
+	}
+	logPath := filepath.Join(localpath.Profile(cname), "tunnel.log")
+	logfile, err := os.OpenFile(logPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		exit.Error(reason.SvcTunnelStart, "opening tunnel log", err)
+	}
+	defer logfile.Close()
+
+	args := []string{"tunnel", "-p", cname, fmt.Sprintf("--cleanup=%t", cleanup), fmt.Sprintf("--bind-address=%s", bindAddress), fmt.Sprintf("--output=%s", outputFormat)}
+	c := exec.Command(exe, args...)
+	c.Stdout = logfile
+	c.Stderr = logfile
+	process.Detach(c)
+	if err := c.Start(); err != nil {
+		exit.Error(reason.SvcTunnelStart, "starting background tunnel", err)
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- c.Wait()
+	}()
+
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case err := <-exited:
+			exit.Message(reason.SvcTunnelStart, "The background tunnel exited ({{.error}}), see {{.log}} for details", out.V{"error": err, "log": logPath})
+		case <-timeout:
+			exit.Message(reason.SvcTunnelStart, "The background tunnel did not start within 30s, see {{.log}} for details", out.V{"log": logPath})
+		case <-time.After(500 * time.Millisecond):
+			if _, err := tunnel.QueryStatus(cname); err != nil {
+				continue
+			}
+			out.Styled(style.Success, "Tunnel for cluster {{.cluster}} started in the background (pid {{.pid}})", out.V{"cluster": cname, "pid": c.Process.Pid})
+			out.Styled(style.Tip, "To check on it run 'minikube tunnel status', to stop it run 'minikube tunnel stop'. Its output is written to {{.log}}", out.V{"log": logPath})
+			return
+		}
+	}
+}
+
 func cleanupLock() {
 	if lockHandle != nil {
 		err := lockHandle.Unlock()
@@ -156,4 +237,6 @@ func outputTunnelStarted() {
 func init() {
 	tunnelCmd.Flags().BoolVarP(&cleanup, "cleanup", "c", true, "call with cleanup=true to remove old tunnels")
 	tunnelCmd.Flags().StringVar(&bindAddress, "bind-address", "", "set tunnel bind address, empty or '*' indicates the tunnel should be available for all interfaces")
+	tunnelCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	tunnelCmd.Flags().BoolVar(&background, "background", false, "run the tunnel as a background process that keeps running after the terminal is closed. Requires passwordless sudo if routes or privileged ports need it")
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/minikube/tunnel"
+)
+
+var tunnelStatusOutput string
+
+var tunnelStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows the status of the running tunnel of a cluster.",
+	Long:  "Shows the route, the patched LoadBalancer services and the errors of the tunnel running for a cluster, in the foreground or the background.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube tunnel status")
+		}
+		if tunnelStatusOutput != "text" && tunnelStatusOutput != "json" {
+			exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", tunnelStatusOutput))
+		}
+
+		cname := ClusterFlagValue()
+		st, err := tunnel.QueryStatus(cname)
+		if errors.Is(err, tunnel.ErrNotRunning) {
+			out.Styled(style.Empty, "No tunnel is running for cluster {{.cluster}}. To start one in the background run: minikube tunnel --background", out.V{"cluster": cname})
+			os.Exit(1)
+		}
+		if err != nil {
+			exit.Error(reason.SvcTunnelStatus, "querying tunnel status", err)
+		}
+
+		if tunnelStatusOutput == "json" {
+			if err := json.NewEncoder(os.Stdout).Encode(st); err != nil {
+				exit.Error(reason.InternalJSONMarshal, "encoding tunnel status", err)
+			}
+			return
+		}
+		out.String(tunnelStatusText(st, time.Now()))
+	},
+}
+
+// tunnelStatusText formats a tunnel status the way the running tunnel reports it
+func tunnelStatusText(st *tunnel.StatusReport, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "machine: %s\n", st.Machine)
+	fmt.Fprintf(&b, "pid: %d\n", st.Pid)
+	fmt.Fprintf(&b, "uptime: %s\n", now.Sub(st.StartTime).Round(time.Second))
+	if st.Route != "" {
+		fmt.Fprintf(&b, "route: %s\n", st.Route)
+	}
+	fmt.Fprintf(&b, "minikube: %s\n", st.MinikubeState)
+	fmt.Fprintf(&b, "services: [%s]\n", strings.Join(st.PatchedServices, ", "))
+	if len(st.Errors) == 0 {
+		b.WriteString("errors: none\n")
+		return b.String()
+	}
+	b.WriteString("errors:\n")
+	names := make([]string, 0, len(st.Errors))
+	for name := range st.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%s: %s\n", name, st.Errors[name])
+	}
+	return b.String()
+}
+
+func init() {
+	tunnelStatusCmd.Flags().StringVarP(&tunnelStatusOutput, "output", "o", "text", "The output format. One of 'text', 'json'")
+	tunnelCmd.AddCommand(tunnelStatusCmd)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/tunnel"
+)
+
+func TestTunnelStatusText(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		st   *tunnel.StatusReport
+		want string
+	}{
+		{
+			name: "healthy",
+			st:   &tunnel.StatusReport{Machine: "minikube", Pid: 42, MinikubeState: "Running", PatchedServices: []string{"nginx", "web"}, StartTime: now.Add(-90 * time.Second)},
+			want: "machine: minikube\npid: 42\nuptime: 1m30s\nminikube: Running\nservices: [nginx, web]\nerrors: none\n",
+		},
+		{
+			name: "errors",
+			st: &tunnel.StatusReport{Machine: "minikube", Pid: 42, Route: "10.96.0.0/12 -> 192.168.49.2", MinikubeState: "Stopped", StartTime: now,
+				Errors: map[string]string{"router": "no route", "minikube": "host is stopped"}},
+			want: "machine: minikube\npid: 42\nuptime: 0s\nroute: 10.96.0.0/12 -> 192.168.49.2\nminikube: Stopped\nservices: []\nerrors:\n\tminikube: host is stopped\n\trouter: no route\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tunnelStatusText(tc.st, now); got != tc.want {
+				t.Errorf("tunnelStatusText() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/process"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/minikube/tunnel"
+	"k8s.io/minikube/pkg/util/retry"
+)
+
+var tunnelStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stops the running tunnel of a cluster.",
+	Long:  "Asks the tunnel running for a cluster to remove its routes, restore the patched services and exit.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube tunnel stop")
+		}
+
+		cname := ClusterFlagValue()
+		st, err := tunnel.QueryStatus(cname)
+		if errors.Is(err, tunnel.ErrNotRunning) {
+			out.Styled(style.Empty, "No tunnel is running for cluster {{.cluster}}.", out.V{"cluster": cname})
+			return
+		}
+		if err != nil {
+			exit.Error(reason.SvcTunnelStop, "querying tunnel", err)
+		}
+		if err := tunnel.RequestStop(cname); err != nil {
+			exit.Error(reason.SvcTunnelStop, "stopping tunnel", err)
+		}
+
+		// the tunnel exits once it has removed its routes and restored the patched services
+		exe, err := os.Executable()
+		if err != nil {
+			exit.Error(reason.SvcTunnelStop, "locating minikube", err)
+		}
+		stopped := func() error {
+			if running, _ := process.Exists(st.Pid, filepath.Base(exe)); running {
+				return errors.New("tunnel is still running")
+			}
+			return nil
+		}
+		if err := retry.Local(stopped, 30*time.Second); err != nil {
+			exit.Error(reason.SvcTunnelStop, "waiting for the tunnel to stop", err)
+		}
+		out.Styled(style.Stopped, "Tunnel for cluster {{.cluster}} stopped", out.V{"cluster": cname})
+	},
+}
+
+func init() {
+	tunnelCmd.AddCommand(tunnelStopCmd)
+}
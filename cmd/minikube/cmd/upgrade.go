@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/cmd/minikube/cmd/flags"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/minikube/upgrade"
+)
+
+var (
+	upgradeVersion        string
+	upgradeAllowDowngrade bool
+	upgradeDrainTimeout   time.Duration
+	upgradeNodeTimeout    time.Duration
+)
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade the Kubernetes version of a cluster, one node at a time",
+	Long: `Upgrades the Kubernetes version of a running cluster with kubeadm, the way it is done in production:
+the control-plane nodes are upgraded one at a time, starting with the primary control-plane node, followed by the workers.
+Each node is cordoned and drained before it is upgraded, and uncordoned once its kubelet runs the new version.
+In HA clusters the kube-vip VIP is moved to another control-plane node before a control-plane node is upgraded,
+so that the API server stays reachable, and the kube-vip static pod of the node is upgraded along with it.
+
+Kubernetes can only be upgraded by one minor version at a time. If the upgrade fails, running the same command again
+resumes it from the node that failed.`,
+	Example: `minikube upgrade --kubernetes-version=v1.35.0`,
+	Run:     runUpgrade,
+}
+
+func runUpgrade(_ *cobra.Command, _ []string) {
+	if outputFormat != "text" && outputFormat != "json" {
+		exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", outputFormat))
+	}
+	out.SetJSON(outputFormat == "json")
+	if upgradeVersion == "" {
+		exit.Message(reason.Usage, "Please specify the Kubernetes version to upgrade to with --kubernetes-version")
+	}
+	target, err := resolveKubernetesVersion(upgradeVersion)
+	if err != nil {
+		if errors.Is(err, ErrKubernetesPatchNotFound) {
+			exit.Message(reason.PatchNotFound, "Unable to detect the latest patch release for specified major.minor version v{{.majorminor}}",
+				out.V{"majorminor": upgradeVersion})
+		}
+		exit.Message(reason.Usage, `Unable to parse "{{.kubernetes_version}}": {{.error}}`, out.V{"kubernetes_version": upgradeVersion, "error": err})
+	}
+
+	cname := ClusterFlagValue()
+	co := mustload.Healthy(cname, flags.CommandOptions())
+	register.SetEventLogPath(localpath.EventLog(cname))
+	register.Reg.SetStep(register.Upgrading)
+
+	if err := upgrade.Validate(*co.Config, target, upgradeAllowDowngrade); err != nil {
+		var inProgress *upgrade.InProgressError
+		switch {
+		case errors.Is(err, upgrade.ErrUpToDate):
+			out.Styled(style.Check, "Cluster {{.cluster}} already runs Kubernetes {{.version}}", out.V{"cluster": cname, "version": target})
+			return
+		case errors.As(err, &inProgress):
+			exit.Message(reason.Usage, "An upgrade to Kubernetes {{.version}} has not completed yet, finish it first by running: minikube upgrade -p {{.cluster}} --kubernetes-version={{.version}}", out.V{"cluster": cname, "version": inProgress.Version})
+		case errors.Is(err, upgrade.ErrDowngrade):
+			exit.Message(reason.Usage, "Kubernetes {{.version}} is older than the version of cluster {{.cluster}}, pass --allow-downgrade to downgrade it", out.V{"cluster": cname, "version": target})
+		default:
+			exit.Message(reason.Usage, "Unable to upgrade cluster {{.cluster}} to Kubernetes {{.version}}: {{.error}}", out.V{"cluster": cname, "version": target, "error": err})
+		}
+	}
+
+	nodes := upgrade.Plan(*co.Config, target)
+	if done := len(co.Config.Nodes) - len(nodes); done > 0 {
+		out.Styled(style.Notice, "Resuming the upgrade to Kubernetes {{.version}}, {{.done}} of {{.total}} nodes are upgraded already", out.V{"version": target, "done": done, "total": len(co.Config.Nodes)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	opts := upgrade.Options{
+		Bootstrapper:   viper.GetString(cmdcfg.Bootstrapper),
+		AllowDowngrade: upgradeAllowDowngrade,
+		DrainTimeout:   upgradeDrainTimeout,
+		NodeTimeout:    upgradeNodeTimeout,
+	}
+	if err := upgrade.Run(ctx, co.API, co.Config, target, opts); err != nil {
+		exit.Error(reason.KubernetesUpgrade, "Failed to upgrade Kubernetes", err)
+	}
+
+	register.Reg.SetStep(register.Done)
+	out.Styled(style.Ready, "Cluster {{.cluster}} now runs Kubernetes {{.version}}", out.V{"cluster": cname, "version": target})
+}
+
+func init() {
+	upgradeCmd.Flags().StringVar(&upgradeVersion, kubernetesVersion, "", fmt.Sprintf("The Kubernetes version to upgrade the cluster to (ex: v1.2.3, 'stable' for %s, 'latest' for %s)", constants.DefaultKubernetesVersion, constants.NewestKubernetesVersion))
+	upgradeCmd.Flags().BoolVar(&upgradeAllowDowngrade, "allow-downgrade", false, "Allow moving the cluster to an older Kubernetes version, at most one minor version back")
+	upgradeCmd.Flags().DurationVar(&upgradeDrainTimeout, "drain-timeout", 5*time.Minute, "How long to wait for the pods of a node to be evicted, for example while a PodDisruptionBudget blocks the eviction")
+	upgradeCmd.Flags().DurationVar(&upgradeNodeTimeout, "wait-timeout", 6*time.Minute, "How long to wait for an upgraded node to become ready")
+	upgradeCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+}
@@ -52,6 +52,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/run"
 	"k8s.io/minikube/pkg/minikube/style"
 	"k8s.io/minikube/pkg/minikube/sysinit"
+	"k8s.io/minikube/pkg/trace"
 	"k8s.io/minikube/pkg/util"
 	"k8s.io/minikube/pkg/util/retry"
 )
@@ -534,8 +535,10 @@ func verifyAddonStatusInternal(cc *config.ClusterConfig, name string, val string
 // Since Enable is called asynchronously (so is not thread-safe for concurrent addons map updating/reading), to avoid race conditions,
 // ToEnable should be called synchronously before Enable to get complete list of addons to enable, and
 // UpdateConfig should be called synchronously after Enable to update the config with successfully enabled addons.
-func Enable(wg *sync.WaitGroup, cc *config.ClusterConfig, toEnable map[string]bool, enabled chan<- []string, options *run.CommandOptions) {
+func Enable(ctx context.Context, wg *sync.WaitGroup, cc *config.ClusterConfig, toEnable map[string]bool, enabled chan<- []string, options *run.CommandOptions) {
 	defer wg.Done()
+	ctx, span := trace.Start(ctx, "addons.Enable")
+	defer span.End()
 
 	start := time.Now()
 	klog.Infof("enable addons start: toEnable=%v", toEnable)
@@ -561,8 +564,11 @@ func Enable(wg *sync.WaitGroup, cc *config.ClusterConfig, toEnable map[string]bo
 	for _, a := range toEnableList {
 		awg.Add(1)
 		go func(name string) {
+			_, aspan := trace.Start(ctx, "enable "+name, trace.String("addon", name))
+			defer aspan.End()
 			err := RunCallbacks(cc, name, "true", options)
 			if err != nil && !errors.Is(err, ErrSkipThisAddon) {
+				aspan.RecordError(err)
 				out.WarningT("Enabling '{{.name}}' returned an error: {{.error}}", out.V{"name": name, "error": err})
 			} else {
 				enabledAddons = append(enabledAddons, name)
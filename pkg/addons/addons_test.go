@@ -17,6 +17,7 @@ limitations under the License.
 package addons
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"sync"
@@ -142,7 +143,7 @@ func TestStartWithAddonsEnabled(t *testing.T) {
 	enabled := make(chan []string, 1)
 	var wg sync.WaitGroup
 	wg.Add(1)
-	go Enable(&wg, cc, toEnable, enabled, options)
+	go Enable(context.Background(), &wg, cc, toEnable, enabled, options)
 	wg.Wait()
 	if ea, ok := <-enabled; ok {
 		UpdateConfigToEnable(cc, ea, options)
@@ -1,5 +1,3 @@
-//go:build darwin
-
 /*
 Copyright 2025 The Kubernetes Authors All rights reserved.
 
@@ -28,6 +26,9 @@ import (
 	"k8s.io/minikube/pkg/libmachine/drivers"
 )
 
+// MountType is the mount type selecting virtiofs mounts, on the drivers supporting other types too
+const MountType = "virtiofs"
+
 // Mount is a directory on the host shared with the guest using virtiofs.
 type Mount struct {
 	// HostPath is an absolute path to existing directory to share with the
@@ -1,5 +1,3 @@
-//go:build darwin
-
 /*
 Copyright 2025 The Kubernetes Authors All rights reserved.
 
@@ -146,3 +144,21 @@ func TestVirtiofsValidateInvalidMount(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtiofsStopDaemons(t *testing.T) {
+	pidPath := filepath.Join(t.TempDir(), "virtiofsd.pid")
+	if err := virtiofs.StopDaemons(pidPath); err != nil {
+		t.Fatalf("failed to stop the daemons without a pidfile: %s", err)
+	}
+
+	// the pid of the test is not a virtiofsd process, so it must be left alone
+	if err := os.WriteFile(pidPath, []byte(fmt.Sprintf(" %d invalid", os.Getpid())), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := virtiofs.StopDaemons(pidPath); err != nil {
+		t.Fatalf("failed to stop the daemons: %s", err)
+	}
+	if _, err := os.Stat(pidPath); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, got %v", pidPath, err)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtiofs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/process"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// daemonPaths are the locations of virtiofsd in the common linux distributions, when it is not in the PATH
+var daemonPaths = []string{
+	"/usr/libexec/virtiofsd",
+	"/usr/lib/virtiofsd",
+	"/usr/lib/qemu/virtiofsd",
+}
+
+// findDaemon returns the path of the virtiofsd binary
+func findDaemon() (string, error) {
+	if p, err := exec.LookPath("virtiofsd"); err == nil {
+		return p, nil
+	}
+	for _, p := range daemonPaths {
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("virtiofsd not found, install the virtiofsd package of your distribution")
+}
+
+// StartDaemon starts a virtiofsd process serving the host path of the mount on the vhost-user socket,
+// and appends its pid to pidPath. The process exits by itself when the VM disconnects from the socket.
+func StartDaemon(m *Mount, socketPath, pidPath string) error {
+	daemon, err := findDaemon()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing stale socket: %w", err)
+	}
+
+	args := []string{"--socket-path=" + socketPath, "--shared-dir=" + m.HostPath, "--cache=auto"}
+	if os.Geteuid() != 0 {
+		// the namespace sandbox needs privileges that a regular user does not have
+		args = append(args, "--sandbox=none")
+	}
+	cmd := exec.Command(daemon, args...)
+	klog.Infof("Starting %v", cmd.Args)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting virtiofsd: %w", err)
+	}
+	if err := lock.AppendToFile(pidPath, []byte(fmt.Sprintf(" %d", cmd.Process.Pid)), 0o644); err != nil {
+		_ = cmd.Process.Kill()
+		return fmt.Errorf("writing virtiofsd pid: %w", err)
+	}
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			klog.Infof("virtiofsd for %s exited: %v", m.HostPath, err)
+		}
+	}()
+
+	// the VM fails to start if the socket is not there yet
+	for i := 0; i < 50; i++ {
+		if _, err := os.Stat(socketPath); err == nil {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("virtiofsd did not create %s", socketPath)
+}
+
+// StopDaemons kills the virtiofsd processes whose pids are in pidPath, and removes it
+func StopDaemons(pidPath string) error {
+	data, err := os.ReadFile(pidPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, f := range strings.Fields(string(data)) {
+		pid, err := strconv.Atoi(f)
+		if err != nil {
+			klog.Warningf("invalid pid %q in %s", f, pidPath)
+			continue
+		}
+		// the daemons exit by themselves when the VM stops, and their pids may have been reused since
+		if err := process.Kill(pid, "virtiofsd"); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("killing virtiofsd %d: %w", pid, err)
+		}
+	}
+	if err := os.Remove(pidPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
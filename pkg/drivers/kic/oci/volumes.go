@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"path"
+	"path/filepath"
 	"runtime"
 	"strings"
 
@@ -147,6 +149,36 @@ func ExtractTarballToVolume(ociBin string, tarballPath, volumeName, imageName st
 	return nil
 }
 
+// ArchiveVolumeToTarball runs a docker image imageName which archives the content of the volume named volumeName
+// to an uncompressed tarball at tarballPath on the host
+func ArchiveVolumeToTarball(ociBin string, volumeName, tarballPath, imageName string) error {
+	cmdArgs := []string{"run", "--rm", "--entrypoint", "/usr/bin/tar"}
+	if ociBin == Podman && runtime.GOOS == "linux" {
+		cmdArgs = append(cmdArgs, "--security-opt", "label=disable")
+	}
+	cmdArgs = append(cmdArgs, "-v", fmt.Sprintf("%s:/archiveDir", filepath.Dir(tarballPath)), "-v", fmt.Sprintf("%s:/volumeDir:ro", volumeName), imageName, "--numeric-owner", "-cpf", path.Join("/archiveDir", filepath.Base(tarballPath)), "-C", "/volumeDir", ".")
+	cmd := exec.Command(ociBin, cmdArgs...)
+	if _, err := runCmd(cmd); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreVolumeFromTarball runs a docker image imageName which replaces the content of the volume named volumeName
+// with the uncompressed tarball at tarballPath, as created by ArchiveVolumeToTarball
+func RestoreVolumeFromTarball(ociBin string, tarballPath, volumeName, imageName string) error {
+	cmdArgs := []string{"run", "--rm", "--entrypoint", "/bin/bash"}
+	if ociBin == Podman && runtime.GOOS == "linux" {
+		cmdArgs = append(cmdArgs, "--security-opt", "label=disable")
+	}
+	cmdArgs = append(cmdArgs, "-v", fmt.Sprintf("%s:/snapshot.tar:ro", tarballPath), "-v", fmt.Sprintf("%s:/volumeDir", volumeName), imageName, "-c", "find /volumeDir -mindepth 1 -delete && tar --numeric-owner -xpf /snapshot.tar -C /volumeDir")
+	cmd := exec.Command(ociBin, cmdArgs...)
+	if _, err := runCmd(cmd); err != nil {
+		return err
+	}
+	return nil
+}
+
 // createVolume creates a volume to be attached to the container with correct labels and prefixes based on profile name
 // Caution ! if volume already exists does NOT return an error and will not apply the minikube labels on it.
 // TODO: this should be fixed as a part of https://github.com/kubernetes/minikube/issues/6530
@@ -23,6 +23,12 @@ const domainTmpl = `
   <name>{{.MachineName}}</name>
   <memory unit='MiB'>{{.Memory}}</memory>
   <vcpu>{{.CPU}}</vcpu>
+  {{- if .VirtiofsMounts}}
+  <memoryBacking>
+    <source type='memfd'/>
+    <access mode='shared'/>
+  </memoryBacking>
+  {{- end}}
   <features>
     <acpi/>
     <apic/>
@@ -87,6 +93,13 @@ const domainTmpl = `
     {{- if gt .ExtraDisks 0}}
     {{.ExtraDisksXML}}
     {{- end}}
+    {{- range .VirtiofsMounts}}
+    <filesystem type='mount' accessmode='passthrough'>
+      <driver type='virtiofs'/>
+      <source dir='{{.HostPath}}'/>
+      <target dir='{{.Tag}}'/>
+    </filesystem>
+    {{- end}}
   </devices>
 </domain>
 `
@@ -20,6 +20,7 @@ package kvm
 
 import (
 	"k8s.io/minikube/pkg/drivers/common"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/libmachine/drivers"
 )
 
@@ -89,6 +90,9 @@ type Driver struct {
 
 	// Extra Disks XML
 	ExtraDisksXML []string
+
+	// Host directories shared with the VM using virtiofs
+	VirtiofsMounts []*virtiofs.Mount
 }
 
 // NewDriver creates a new driver for a host
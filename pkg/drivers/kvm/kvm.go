@@ -28,6 +28,7 @@ import (
 	"errors"
 
 	"k8s.io/minikube/pkg/drivers/common"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/libmachine/drivers"
 	"k8s.io/minikube/pkg/libmachine/log"
 	"k8s.io/minikube/pkg/libmachine/state"
@@ -260,6 +261,13 @@ func (d *Driver) Start() error {
 		return fmt.Errorf("waiting for SSH: %w", err)
 	}
 
+	if len(d.VirtiofsMounts) > 0 {
+		log.Info("setting up virtiofs mounts...")
+		if err := virtiofs.SetupMounts(d, d.VirtiofsMounts); err != nil {
+			return fmt.Errorf("setting up virtiofs mounts: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -43,6 +43,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/drivers/common"
 	"k8s.io/minikube/pkg/drivers/common/dhcp"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/minikube/detect"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/firewall"
@@ -95,6 +96,7 @@ type Driver struct {
 	SocketVMNetPath       string
 	SocketVMNetClientPath string
 	ExtraDisks            int
+	VirtiofsMounts        []*virtiofs.Mount
 }
 
 func (d *Driver) GetMachineName() string {
@@ -470,6 +472,26 @@ func (d *Driver) Start() error {
 			"virtio-9p-pci,id=fs0,fsdev=fsdev0,mount_tag=config-2")
 	}
 
+	if len(d.VirtiofsMounts) > 0 {
+		// vhost-user devices need the memory of the VM to be shared with virtiofsd
+		startCmd = append(startCmd,
+			"-object", fmt.Sprintf("memory-backend-memfd,id=mem,size=%dM,share=on", d.Memory),
+			"-numa", "node,memdev=mem")
+		// the daemons of a previous start are left behind when the VM was not stopped by minikube
+		if err := virtiofs.StopDaemons(d.virtiofsdPidfilePath()); err != nil {
+			return err
+		}
+		for i, m := range d.VirtiofsMounts {
+			socketPath := filepath.Join(machineDir, fmt.Sprintf("virtiofs-%d.sock", i))
+			if err := virtiofs.StartDaemon(m, socketPath, d.virtiofsdPidfilePath()); err != nil {
+				return err
+			}
+			startCmd = append(startCmd,
+				"-chardev", fmt.Sprintf("socket,id=virtiofs%d,path=%s", i, socketPath),
+				"-device", fmt.Sprintf("vhost-user-fs-pci,chardev=virtiofs%d,tag=%s", i, m.Tag))
+		}
+	}
+
 	serialPath := d.ResolveStorePath(serialFileName)
 	startCmd = append(startCmd,
 		"-serial", fmt.Sprintf("file:%s", serialPath))
@@ -528,7 +550,17 @@ func (d *Driver) Start() error {
 		}
 	}
 
-	return common.WaitForSSHAccess(d)
+	if err := common.WaitForSSHAccess(d); err != nil {
+		return err
+	}
+
+	if len(d.VirtiofsMounts) > 0 {
+		klog.Infof("Setup virtiofs mounts ...")
+		if err := virtiofs.SetupMounts(d, d.VirtiofsMounts); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func hardwareAcceleration() string {
@@ -590,7 +622,7 @@ func (d *Driver) Stop() error {
 	if _, err := d.RunQMPCommand("system_powerdown"); err != nil {
 		return err
 	}
-	return nil
+	return virtiofs.StopDaemons(d.virtiofsdPidfilePath())
 }
 
 func (d *Driver) Remove() error {
@@ -608,7 +640,7 @@ func (d *Driver) Remove() error {
 			return fmt.Errorf("quit: %w", err)
 		}
 	}
-	return nil
+	return virtiofs.StopDaemons(d.virtiofsdPidfilePath())
 }
 
 func (d *Driver) Restart() error {
@@ -629,7 +661,7 @@ func (d *Driver) Kill() error {
 	if _, err := d.RunQMPCommand("system_powerdown"); err != nil {
 		return err
 	}
-	return nil
+	return virtiofs.StopDaemons(d.virtiofsdPidfilePath())
 }
 
 func (d *Driver) StartDocker() error {
@@ -672,6 +704,12 @@ func (d *Driver) pidfilePath() string {
 	return filepath.Join(machineDir, "qemu.pid")
 }
 
+// virtiofsdPidfilePath returns the path of the file holding the pids of the virtiofsd processes of the VM
+func (d *Driver) virtiofsdPidfilePath() string {
+	machineDir := filepath.Join(d.StorePath, "machines", d.GetMachineName())
+	return filepath.Join(machineDir, "virtiofsd.pid")
+}
+
 // Make a boot2docker VM disk image.
 func (d *Driver) generateDiskImage(size int) error {
 	log.Debugf("Creating %d MB hard disk image...", size)
@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package archive streams trees of files between the host and the nodes as tar archives,
+// so that any number of files can be transferred over a single command runner session.
+package archive
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// Source is a host file or directory to pack, and the name it gets in the archive
+type Source struct {
+	// Path is the path of the file or directory on the host
+	Path string
+	// Name is the slash separated path of Path in the archive
+	Name string
+}
+
+// Pack writes a tar archive of the sources to w, recursing into directories.
+// File modes, modification times and symbolic links are preserved. Symbolic links
+// are only followed for the source paths themselves.
+func Pack(w io.Writer, srcs ...Source) error {
+	tw := tar.NewWriter(w)
+	for _, src := range srcs {
+		root, err := filepath.EvalSymlinks(src.Path)
+		if err != nil {
+			return fmt.Errorf("resolving %s: %w", src.Path, err)
+		}
+		err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			return addFile(tw, p, path.Join(src.Name, filepath.ToSlash(rel)), info)
+		})
+		if err != nil {
+			return fmt.Errorf("packing %s: %w", src.Path, err)
+		}
+	}
+	return tw.Close()
+}
+
+// PackFiles writes a tar archive of the named entries of the host directory root to w, without recursing
+// into directories. The names are slash separated paths relative to root, and are kept in the archive.
+func PackFiles(w io.Writer, root string, names ...string) error {
+	tw := tar.NewWriter(w)
+	for _, name := range names {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		info, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+		if err := addFile(tw, p, name, info); err != nil {
+			return fmt.Errorf("packing %s: %w", p, err)
+		}
+	}
+	return tw.Close()
+}
+
+// addFile writes the file at p to tw, under the archive name
+func addFile(tw *tar.Writer, p, name string, info os.FileInfo) error {
+	if info.Mode()&(os.ModeSocket|os.ModeNamedPipe|os.ModeDevice|os.ModeCharDevice|os.ModeIrregular) != 0 {
+		klog.Warningf("skipping %s: unsupported file type %s", p, info.Mode().Type())
+		return nil
+	}
+	link := ""
+	if info.Mode()&os.ModeSymlink != 0 {
+		l, err := os.Readlink(p)
+		if err != nil {
+			return err
+		}
+		link = l
+	}
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	// the owner of the files on the host means nothing on the other side
+	hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// Extract unpacks the tar archive read from r into the host directory dir, creating it if needed.
+// File modes and modification times are restored; entries that would be written outside of dir are refused.
+func Extract(r io.Reader, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	// the directories are finished last, as writing their content changes their modification time
+	var dirs []*tar.Header
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+		name, err := cleanName(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if name == "." && hdr.Typeflag != tar.TypeDir {
+			return fmt.Errorf("invalid archive entry %q", hdr.Name)
+		}
+		target := filepath.Join(root, filepath.FromSlash(name))
+		if err := checkWithin(root, filepath.Dir(target)); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+			h := *hdr
+			h.Name = target
+			dirs = append(dirs, &h)
+		case tar.TypeReg:
+			if err := extractFile(tr, target, hdr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := removeIfExists(target); err != nil {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			old, err := cleanName(hdr.Linkname)
+			if err != nil {
+				return err
+			}
+			if err := removeIfExists(target); err != nil {
+				return err
+			}
+			if err := os.Link(filepath.Join(root, filepath.FromSlash(old)), target); err != nil {
+				return err
+			}
+		default:
+			klog.Warningf("skipping %s: unsupported tar entry type %q", hdr.Name, hdr.Typeflag)
+		}
+	}
+
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := os.Chmod(dirs[i].Name, dirs[i].FileInfo().Mode().Perm()); err != nil {
+			return err
+		}
+		if err := os.Chtimes(dirs[i].Name, dirs[i].ModTime, dirs[i].ModTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractFile writes the content of the current archive entry to target
+func extractFile(r io.Reader, target string, hdr *tar.Header) error {
+	if err := removeIfExists(target); err != nil {
+		return err
+	}
+	mode := hdr.FileInfo().Mode().Perm()
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	// the mode given to OpenFile is subject to the umask
+	if err := os.Chmod(target, mode); err != nil {
+		return err
+	}
+	return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+}
+
+// removeIfExists removes target unless it is a directory, so that it can be replaced
+func removeIfExists(target string) error {
+	fi, err := os.Lstat(target)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return fmt.Errorf("cannot replace directory %s", target)
+	}
+	return os.Remove(target)
+}
+
+// cleanName returns the cleaned slash separated name of an archive entry, refusing names outside of the archive
+func cleanName(name string) (string, error) {
+	n := path.Clean(strings.TrimPrefix(filepath.ToSlash(name), "./"))
+	if path.IsAbs(n) || n == ".." || strings.HasPrefix(n, "../") || filepath.VolumeName(n) != "" {
+		return "", fmt.Errorf("invalid archive entry %q", name)
+	}
+	return n, nil
+}
+
+// checkWithin returns an error if dir, once its symbolic links are resolved, is not inside root
+func checkWithin(root, dir string) error {
+	// dir may not exist yet: resolve its closest existing parent
+	p := dir
+	for {
+		if _, err := os.Lstat(p); err == nil {
+			break
+		}
+		parent := filepath.Dir(p)
+		if parent == p {
+			break
+		}
+		p = parent
+	}
+	resolved, err := filepath.EvalSymlinks(p)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("refusing to extract outside of %s: %s", root, dir)
+	}
+	return nil
+}
+
+// Rewrite copies the tar archive read from r to w, renaming its entries with rename
+func Rewrite(r io.Reader, w io.Writer, rename func(name string) string) error {
+	tr := tar.NewReader(r)
+	tw := tar.NewWriter(w)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+		dir := strings.HasSuffix(hdr.Name, "/")
+		hdr.Name = rename(strings.TrimSuffix(hdr.Name, "/"))
+		if dir {
+			hdr.Name += "/"
+		}
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = rename(hdr.Linkname)
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// PackCmd returns the command writing to its stdout a tar archive of the node paths,
+// each one named after its base name in the archive
+func PackCmd(paths ...string) *exec.Cmd {
+	args := []string{"sudo", "tar", "-cf", "-"}
+	for _, p := range paths {
+		args = append(args, "-C", path.Dir(p), path.Base(p))
+	}
+	return exec.Command(args[0], args[1:]...)
+}
+
+// PackDirCmd returns the command writing to its stdout a tar archive of the named entries of the node directory dir,
+// without recursing into directories. The names are paths relative to dir, and are kept in the archive.
+func PackDirCmd(dir string, names ...string) *exec.Cmd {
+	args := append([]string{"sudo", "tar", "-cf", "-", "--no-recursion", "-C", dir, "--"}, names...)
+	return exec.Command(args[0], args[1:]...)
+}
+
+// ExtractCmd returns the command unpacking the tar archive read from its stdin into the node directory dir
+func ExtractCmd(dir string) *exec.Cmd {
+	return exec.Command("sudo", "sh", "-c", `mkdir -p "$0" && tar --no-same-owner -xpf - -C "$0"`, dir)
+}
+
+// Pipe runs write and read concurrently, connecting what write writes to what read reads
+func Pipe(write func(io.Writer) error, read func(io.Reader) error) error {
+	pr, pw := io.Pipe()
+	werrc := make(chan error, 1)
+	go func() {
+		err := write(pw)
+		pw.CloseWithError(err)
+		werrc <- err
+	}()
+
+	rerr := read(pr)
+	if rerr == nil {
+		// consume the padding following the end of the archive, so that write can complete
+		_, rerr = io.Copy(io.Discard, pr)
+	}
+	pr.CloseWithError(rerr)
+	werr := <-werrc
+	if werr != nil && (rerr == nil || errors.Is(rerr, werr)) {
+		return werr
+	}
+	return rerr
+}
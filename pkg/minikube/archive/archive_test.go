@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestPackExtract(t *testing.T) {
+	src := t.TempDir()
+	mtime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := os.MkdirAll(filepath.Join(src, "dir", "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]os.FileMode{
+		filepath.Join("dir", "a.txt"):         0o644,
+		filepath.Join("dir", "sub", "run.sh"): 0o755,
+	}
+	for name, mode := range files {
+		p := filepath.Join(src, name)
+		if err := os.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(src, "single"), []byte("single"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" {
+		if err := os.Symlink("a.txt", filepath.Join(src, "dir", "link")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b bytes.Buffer
+	err := Pack(&b, Source{Path: filepath.Join(src, "dir"), Name: "copy"}, Source{Path: filepath.Join(src, "single"), Name: "renamed"})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	dst := filepath.Join(t.TempDir(), "new")
+	if err := Extract(&b, dst); err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	for name, mode := range files {
+		p := filepath.Join(dst, "copy", name[len("dir")+1:])
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("extracted file: %v", err)
+		}
+		if runtime.GOOS != "windows" && fi.Mode().Perm() != mode {
+			t.Errorf("mode of %s = %v, want %v", p, fi.Mode().Perm(), mode)
+		}
+		if !fi.ModTime().Equal(mtime) {
+			t.Errorf("mtime of %s = %v, want %v", p, fi.ModTime(), mtime)
+		}
+		if got, _ := os.ReadFile(p); string(got) != name {
+			t.Errorf("content of %s = %q, want %q", p, got, name)
+		}
+	}
+	if got, _ := os.ReadFile(filepath.Join(dst, "renamed")); string(got) != "single" {
+		t.Errorf("content of renamed = %q, want %q", got, "single")
+	}
+	if runtime.GOOS != "windows" {
+		if l, err := os.Readlink(filepath.Join(dst, "copy", "link")); err != nil || l != "a.txt" {
+			t.Errorf("link = %q, %v, want %q", l, err, "a.txt")
+		}
+	}
+}
+
+func TestExtractRefusesEscapes(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []tar.Header
+	}{
+		{"parent", []tar.Header{{Name: "../evil", Typeflag: tar.TypeReg}}},
+		{"absolute", []tar.Header{{Name: "/evil", Typeflag: tar.TypeReg}}},
+		{"through symlink", []tar.Header{
+			{Name: "link", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "link/evil", Typeflag: tar.TypeReg},
+		}},
+		{"hardlink", []tar.Header{{Name: "evil", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.name == "through symlink" && runtime.GOOS == "windows" {
+				t.Skip("symbolic links need privileges on windows")
+			}
+			var b bytes.Buffer
+			tw := tar.NewWriter(&b)
+			for _, h := range tc.entries {
+				h := h
+				h.Mode = 0o644
+				if err := tw.WriteHeader(&h); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := tw.Close(); err != nil {
+				t.Fatal(err)
+			}
+			parent := t.TempDir()
+			if err := Extract(&b, filepath.Join(parent, "dst")); err == nil {
+				t.Errorf("Extract succeeded, want an error")
+			}
+			if _, err := os.Lstat(filepath.Join(parent, "evil")); err == nil {
+				t.Errorf("file was written outside of the target directory")
+			}
+		})
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	var in bytes.Buffer
+	tw := tar.NewWriter(&in)
+	for _, h := range []tar.Header{
+		{Name: "a/", Typeflag: tar.TypeDir, Mode: 0o755},
+		{Name: "a/f", Typeflag: tar.TypeReg, Mode: 0o644, Size: 2},
+		{Name: "a/l", Typeflag: tar.TypeLink, Linkname: "a/f"},
+	} {
+		h := h
+		if err := tw.WriteHeader(&h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Size > 0 {
+			if _, err := tw.Write([]byte("hi")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err := Rewrite(&in, &out, func(name string) string { return "b" + name[1:] })
+	if err != nil {
+		t.Fatalf("Rewrite: %v", err)
+	}
+
+	var got []string
+	tr := tar.NewReader(&out)
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			break
+		}
+		got = append(got, h.Name+">"+h.Linkname)
+	}
+	want := []string{"b/>", "b/f>", "b/l>b/f"}
+	if len(got) != len(want) {
+		t.Fatalf("entries = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -959,6 +959,8 @@ func GenerateTemplateData(addon *Addon, cc *config.ClusterConfig, netInfo Networ
 		LegacyPodSecurityPolicy bool
 		LegacyRuntimeClass      bool
 		AutoPauseInterval       time.Duration
+		AutoPauseCPUThreshold   float64
+		ExcludedNamespaces      string // the namespaces auto-pause does not count as workloads, comma separated
 	}{
 		KubernetesVersion:      make(map[string]uint64),
 		PreOneTwentyKubernetes: false,
@@ -981,6 +983,8 @@ func GenerateTemplateData(addon *Addon, cc *config.ClusterConfig, netInfo Networ
 		LegacyPodSecurityPolicy: v.LT(semver.Version{Major: 1, Minor: 25}),
 		LegacyRuntimeClass:      v.LT(semver.Version{Major: 1, Minor: 25}),
 		AutoPauseInterval:       cc.AutoPauseInterval,
+		AutoPauseCPUThreshold:   cc.AutoPauseCPUThreshold,
+		ExcludedNamespaces:      strings.Join(cc.AutoPauseExcludedNamespaces, ","),
 	}
 	if opts.ImageRepository != "" && !strings.HasSuffix(opts.ImageRepository, "/") {
 		opts.ImageRepository += "/"
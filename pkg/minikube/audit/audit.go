@@ -17,8 +17,6 @@ limitations under the License.
 package audit
 
 import (
-	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/user"
@@ -29,8 +27,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/minikube/config"
-	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/version"
 )
 
@@ -56,84 +54,43 @@ func args() string {
 	return strings.Join(os.Args[2:], " ")
 }
 
+// started holds the rows of the commands started by this process, so that their end can be logged without reading the audit log
+var started = map[string]*row{}
+
 // Log details about the executed command.
 func LogCommandStart() (string, error) {
 	if !shouldLog() {
 		return "", nil
 	}
+	if err := rotateIfNeeded(); err != nil {
+		klog.Warningf("failed to rotate audit log: %v", err)
+	}
 	id := uuid.New().String()
 	r := newRow(pflag.Arg(0), args(), userName(), version.GetVersion(), time.Now(), id)
 	if err := appendToLog(r); err != nil {
 		return "", err
 	}
+	started[id] = r
 	return r.id, nil
 }
 
+// LogCommandEnd appends the entry of the command with its end time to the audit log, readers merge it with the start entry.
 func LogCommandEnd(id string) error {
 	if id == "" {
 		return nil
 	}
-	if err := openAuditLog(); err != nil {
-		return err
-	}
-	defer closeAuditLog()
-	var logs []string
-	s := bufio.NewScanner(currentLogFile)
-	for s.Scan() {
-		logs = append(logs, s.Text())
-	}
-	if err := s.Err(); err != nil {
-		return fmt.Errorf("failed to read from audit file: %v", err)
-	}
-	closeAuditLog()
-	rowSlice, err := logsToRows(logs)
-	if err != nil {
-		return fmt.Errorf("failed to convert logs to rows: %v", err)
-	}
-	// have to truncate the audit log while closed as Windows can't truncate an open file
-	if err := truncateAuditLog(); err != nil {
-		return fmt.Errorf("failed to truncate audit log: %v", err)
+	r, ok := started[id]
+	if !ok {
+		return fmt.Errorf("failed to find a log row with id equals to %v", id)
 	}
-	if err := openAuditLog(); err != nil {
+	r.endTime = time.Now().Format(timeLayout)
+	if err := appendToLog(r); err != nil {
 		return err
 	}
-	var entriesNeedsToUpdate int
-
-	startIndex := getStartIndex(len(rowSlice))
-	rowSlice = rowSlice[startIndex:]
-	for _, v := range rowSlice {
-		if v.id == id {
-			v.endTime = time.Now().Format(constants.TimeFormat)
-			v.Data = v.toMap()
-			entriesNeedsToUpdate++
-		}
-		auditLog, err := json.Marshal(v)
-		if err != nil {
-			return err
-		}
-		if _, err = currentLogFile.WriteString(string(auditLog) + "\n"); err != nil {
-			return fmt.Errorf("failed to write to audit log: %v", err)
-		}
-	}
-	if entriesNeedsToUpdate == 0 {
-		return fmt.Errorf("failed to find a log row with id equals to %v", id)
-	}
+	delete(started, id)
 	return nil
 }
 
-func getStartIndex(entryCount int) int {
-	// default to 1000 entries
-	maxEntries := 1000
-	if viper.IsSet(config.MaxAuditEntries) {
-		maxEntries = viper.GetInt(config.MaxAuditEntries)
-	}
-	startIndex := entryCount - maxEntries
-	if maxEntries <= 0 || startIndex <= 0 {
-		return 0
-	}
-	return startIndex
-}
-
 // shouldLog returns if the command should be logged.
 func shouldLog() bool {
 	if viper.GetBool(config.SkipAuditFlag) {
@@ -150,7 +107,7 @@ func shouldLog() bool {
 	}
 
 	// commands that should not be logged.
-	no := []string{"status", "version", "logs", "generate-docs", "profile"}
+	no := []string{"status", "version", "logs", "generate-docs", "profile", "audit"}
 	a := pflag.Arg(0)
 	return !slices.Contains(no, a)
 }
@@ -17,8 +17,8 @@ limitations under the License.
 package audit
 
 import (
+	"bytes"
 	"os"
-	"os/exec"
 	"os/user"
 	"strings"
 	"testing"
@@ -225,7 +225,6 @@ func TestAudit(t *testing.T) {
 		oldArgs := os.Args
 		defer func() { os.Args = oldArgs }()
 		os.Args = []string{"minikube", "start"}
-		viper.Set(config.MaxAuditEntries, 3)
 
 		oldCommandLine := pflag.CommandLine
 		defer func() {
@@ -237,18 +236,73 @@ func TestAudit(t *testing.T) {
 		if err != nil {
 			t.Fatalf("start failed: %v", err)
 		}
+		before, err := os.ReadFile(auditOverrideFilename)
+		if err != nil {
+			t.Fatal(err)
+		}
 		if err := LogCommandEnd(auditID); err != nil {
 			t.Fatal(err)
 		}
 
-		b, err := exec.Command("wc", "-l", auditOverrideFilename).Output()
+		after, err := os.ReadFile(auditOverrideFilename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(after, before) {
+			t.Errorf("LogCommandEnd rewrote the audit log instead of appending to it")
+		}
+		rows, err := logsToRows(strings.Split(strings.TrimSpace(string(after)), "\n"))
 		if err != nil {
 			t.Fatal(err)
 		}
-		if !strings.Contains(string(b), "3") {
-			t.Errorf("MaxAuditEntries did not work, expected 3 lines in the audit log found %s", string(b))
+		last := rows[len(rows)-1]
+		if last.id != auditID || last.endTime == "" {
+			t.Errorf("expected the last entry to be %s with an end time, got %+v", auditID, last)
 		}
+	})
+
+	t.Run("LogCommandStartRotates", func(t *testing.T) {
+		oldArgs := os.Args
+		defer func() { os.Args = oldArgs }()
+		os.Args = []string{"minikube", "start"}
+		viper.Set(config.MaxAuditFileSize, "1kb")
+		viper.Set(config.MaxAuditFiles, 1)
+		defer func() {
+			viper.Set(config.MaxAuditFileSize, "10mb")
+			viper.Set(config.MaxAuditFiles, 5)
+		}()
+		defer os.Remove(auditOverrideFilename + ".1")
 
+		oldCommandLine := pflag.CommandLine
+		defer func() {
+			pflag.CommandLine = oldCommandLine
+			pflag.Parse()
+		}()
+		mockArgs(t, os.Args)
+
+		// the log already holds more than 1kb: it is rotated before the entry is appended, then stays below the bound
+		for i := 0; i < 3; i++ {
+			auditID, err := LogCommandStart()
+			if err != nil {
+				t.Fatalf("start failed: %v", err)
+			}
+			if err := LogCommandEnd(auditID); err != nil {
+				t.Fatal(err)
+			}
+			fi, err := os.Stat(auditOverrideFilename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fi.Size() >= 2048 {
+				t.Errorf("audit log is %d bytes after %d commands, want it rotated at 1kb", fi.Size(), i+1)
+			}
+		}
+		if _, err := os.Stat(auditOverrideFilename + ".1"); err != nil {
+			t.Errorf("expected a rotated log: %v", err)
+		}
+		if _, err := os.Stat(auditOverrideFilename + ".2"); !os.IsNotExist(err) {
+			t.Errorf("expected no more than MaxAuditFiles rotated logs, got %s.2: %v", auditOverrideFilename, err)
+		}
 	})
 
 	t.Run("LogCommandEndNonExistingID", func(t *testing.T) {
@@ -72,14 +72,6 @@ func appendToLog(row *row) error {
 	return nil
 }
 
-// truncateAuditLog truncates the audit log file
-func truncateAuditLog() error {
-	if err := os.Truncate(auditPath(), 0); err != nil {
-		return fmt.Errorf("failed to truncate audit log: %v", err)
-	}
-	return nil
-}
-
 func auditPath() string {
 	if auditOverrideFilename != "" {
 		return auditOverrideFilename
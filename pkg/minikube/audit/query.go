@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// timeLayout is the layout start and end times are written in, precise enough to compute the durations of short commands
+const timeLayout = time.RFC3339Nano
+
+// timeLayouts are the layouts start and end times have been written in, the older ones having no seconds
+var timeLayouts = []string{timeLayout, constants.TimeFormat, time.RFC1123}
+
+// Entry is a single audited command.
+type Entry struct {
+	ID        string        `json:"id"`
+	Command   string        `json:"command"`
+	Args      string        `json:"args"`
+	Profile   string        `json:"profile"`
+	User      string        `json:"user"`
+	Version   string        `json:"version"`
+	StartTime time.Time     `json:"startTime"`
+	EndTime   time.Time     `json:"endTime,omitzero"`
+	Duration  time.Duration `json:"-"`
+}
+
+// MarshalJSON writes the duration in a human readable form, such as "2m31s".
+func (e Entry) MarshalJSON() ([]byte, error) {
+	type entry Entry
+	v := struct {
+		entry
+		Duration string `json:"duration,omitempty"`
+	}{entry: entry(e)}
+	if e.Completed() {
+		v.Duration = e.Duration.String()
+	}
+	return json.Marshal(v)
+}
+
+// Completed returns true if the command recorded its end, commands that exit with an error do not.
+func (e Entry) Completed() bool {
+	return !e.EndTime.IsZero()
+}
+
+// Filter selects the entries returned by Query. Empty fields match every entry.
+type Filter struct {
+	Profile string
+	Command string
+	User    string
+	Since   time.Time
+	Until   time.Time
+	// Failed only matches commands that did not complete
+	Failed bool
+}
+
+// Match returns true if the entry is selected by the filter.
+func (f Filter) Match(e Entry) bool {
+	switch {
+	case f.Profile != "" && e.Profile != f.Profile:
+		return false
+	case f.Command != "" && e.Command != f.Command:
+		return false
+	case f.User != "" && e.User != f.User:
+		return false
+	case !f.Since.IsZero() && e.StartTime.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && e.StartTime.After(f.Until):
+		return false
+	case f.Failed && e.Completed():
+		return false
+	}
+	return true
+}
+
+// Query returns the entries of the audit log and rotated logs selected by the filter, oldest first.
+func Query(f Filter) ([]Entry, error) {
+	logs, err := readRotatedLogs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rotated audit logs: %v", err)
+	}
+	if err := openAuditLog(); err != nil {
+		return nil, err
+	}
+	defer closeAuditLog()
+	s := bufio.NewScanner(currentLogFile)
+	for s.Scan() {
+		logs = append(logs, s.Text())
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read from audit file: %v", err)
+	}
+	rows, err := logsToRows(logs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert logs to rows: %v", err)
+	}
+	entries := []Entry{}
+	for _, r := range rows {
+		e := r.toEntry()
+		if f.Match(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// toEntry converts a row to an Entry, parsing its start and end times.
+func (e *row) toEntry() Entry {
+	entry := Entry{
+		ID:        e.id,
+		Command:   e.command,
+		Args:      e.args,
+		Profile:   e.profile,
+		User:      e.user,
+		Version:   e.version,
+		StartTime: parseTime(e.startTime),
+		EndTime:   parseTime(e.endTime),
+	}
+	if entry.ID == "" {
+		entry.ID = e.ID
+	}
+	if entry.Completed() && !entry.StartTime.IsZero() {
+		entry.Duration = entry.EndTime.Sub(entry.StartTime)
+	}
+	return entry
+}
+
+// parseTime parses a start or end time, returning the zero time if it is empty or invalid.
+func parseTime(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	f, err := os.CreateTemp("", "audit.json")
+	if err != nil {
+		t.Fatalf("failed creating temporary file: %v", err)
+	}
+	defer os.Remove(f.Name())
+
+	s := `{"data":{"args":"-p mini1","command":"start","endTime":"Wed, 03 Feb 2021 15:33:05 MST","profile":"mini1","startTime":"Wed, 03 Feb 2021 15:30:33 MST","user":"user1","id":"9b7593cb-fbec-49e5-a3ce-bdc2d0bfb208"},"datacontenttype":"application/json","id":"9b7593cb-fbec-49e5-a3ce-bdc2d0bfb208","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.audit"}
+{"data":{"args":"--user user2","command":"logs","endTime":"02 Feb 21 16:47 MST","profile":"minikube","startTime":"02 Feb 21 16:46 MST","user":"user2"},"datacontenttype":"application/json","id":"fec03227-2484-48b6-880a-88fd010b5efd","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.audit"}
+{"data":{"args":"-p mini1","command":"delete","endTime":"","profile":"mini1","startTime":"Thu, 04 Feb 2021 09:00:00 MST","user":"user2"},"datacontenttype":"application/json","id":"0c1f4e6e-5d8c-4b54-a0a5-3a0a3e0b0a9c","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.audit"}
+{"data":{"args":"-p mini2","command":"ssh","endTime":"2021-02-05T10:00:01.5-07:00","profile":"mini2","startTime":"2021-02-05T10:00:00-07:00","user":"user3"},"datacontenttype":"application/json","id":"6a7c1d1e-7f0a-4d5e-9a43-2f3b9f0e6d11","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.audit"}
+`
+	if _, err := f.WriteString(s); err != nil {
+		t.Fatalf("failed writing to file: %v", err)
+	}
+	f.Close()
+	auditOverrideFilename = f.Name()
+	defer func() { auditOverrideFilename = "" }()
+
+	feb3, err := time.Parse(time.RFC1123, "Wed, 03 Feb 2021 00:00:00 MST")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{"all", Filter{}, []string{"start", "logs", "delete", "ssh"}},
+		{"profile", Filter{Profile: "mini1"}, []string{"start", "delete"}},
+		{"command", Filter{Command: "delete"}, []string{"delete"}},
+		{"user", Filter{User: "user2"}, []string{"logs", "delete"}},
+		{"since", Filter{Since: feb3}, []string{"start", "delete", "ssh"}},
+		{"until", Filter{Until: feb3}, []string{"logs"}},
+		{"failed", Filter{Failed: true}, []string{"delete"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := Query(tc.filter)
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+			got := []string{}
+			for _, e := range entries {
+				got = append(got, e.Command)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got commands %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("got commands %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+
+	entries, err := Query(Filter{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	durations := map[string]time.Duration{"start": 2*time.Minute + 32*time.Second, "logs": time.Minute, "delete": 0, "ssh": 1500 * time.Millisecond}
+	for _, e := range entries {
+		if e.Duration != durations[e.Command] {
+			t.Errorf("%s duration = %s, want %s", e.Command, e.Duration, durations[e.Command])
+		}
+	}
+	if entries[2].Completed() {
+		t.Errorf("delete without an end time should not be completed")
+	}
+}
@@ -28,7 +28,7 @@ type RawReport struct {
 	rows    []row
 }
 
-// Report is created using the last n lines from the log file.
+// Report is created using the last n commands from the log file, continuing into the rotated logs if needed.
 func Report(lastNLines int) (*RawReport, error) {
 	if lastNLines <= 0 {
 		return nil, errors.New("last n lines must be 1 or greater")
@@ -40,10 +40,6 @@ func Report(lastNLines int) (*RawReport, error) {
 	var logs []string
 	s := bufio.NewScanner(currentLogFile)
 	for s.Scan() {
-		// pop off the earliest line if already at desired log length
-		if len(logs) == lastNLines {
-			logs = logs[1:]
-		}
 		logs = append(logs, s.Text())
 	}
 	if err := s.Err(); err != nil {
@@ -53,6 +49,17 @@ func Report(lastNLines int) (*RawReport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert logs to rows: %v", err)
 	}
+	if len(rows) < lastNLines {
+		rotated, err := readRotatedLogs()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rotated audit logs: %v", err)
+		}
+		// read them along with audit.json, which may hold the end of commands started before the last rotation
+		if rows, err = logsToRows(append(rotated, logs...)); err != nil {
+			return nil, fmt.Errorf("failed to convert logs to rows: %v", err)
+		}
+	}
+	rows = rows[max(len(rows)-lastNLines, 0):]
 	r := &RawReport{
 		[]string{"Command", "Args", "Profile", "User", "Version", "Start Time", "End Time"},
 		rows,
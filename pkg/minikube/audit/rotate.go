@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// audit.json is only ever appended to. Once it reaches MaxAuditFileSize, or its oldest entry is older than
+// MaxAuditAge, every rotated log is renamed to the next index and, if CompressAuditFiles is set, compressed to
+// audit.json.N.gz, and audit.json is renamed to audit.json.1. Rotated logs beyond MaxAuditFiles or last written
+// before MaxAuditAge are removed.
+
+const gzipExt = ".gz"
+
+// maxFileSize returns the size at which audit.json is rotated
+func maxFileSize() int64 {
+	s := "10mb"
+	if viper.IsSet(config.MaxAuditFileSize) {
+		s = viper.GetString(config.MaxAuditFileSize)
+	}
+	size, err := units.FromHumanSize(s)
+	if err != nil {
+		klog.Warningf("invalid %s %q, using 10mb: %v", config.MaxAuditFileSize, s, err)
+		return 10 * units.MB
+	}
+	return size
+}
+
+// maxFiles returns the number of rotated logs to retain
+func maxFiles() int {
+	if viper.IsSet(config.MaxAuditFiles) {
+		return viper.GetInt(config.MaxAuditFiles)
+	}
+	return 5
+}
+
+// maxAge returns the age after which audit.json is rotated and rotated logs are removed, or 0 if they are kept regardless of age
+func maxAge() time.Duration {
+	s := viper.GetString(config.MaxAuditAge)
+	if s == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		klog.Warningf("invalid %s %q, ignoring: %v", config.MaxAuditAge, s, err)
+		return 0
+	}
+	return d
+}
+
+// rotatedPath returns the path of the rotated log with the given index
+func rotatedPath(index int, compressed bool) string {
+	p := fmt.Sprintf("%s.%d", auditPath(), index)
+	if compressed {
+		p += gzipExt
+	}
+	return p
+}
+
+// rotatedLogs returns the paths of the rotated logs by index
+func rotatedLogs() (map[int]string, error) {
+	matches, err := filepath.Glob(auditPath() + ".*")
+	if err != nil {
+		return nil, err
+	}
+	logs := map[int]string{}
+	for _, m := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(m, auditPath()+"."), gzipExt)
+		i, err := strconv.Atoi(suffix)
+		if err != nil || i < 1 {
+			continue
+		}
+		if _, ok := logs[i]; ok {
+			// compressFile was interrupted before removing the plain log, which unlike the gzipped one is complete
+			plain := rotatedPath(i, false)
+			klog.Warningf("found both %s and %s, removing the partially compressed log", plain, plain+gzipExt)
+			if err := os.Remove(plain + gzipExt); err != nil {
+				return nil, err
+			}
+			logs[i] = plain
+			continue
+		}
+		logs[i] = m
+	}
+	return logs, nil
+}
+
+// oldestFirst returns the indexes of the rotated logs, from the oldest to the newest
+func oldestFirst(logs map[int]string) []int {
+	indexes := slices.Collect(maps.Keys(logs))
+	slices.Sort(indexes)
+	slices.Reverse(indexes)
+	return indexes
+}
+
+// rotateIfNeeded rotates audit.json once it reaches MaxAuditFileSize or its oldest entry is older than MaxAuditAge
+func rotateIfNeeded() error {
+	if ok, err := needsRotation(); err != nil || !ok {
+		return err
+	}
+	// other minikube processes may be rotating the logs at the same time
+	releaser, err := lock.Acquire(lock.PathMutexSpec(auditPath()))
+	if err != nil {
+		return fmt.Errorf("lock audit log: %w", err)
+	}
+	defer releaser.Release()
+	// the logs may have been rotated while waiting for the lock
+	if ok, err := needsRotation(); err != nil || !ok {
+		return err
+	}
+
+	if err := rotate(); err != nil {
+		return fmt.Errorf("rotate audit logs: %w", err)
+	}
+	if maxFiles() <= 0 {
+		if err := os.Remove(auditPath()); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := os.Rename(auditPath(), rotatedPath(1, false)); err != nil {
+		return err
+	}
+	return removeExpired()
+}
+
+// needsRotation returns whether audit.json reached MaxAuditFileSize or holds entries older than MaxAuditAge
+func needsRotation() (bool, error) {
+	fi, err := os.Stat(auditPath())
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if fi.Size() == 0 {
+		return false, nil
+	}
+	if fi.Size() >= maxFileSize() {
+		return true, nil
+	}
+	age := maxAge()
+	if age <= 0 {
+		return false, nil
+	}
+	oldest, err := oldestEntryTime()
+	if err != nil {
+		return false, err
+	}
+	return !oldest.IsZero() && time.Since(oldest) > age, nil
+}
+
+// oldestEntryTime returns the start time of the first entry of audit.json, reading only that entry
+func oldestEntryTime() (time.Time, error) {
+	f, err := os.Open(auditPath())
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	if !s.Scan() {
+		return time.Time{}, s.Err()
+	}
+	rows, err := logsToRows([]string{s.Text()})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseTime(rows[0].startTime), nil
+}
+
+// rotate renames each rotated log to the next index, compressing them if enabled, and removes those that would
+// exceed MaxAuditFiles once audit.json is renamed to audit.json.1
+func rotate() error {
+	logs, err := rotatedLogs()
+	if err != nil {
+		return err
+	}
+	for _, i := range oldestFirst(logs) {
+		src := logs[i]
+		if i >= maxFiles() {
+			if err := os.Remove(src); err != nil {
+				return err
+			}
+			continue
+		}
+		compressed := strings.HasSuffix(src, gzipExt)
+		if !compressed && viper.GetBool(config.CompressAuditFiles) {
+			if err := compressFile(src, rotatedPath(i+1, true)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Rename(src, rotatedPath(i+1, compressed)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compressFile gzips src to dst, then removes src
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		return fmt.Errorf("compress %s: %w", src, err)
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		return fmt.Errorf("compress %s: %w", src, err)
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	in.Close()
+	return os.Remove(src)
+}
+
+// removeExpired removes rotated logs that were last written before MaxAuditAge
+func removeExpired() error {
+	age := maxAge()
+	if age <= 0 {
+		return nil
+	}
+	logs, err := rotatedLogs()
+	if err != nil {
+		return err
+	}
+	for _, p := range logs {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if time.Since(fi.ModTime()) > age {
+			klog.Infof("removing expired audit log %s", p)
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readRotatedLogs returns the lines of the rotated logs, oldest first
+func readRotatedLogs() ([]string, error) {
+	logs, err := rotatedLogs()
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, i := range oldestFirst(logs) {
+		l, err := readLogLines(logs[i])
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", logs[i], err)
+		}
+		lines = append(lines, l...)
+	}
+	return lines, nil
+}
+
+// readLogLines returns the lines of a plain or gzipped log file
+func readLogLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(path, gzipExt) {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	return lines, s.Err()
+}
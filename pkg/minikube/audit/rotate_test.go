@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/viper"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestRotate(t *testing.T) {
+	auditOverrideFilename = filepath.Join(t.TempDir(), "audit.json")
+	defer func() { auditOverrideFilename = "" }()
+	viper.Set(config.MaxAuditFileSize, "1b")
+	viper.Set(config.MaxAuditFiles, 3)
+	viper.Set(config.CompressAuditFiles, true)
+	defer func() {
+		viper.Set(config.MaxAuditFileSize, nil)
+		viper.Set(config.MaxAuditFiles, nil)
+		viper.Set(config.CompressAuditFiles, nil)
+	}()
+
+	start := time.Date(2021, 2, 3, 15, 30, 0, 0, time.UTC)
+	newRows := func(from, to int) []row {
+		rows := []row{}
+		for i := from; i < to; i++ {
+			r := newRow("start", "", "user1", "v1.0.0", start.Add(time.Duration(i)*time.Minute), fmt.Sprintf("id-%d", i), "p1")
+			r.Data = r.toMap()
+			rows = append(rows, *r)
+		}
+		return rows
+	}
+
+	// every command fills audit.json past the 1 byte limit, so the next one rotates it
+	logRows := func(rows []row) {
+		if err := rotateIfNeeded(); err != nil {
+			t.Fatalf("rotateIfNeeded: %v", err)
+		}
+		for _, r := range rows {
+			if err := appendToLog(&r); err != nil {
+				t.Fatalf("append: %v", err)
+			}
+		}
+	}
+	for i := 0; i < 5; i++ {
+		logRows(newRows(i*2, i*2+2))
+	}
+	last := newRows(10, 11)
+	logRows(last)
+	// the end of the last command is appended without rotating
+	last[0].endTime = start.Add(time.Hour).Format(timeLayout)
+	if err := appendToLog(&last[0]); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	for _, p := range []string{rotatedPath(1, false), rotatedPath(2, true), rotatedPath(3, true)} {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("expected rotated log %s: %v", p, err)
+		}
+	}
+	if _, err := os.Stat(rotatedPath(4, true)); !os.IsNotExist(err) {
+		t.Errorf("rotated logs beyond MaxAuditFiles should be removed, got: %v", err)
+	}
+
+	entries, err := Query(Filter{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	// the two oldest rotations were removed
+	want := []string{"id-4", "id-5", "id-6", "id-7", "id-8", "id-9", "id-10"}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i, e := range entries {
+		if e.ID != want[i] {
+			t.Errorf("entry %d = %s, want %s", i, e.ID, want[i])
+		}
+	}
+	if !entries[len(entries)-1].Completed() {
+		t.Errorf("the end of the last command was not merged into its entry: %+v", entries[len(entries)-1])
+	}
+
+	r, err := Report(3)
+	if err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+	if len(r.rows) != 3 || r.rows[0].id != "id-8" || r.rows[2].id != "id-10" {
+		t.Errorf("Report(3) did not continue into the rotated logs: %+v", r.rows)
+	}
+}
+
+func TestRotateByAge(t *testing.T) {
+	auditOverrideFilename = filepath.Join(t.TempDir(), "audit.json")
+	defer func() { auditOverrideFilename = "" }()
+	viper.Set(config.MaxAuditAge, "24h")
+	defer viper.Set(config.MaxAuditAge, nil)
+
+	old := newRow("start", "", "user1", "v1.0.0", time.Now().Add(-48*time.Hour), "id-old", "p1")
+	if err := appendToLog(old); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if err := rotateIfNeeded(); err != nil {
+		t.Fatalf("rotateIfNeeded: %v", err)
+	}
+	if _, err := os.Stat(auditOverrideFilename); !os.IsNotExist(err) {
+		t.Errorf("audit log holding entries older than MaxAuditAge should be rotated, got: %v", err)
+	}
+	if _, err := os.Stat(rotatedPath(1, false)); err != nil {
+		t.Errorf("expected rotated log: %v", err)
+	}
+
+	recent := newRow("start", "", "user1", "v1.0.0", time.Now(), "id-recent", "p1")
+	if err := appendToLog(recent); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if err := rotateIfNeeded(); err != nil {
+		t.Fatalf("rotateIfNeeded: %v", err)
+	}
+	if _, err := os.Stat(auditOverrideFilename); err != nil {
+		t.Errorf("audit log holding recent entries should not be rotated: %v", err)
+	}
+}
+
+func TestRotatedLogsPartiallyCompressed(t *testing.T) {
+	auditOverrideFilename = filepath.Join(t.TempDir(), "audit.json")
+	defer func() { auditOverrideFilename = "" }()
+
+	for _, p := range []string{rotatedPath(1, false), rotatedPath(2, false), rotatedPath(2, true)} {
+		if err := os.WriteFile(p, []byte(p), 0644); err != nil {
+			t.Fatalf("write %s: %v", p, err)
+		}
+	}
+	logs, err := rotatedLogs()
+	if err != nil {
+		t.Fatalf("rotatedLogs: %v", err)
+	}
+	want := map[int]string{1: rotatedPath(1, false), 2: rotatedPath(2, false)}
+	if diff := cmp.Diff(want, logs); diff != "" {
+		t.Errorf("rotatedLogs mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := os.Stat(rotatedPath(2, true)); !os.IsNotExist(err) {
+		t.Errorf("the partially compressed log should be removed, got: %v", err)
+	}
+}
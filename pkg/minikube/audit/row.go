@@ -90,7 +90,7 @@ func newRow(command string, args string, user string, version string, startTime
 		args:      args,
 		command:   command,
 		profile:   p,
-		startTime: startTime.Format(constants.TimeFormat),
+		startTime: startTime.Format(timeLayout),
 		user:      user,
 		version:   version,
 		id:        id,
@@ -100,18 +100,33 @@ func newRow(command string, args string, user string, version string, startTime
 // toFields converts a row to an array of fields,
 // to be used when converting to a table.
 func (e *row) toFields() []string {
-	return []string{e.command, e.args, e.profile, e.user, e.version, e.startTime, e.endTime}
+	return []string{e.command, e.args, e.profile, e.user, e.version, displayTime(e.startTime), displayTime(e.endTime)}
+}
+
+// displayTime returns the start or end time s in the short format of the tables, or s itself if it can not be parsed
+func displayTime(s string) string {
+	if t := parseTime(s); !t.IsZero() {
+		return t.Format(constants.TimeFormat)
+	}
+	return s
 }
 
 // logsToRows converts audit logs into arrays of rows.
+// The entry appended when a command ends replaces the entry appended when it started.
 func logsToRows(logs []string) ([]row, error) {
 	rows := []row{}
+	index := map[string]int{}
 	for _, l := range logs {
 		r := row{}
 		if err := json.Unmarshal([]byte(l), &r); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal %q: %v", l, err)
 		}
 		r.assignFields()
+		if i, ok := index[r.id]; ok && r.id != "" {
+			rows[i] = r
+			continue
+		}
+		index[r.id] = len(rows)
 		rows = append(rows, r)
 	}
 	return rows, nil
@@ -34,9 +34,9 @@ func TestRow(t *testing.T) {
 	u := "user1"
 	v := "v0.17.1"
 	st := time.Now()
-	stFormatted := st.Format(constants.TimeFormat)
+	stFormatted := st.Format(timeLayout)
 	et := time.Now()
-	etFormatted := et.Format(constants.TimeFormat)
+	etFormatted := et.Format(timeLayout)
 	id := uuid.New().String()
 
 	r := newRow(c, a, u, v, st, id, p)
@@ -100,7 +100,7 @@ func TestRow(t *testing.T) {
 	t.Run("toFields", func(t *testing.T) {
 		got := r.toFields()
 		gotString := strings.Join(got, ",")
-		want := []string{c, a, p, u, v, stFormatted, etFormatted}
+		want := []string{c, a, p, u, v, st.Format(constants.TimeFormat), et.Format(constants.TimeFormat)}
 		wantString := strings.Join(want, ",")
 
 		if gotString != wantString {
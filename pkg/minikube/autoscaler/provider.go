@@ -0,0 +1,539 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/third_party/cluster-autoscaler/cloudprovider/externalgrpc/protos"
+)
+
+// ProviderIDPrefix prefixes the machine name in the provider ID given to the nodes of autoscaled pools
+const ProviderIDPrefix = "minikube://"
+
+// member is a node of an autoscaled pool
+type member struct {
+	node    config.Node
+	machine string
+}
+
+// Provider implements the externalgrpc cloud provider of the cluster autoscaler on top of the node pools of a cluster.
+// Each pool with a maximum size is a node group. The autoscaler only changes target sizes and marks nodes for deletion,
+// a single worker then adds and deletes the nodes, so the cluster config is only ever changed by one goroutine.
+// The cluster config is read back from the profile before every change, as the pools may also be changed meanwhile
+// with 'minikube nodepool' and 'minikube node'.
+type Provider struct {
+	protos.UnimplementedCloudProviderServer
+
+	client kubernetes.Interface
+	// load, scale and remove read and change the nodes of the cluster, they are replaced in tests
+	load   func() (*config.ClusterConfig, error)
+	scale  func(cc *config.ClusterConfig, pool config.NodePool, count int) error
+	remove func(cc *config.ClusterConfig, nodes []config.Node) error
+
+	wake chan struct{}
+
+	mu       sync.Mutex
+	base     config.ClusterConfig
+	pools    map[string]config.NodePool
+	members  map[string][]member
+	target   map[string]int
+	deleting map[string]bool
+}
+
+// NewProvider returns a provider scaling the autoscaled node pools of cc
+func NewProvider(cc *config.ClusterConfig, client kubernetes.Interface, drainTimeout time.Duration, options *run.CommandOptions) *Provider {
+	p := &Provider{
+		client: client,
+		load: func() (*config.ClusterConfig, error) {
+			return config.Load(cc.Name)
+		},
+		scale: func(cc *config.ClusterConfig, pool config.NodePool, count int) error {
+			return node.ScalePool(cc, pool, count, drainTimeout, options)
+		},
+		remove: func(cc *config.ClusterConfig, nodes []config.Node) error {
+			return node.DeletePoolNodes(cc, nodes, drainTimeout, options)
+		},
+		wake:     make(chan struct{}, 1),
+		members:  map[string][]member{},
+		target:   map[string]int{},
+		deleting: map[string]bool{},
+	}
+	p.update(cc)
+	return p
+}
+
+// AutoscaledPools returns the node pools of the cluster the cluster autoscaler may scale
+func AutoscaledPools(cc config.ClusterConfig) []config.NodePool {
+	var pools []config.NodePool
+	for _, p := range cc.NodePools {
+		if p.MaxNodes > 0 {
+			pools = append(pools, p)
+		}
+	}
+	return pools
+}
+
+// Serve serves the CloudProvider service on lis and scales the node pools until ctx is done
+func (p *Provider) Serve(ctx context.Context, lis net.Listener, opts ...grpc.ServerOption) error {
+	s := grpc.NewServer(opts...)
+	protos.RegisterCloudProviderServer(s, p)
+
+	go p.work(ctx)
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+	return s.Serve(lis)
+}
+
+// reload reads the cluster config back from the profile and updates the node groups from it, p.mu must be held
+func (p *Provider) reload() (*config.ClusterConfig, error) {
+	cc, err := p.load()
+	if err != nil {
+		return nil, fmt.Errorf("load profile: %w", err)
+	}
+	p.update(cc)
+	return cc, nil
+}
+
+// update sets the node groups from the pools of cc, keeping the target sizes the autoscaler asked for
+// unless nodes were added outside of it, p.mu must be held
+func (p *Provider) update(cc *config.ClusterConfig) {
+	p.base = *cc
+	p.base.Nodes = nil
+	p.pools = map[string]config.NodePool{}
+	machines := map[string]bool{}
+	for _, pool := range AutoscaledPools(*cc) {
+		p.pools[pool.Name] = pool
+		var members []member
+		existing := 0
+		for _, n := range node.PoolNodes(*cc, pool.Name) {
+			m := config.MachineName(*cc, n)
+			members = append(members, member{node: n, machine: m})
+			machines[m] = true
+			if !p.deleting[m] {
+				existing++
+			}
+		}
+		p.members[pool.Name] = members
+		if target, ok := p.target[pool.Name]; !ok || target < existing {
+			p.target[pool.Name] = existing
+		}
+	}
+	for name := range p.members {
+		if _, ok := p.pools[name]; !ok {
+			delete(p.members, name)
+			delete(p.target, name)
+		}
+	}
+	// nodes deleted meanwhile with 'minikube node delete' need no deletion anymore
+	for m := range p.deleting {
+		if !machines[m] {
+			delete(p.deleting, m)
+		}
+	}
+}
+
+// readProfile reloads the cluster config under p.mu, for the worker
+func (p *Provider) readProfile() (*config.ClusterConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.reload()
+}
+
+func (p *Provider) notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// work applies the changes requested by the cluster autoscaler, one at a time
+func (p *Provider) work(ctx context.Context) {
+	for {
+		p.reconcile(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		}
+	}
+}
+
+func (p *Provider) reconcile(ctx context.Context) {
+	p.mu.Lock()
+	names := slices.Sorted(maps.Keys(p.pools))
+	p.mu.Unlock()
+
+	for _, name := range names {
+		p.mu.Lock()
+		cc, err := p.reload()
+		pool, ok := p.pools[name]
+		var doomed []config.Node
+		for _, m := range p.members[name] {
+			if p.deleting[m.machine] {
+				doomed = append(doomed, m.node)
+			}
+		}
+		p.mu.Unlock()
+		if err != nil {
+			klog.Warningf("scaling node pool %q: %v", name, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if len(doomed) > 0 {
+			if err := p.remove(cc, doomed); err != nil {
+				klog.Warningf("deleting nodes of pool %q: %v", name, err)
+			}
+			p.mu.Lock()
+			for _, n := range doomed {
+				delete(p.deleting, config.MachineName(*cc, n))
+				// the node is still there, give it back to the target size so that the autoscaler can try again
+				if slices.ContainsFunc(cc.Nodes, func(c config.Node) bool { return c.Name == n.Name }) {
+					p.target[name]++
+				}
+			}
+			p.mu.Unlock()
+		}
+
+		p.mu.Lock()
+		cc, err = p.reload()
+		target := p.target[name]
+		p.mu.Unlock()
+		if err != nil {
+			klog.Warningf("scaling node pool %q: %v", name, err)
+			continue
+		}
+		if current := len(node.PoolNodes(*cc, name)); target > current {
+			klog.Infof("scaling node pool %q from %d to %d nodes", name, current, target)
+			if err := p.scale(cc, pool, target); err != nil {
+				klog.Warningf("scaling node pool %q: %v", name, err)
+				// the nodes which failed to start are gone, report the actual size so the autoscaler notices
+				p.mu.Lock()
+				p.target[name] = len(node.PoolNodes(*cc, name))
+				p.mu.Unlock()
+			}
+		}
+	}
+	if _, err := p.readProfile(); err != nil {
+		klog.Warningf("reading the node pools: %v", err)
+	}
+	p.setProviderIDs(ctx)
+}
+
+// setProviderIDs gives the Kubernetes nodes of the autoscaled pools a provider ID, which the autoscaler
+// uses to match them with the instances of their node group
+func (p *Provider) setProviderIDs(ctx context.Context) {
+	nodes, err := p.client.CoreV1().Nodes().List(ctx, meta.ListOptions{LabelSelector: node.PoolLabel})
+	if err != nil {
+		klog.Warningf("list pool nodes: %v", err)
+		return
+	}
+	for _, n := range nodes.Items {
+		if n.Spec.ProviderID != "" || p.findMember(n.Name) == "" {
+			continue
+		}
+		patch := fmt.Sprintf(`{"spec":{"providerID":%q}}`, ProviderIDPrefix+n.Name)
+		if _, err := p.client.CoreV1().Nodes().Patch(ctx, n.Name, types.StrategicMergePatchType, []byte(patch), meta.PatchOptions{}); err != nil {
+			klog.Warningf("set provider ID of node %q: %v", n.Name, err)
+		}
+	}
+}
+
+// findMember returns the pool of the machine, or an empty string if it is not part of an autoscaled pool
+func (p *Provider) findMember(machine string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for pool, members := range p.members {
+		for _, m := range members {
+			if m.machine == machine {
+				return pool
+			}
+		}
+	}
+	return ""
+}
+
+// machineName returns the machine of a node sent by the autoscaler
+func machineName(n *protos.ExternalGrpcNode) string {
+	if strings.HasPrefix(n.GetProviderID(), ProviderIDPrefix) {
+		return strings.TrimPrefix(n.GetProviderID(), ProviderIDPrefix)
+	}
+	return n.GetName()
+}
+
+// group returns the node group of the pool, p.mu must be held
+func (p *Provider) group(pool config.NodePool) *protos.NodeGroup {
+	return &protos.NodeGroup{
+		Id:      pool.Name,
+		MinSize: int32(pool.MinNodes),
+		MaxSize: int32(pool.MaxNodes),
+		Debug:   fmt.Sprintf("minikube node pool %s of cluster %s", pool.Name, p.base.Name),
+	}
+}
+
+// lookup returns the pool with the given id, p.mu must be held
+func (p *Provider) lookup(id string) (config.NodePool, error) {
+	pool, ok := p.pools[id]
+	if !ok {
+		return pool, status.Errorf(codes.NotFound, "node group %q not found", id)
+	}
+	return pool, nil
+}
+
+// lookupCurrent reads the profile back before looking up the pool, for the requests which change the node group, p.mu must be held
+func (p *Provider) lookupCurrent(id string) (config.NodePool, error) {
+	if _, err := p.reload(); err != nil {
+		return config.NodePool{}, status.Error(codes.Unavailable, err.Error())
+	}
+	return p.lookup(id)
+}
+
+// NodeGroups returns the autoscaled node pools
+func (p *Provider) NodeGroups(_ context.Context, _ *protos.NodeGroupsRequest) (*protos.NodeGroupsResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	resp := &protos.NodeGroupsResponse{}
+	for _, name := range slices.Sorted(maps.Keys(p.pools)) {
+		resp.NodeGroups = append(resp.NodeGroups, p.group(p.pools[name]))
+	}
+	return resp, nil
+}
+
+// NodeGroupForNode returns the pool of the node, or an empty node group for nodes outside of the autoscaled pools
+func (p *Provider) NodeGroupForNode(_ context.Context, req *protos.NodeGroupForNodeRequest) (*protos.NodeGroupForNodeResponse, error) {
+	name := p.findMember(machineName(req.GetNode()))
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if name == "" {
+		// an empty node group tells the autoscaler to leave the node alone
+		return &protos.NodeGroupForNodeResponse{NodeGroup: &protos.NodeGroup{}}, nil
+	}
+	return &protos.NodeGroupForNodeResponse{NodeGroup: p.group(p.pools[name])}, nil
+}
+
+// GPULabel returns no label, the nodes of minikube have no GPUs the autoscaler needs to know about
+func (p *Provider) GPULabel(_ context.Context, _ *protos.GPULabelRequest) (*protos.GPULabelResponse, error) {
+	return &protos.GPULabelResponse{}, nil
+}
+
+// GetAvailableGPUTypes returns no GPU types
+func (p *Provider) GetAvailableGPUTypes(_ context.Context, _ *protos.GetAvailableGPUTypesRequest) (*protos.GetAvailableGPUTypesResponse, error) {
+	return &protos.GetAvailableGPUTypesResponse{}, nil
+}
+
+// Cleanup has nothing to clean up, the worker stops with the server
+func (p *Provider) Cleanup(_ context.Context, _ *protos.CleanupRequest) (*protos.CleanupResponse, error) {
+	return &protos.CleanupResponse{}, nil
+}
+
+// Refresh lets the worker read the profile back and give new nodes their provider ID
+func (p *Provider) Refresh(_ context.Context, _ *protos.RefreshRequest) (*protos.RefreshResponse, error) {
+	p.notify()
+	return &protos.RefreshResponse{}, nil
+}
+
+// NodeGroupTargetSize returns the number of nodes the pool has once the pending changes are done
+func (p *Provider) NodeGroupTargetSize(_ context.Context, req *protos.NodeGroupTargetSizeRequest) (*protos.NodeGroupTargetSizeResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.lookup(req.GetId()); err != nil {
+		return nil, err
+	}
+	return &protos.NodeGroupTargetSizeResponse{TargetSize: int32(p.target[req.GetId()])}, nil
+}
+
+// NodeGroupIncreaseSize raises the target size of the pool, the worker then starts the new nodes
+func (p *Provider) NodeGroupIncreaseSize(_ context.Context, req *protos.NodeGroupIncreaseSizeRequest) (*protos.NodeGroupIncreaseSizeResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pool, err := p.lookupCurrent(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDelta() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size increase must be positive, got %d", req.GetDelta())
+	}
+	target := p.target[req.GetId()] + int(req.GetDelta())
+	if target > pool.MaxNodes {
+		return nil, status.Errorf(codes.InvalidArgument, "size increase too large: desired %d, max %d", target, pool.MaxNodes)
+	}
+	klog.Infof("increasing target size of node pool %q to %d", req.GetId(), target)
+	p.target[req.GetId()] = target
+	p.notify()
+	return &protos.NodeGroupIncreaseSizeResponse{}, nil
+}
+
+// NodeGroupDeleteNodes marks the nodes for deletion and lowers the target size of the pool, the worker then deletes them
+func (p *Provider) NodeGroupDeleteNodes(_ context.Context, req *protos.NodeGroupDeleteNodesRequest) (*protos.NodeGroupDeleteNodesResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pool, err := p.lookupCurrent(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var machines []string
+	for _, n := range req.GetNodes() {
+		m := machineName(n)
+		if !slices.ContainsFunc(p.members[req.GetId()], func(c member) bool { return c.machine == m }) {
+			return nil, status.Errorf(codes.InvalidArgument, "node %q does not belong to node group %q", m, req.GetId())
+		}
+		machines = append(machines, m)
+	}
+	if p.target[req.GetId()]-len(machines) < pool.MinNodes {
+		return nil, status.Errorf(codes.FailedPrecondition, "node group %q would go below its minimum size of %d", req.GetId(), pool.MinNodes)
+	}
+	for _, m := range machines {
+		if p.deleting[m] {
+			continue
+		}
+		klog.Infof("deleting node %q of node pool %q", m, req.GetId())
+		p.deleting[m] = true
+		p.target[req.GetId()]--
+	}
+	p.notify()
+	return &protos.NodeGroupDeleteNodesResponse{}, nil
+}
+
+// NodeGroupDecreaseTargetSize lowers the target size of the pool, only by nodes which were not started yet
+func (p *Provider) NodeGroupDecreaseTargetSize(_ context.Context, req *protos.NodeGroupDecreaseTargetSizeRequest) (*protos.NodeGroupDecreaseTargetSizeResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.lookupCurrent(req.GetId()); err != nil {
+		return nil, err
+	}
+	if req.GetDelta() >= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size decrease must be negative, got %d", req.GetDelta())
+	}
+	existing := 0
+	for _, m := range p.members[req.GetId()] {
+		if !p.deleting[m.machine] {
+			existing++
+		}
+	}
+	target := p.target[req.GetId()] + int(req.GetDelta())
+	if target < existing {
+		return nil, status.Errorf(codes.InvalidArgument, "attempt to delete existing nodes: target size %d, existing nodes %d", target, existing)
+	}
+	p.target[req.GetId()] = target
+	return &protos.NodeGroupDecreaseTargetSizeResponse{}, nil
+}
+
+// NodeGroupNodes returns the nodes of the pool, identified by their provider ID
+func (p *Provider) NodeGroupNodes(_ context.Context, req *protos.NodeGroupNodesRequest) (*protos.NodeGroupNodesResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.lookup(req.GetId()); err != nil {
+		return nil, err
+	}
+	resp := &protos.NodeGroupNodesResponse{}
+	for _, m := range p.members[req.GetId()] {
+		state := protos.InstanceStatus_instanceRunning
+		if p.deleting[m.machine] {
+			state = protos.InstanceStatus_instanceDeleting
+		}
+		resp.Instances = append(resp.Instances, &protos.Instance{Id: ProviderIDPrefix + m.machine, Status: &protos.InstanceStatus{InstanceState: state}})
+	}
+	return resp, nil
+}
+
+// NodeGroupTemplateNodeInfo returns what a new node of the pool looks like
+func (p *Provider) NodeGroupTemplateNodeInfo(_ context.Context, req *protos.NodeGroupTemplateNodeInfoRequest) (*protos.NodeGroupTemplateNodeInfoResponse, error) {
+	p.mu.Lock()
+	pool, err := p.lookup(req.GetId())
+	base := p.base
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := templateNode(base, pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	b, err := tmpl.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protos.NodeGroupTemplateNodeInfoResponse{NodeInfo: b}, nil
+}
+
+// templateNode returns what a new node of the pool looks like, which lets the autoscaler scale the pool up from zero
+func templateNode(cc config.ClusterConfig, pool config.NodePool) (*core.Node, error) {
+	res := config.WithNodeResources(cc, config.Node{CPUs: pool.CPUs, Memory: pool.Memory, DiskSize: pool.DiskSize})
+	name := fmt.Sprintf("%s-%s-template", cc.Name, pool.Name)
+
+	labels := maps.Clone(pool.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[node.PoolLabel] = pool.Name
+	labels[core.LabelHostname] = name
+	labels[core.LabelOSStable] = "linux"
+
+	var taints []core.Taint
+	for _, t := range pool.Taints {
+		taint, err := node.ParseTaint(t)
+		if err != nil {
+			return nil, err
+		}
+		taints = append(taints, taint)
+	}
+
+	capacity := core.ResourceList{
+		core.ResourceCPU:    *resource.NewQuantity(int64(res.CPUs), resource.DecimalSI),
+		core.ResourceMemory: *resource.NewQuantity(int64(res.Memory)*1024*1024, resource.BinarySI),
+		core.ResourcePods:   *resource.NewQuantity(110, resource.DecimalSI),
+	}
+	if res.DiskSize > 0 {
+		capacity[core.ResourceEphemeralStorage] = *resource.NewQuantity(int64(res.DiskSize)*1024*1024, resource.BinarySI)
+	}
+
+	return &core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: name, Labels: labels},
+		Spec:       core.NodeSpec{Taints: taints},
+		Status: core.NodeStatus{
+			Capacity:    capacity,
+			Allocatable: capacity,
+			Conditions:  []core.NodeCondition{{Type: core.NodeReady, Status: core.ConditionTrue}},
+		},
+	}, nil
+}
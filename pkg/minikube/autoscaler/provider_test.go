@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/third_party/cluster-autoscaler/cloudprovider/externalgrpc/protos"
+)
+
+// profile is the stored cluster config, which the provider reads back before every change
+type profile struct {
+	mu sync.Mutex
+	cc config.ClusterConfig
+}
+
+func (s *profile) load() (*config.ClusterConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cc := s.cc
+	cc.Nodes = slices.Clone(s.cc.Nodes)
+	cc.NodePools = slices.Clone(s.cc.NodePools)
+	return &cc, nil
+}
+
+func (s *profile) save(cc *config.ClusterConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cc = *cc
+	s.cc.Nodes = slices.Clone(cc.Nodes)
+	s.cc.NodePools = slices.Clone(cc.NodePools)
+}
+
+// testProvider serves a provider for a cluster with an autoscaled web pool of one node and a db pool left alone
+func testProvider(t *testing.T) (*Provider, *profile, protos.CloudProviderClient) {
+	cc := &config.ClusterConfig{
+		Name:  "p",
+		CPUs:  2,
+		Nodes: []config.Node{{Name: "", ControlPlane: true}, {Name: "m02", Worker: true, Pool: "web"}, {Name: "m03", Worker: true, Pool: "db"}},
+		NodePools: []config.NodePool{
+			{Name: "web", CPUs: 4, Memory: 8192, Labels: map[string]string{"tier": "web"}, Taints: []string{"tier=web:NoSchedule"}, MinNodes: 1, MaxNodes: 3},
+			{Name: "db"},
+		},
+	}
+	stored := &profile{}
+	stored.save(cc)
+	client := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "p-m02", Labels: map[string]string{node.PoolLabel: "web"}}})
+	p := NewProvider(cc, client, time.Minute, &run.CommandOptions{})
+	p.load = stored.load
+	p.scale = func(cc *config.ClusterConfig, pool config.NodePool, count int) error {
+		for len(node.PoolNodes(*cc, pool.Name)) < count {
+			last, _ := node.ID(cc.Nodes[len(cc.Nodes)-1].Name)
+			cc.Nodes = append(cc.Nodes, config.Node{Name: node.Name(last + 1), Worker: true, Pool: pool.Name})
+		}
+		stored.save(cc)
+		return nil
+	}
+	p.remove = func(cc *config.ClusterConfig, nodes []config.Node) error {
+		cc.Nodes = slices.DeleteFunc(cc.Nodes, func(n config.Node) bool {
+			return slices.ContainsFunc(nodes, func(d config.Node) bool { return d.Name == n.Name })
+		})
+		stored.save(cc)
+		return nil
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Serve(ctx, lis) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve: %v", err)
+		}
+	})
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return p, stored, protos.NewCloudProviderClient(conn)
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// waitInstances waits for the instances of the web node group to match want
+func waitInstances(t *testing.T, c protos.CloudProviderClient, want ...string) {
+	var got []string
+	for range 50 {
+		resp, err := c.NodeGroupNodes(testContext(t), &protos.NodeGroupNodesRequest{Id: "web"})
+		if err != nil {
+			t.Fatalf("NodeGroupNodes: %v", err)
+		}
+		got = nil
+		for _, i := range resp.GetInstances() {
+			got = append(got, i.GetId())
+		}
+		if slices.Equal(got, want) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("instances = %v, want %v", got, want)
+}
+
+func targetSize(t *testing.T, c protos.CloudProviderClient) int32 {
+	resp, err := c.NodeGroupTargetSize(testContext(t), &protos.NodeGroupTargetSizeRequest{Id: "web"})
+	if err != nil {
+		t.Fatalf("NodeGroupTargetSize: %v", err)
+	}
+	return resp.GetTargetSize()
+}
+
+func TestNodeGroups(t *testing.T) {
+	_, _, c := testProvider(t)
+
+	groups, err := c.NodeGroups(testContext(t), &protos.NodeGroupsRequest{})
+	if err != nil {
+		t.Fatalf("NodeGroups: %v", err)
+	}
+	if g := groups.GetNodeGroups(); len(g) != 1 || g[0].GetId() != "web" || g[0].GetMinSize() != 1 || g[0].GetMaxSize() != 3 {
+		t.Errorf("NodeGroups() = %+v, want only web with 1 to 3 nodes", g)
+	}
+
+	for name, want := range map[string]string{"p-m02": "web", "p-m03": "", "p": ""} {
+		resp, err := c.NodeGroupForNode(testContext(t), &protos.NodeGroupForNodeRequest{Node: &protos.ExternalGrpcNode{Name: name}})
+		if err != nil {
+			t.Fatalf("NodeGroupForNode(%s): %v", name, err)
+		}
+		if got := resp.GetNodeGroup().GetId(); got != want {
+			t.Errorf("NodeGroupForNode(%s) = %q, want %q", name, got, want)
+		}
+	}
+
+	_, err = c.NodeGroupTargetSize(testContext(t), &protos.NodeGroupTargetSizeRequest{Id: "db"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("NodeGroupTargetSize(db) = %v, want NotFound", err)
+	}
+	_, err = c.PricingNodePrice(testContext(t), &protos.PricingNodePriceRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("PricingNodePrice() = %v, want Unimplemented", err)
+	}
+}
+
+func TestScaling(t *testing.T) {
+	_, _, c := testProvider(t)
+
+	if got := targetSize(t, c); got != 1 {
+		t.Fatalf("initial target size = %d, want 1", got)
+	}
+	_, err := c.NodeGroupIncreaseSize(testContext(t), &protos.NodeGroupIncreaseSizeRequest{Delta: 3, Id: "web"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("increase above the maximum size = %v, want InvalidArgument", err)
+	}
+	if _, err := c.NodeGroupIncreaseSize(testContext(t), &protos.NodeGroupIncreaseSizeRequest{Delta: 2, Id: "web"}); err != nil {
+		t.Fatalf("NodeGroupIncreaseSize: %v", err)
+	}
+	waitInstances(t, c, "minikube://p-m02", "minikube://p-m04", "minikube://p-m05")
+
+	_, err = c.NodeGroupDecreaseTargetSize(testContext(t), &protos.NodeGroupDecreaseTargetSizeRequest{Delta: -1, Id: "web"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("decrease below the existing nodes = %v, want InvalidArgument", err)
+	}
+
+	del := &protos.NodeGroupDeleteNodesRequest{Nodes: []*protos.ExternalGrpcNode{{ProviderID: "minikube://p-m02"}}, Id: "web"}
+	if _, err := c.NodeGroupDeleteNodes(testContext(t), del); err != nil {
+		t.Fatalf("NodeGroupDeleteNodes: %v", err)
+	}
+	waitInstances(t, c, "minikube://p-m04", "minikube://p-m05")
+	if got := targetSize(t, c); got != 2 {
+		t.Errorf("target size after deleting a node = %d, want 2", got)
+	}
+
+	del = &protos.NodeGroupDeleteNodesRequest{Nodes: []*protos.ExternalGrpcNode{{ProviderID: "minikube://p-m04"}, {ProviderID: "minikube://p-m05"}}, Id: "web"}
+	_, err = c.NodeGroupDeleteNodes(testContext(t), del)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("delete below the minimum size = %v, want FailedPrecondition", err)
+	}
+	del = &protos.NodeGroupDeleteNodesRequest{Nodes: []*protos.ExternalGrpcNode{{Name: "p-m03"}}, Id: "web"}
+	_, err = c.NodeGroupDeleteNodes(testContext(t), del)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("delete of a node of another pool = %v, want InvalidArgument", err)
+	}
+}
+
+func TestProfileChanges(t *testing.T) {
+	_, stored, c := testProvider(t)
+
+	// meanwhile 'minikube nodepool scale' adds a node to the pool and 'minikube nodepool autoscale' raises its maximum
+	cc, _ := stored.load()
+	cc.Nodes = append(cc.Nodes, config.Node{Name: "m04", Worker: true, Pool: "web"})
+	cc.NodePools[0].MaxNodes = 4
+	stored.save(cc)
+
+	if _, err := c.NodeGroupIncreaseSize(testContext(t), &protos.NodeGroupIncreaseSizeRequest{Delta: 2, Id: "web"}); err != nil {
+		t.Fatalf("NodeGroupIncreaseSize: %v", err)
+	}
+	waitInstances(t, c, "minikube://p-m02", "minikube://p-m04", "minikube://p-m05", "minikube://p-m06")
+	if got := targetSize(t, c); got != 4 {
+		t.Errorf("target size = %d, want 4", got)
+	}
+
+	cc, _ = stored.load()
+	if len(cc.Nodes) != 6 || cc.NodePools[0].MaxNodes != 4 {
+		t.Errorf("stored config = %+v, want the changes made outside of the provider kept", cc)
+	}
+}
+
+func TestProviderIDs(t *testing.T) {
+	p, _, c := testProvider(t)
+
+	if _, err := c.Refresh(testContext(t), &protos.RefreshRequest{}); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	for range 50 {
+		n, err := p.client.CoreV1().Nodes().Get(context.Background(), "p-m02", meta.GetOptions{})
+		if err != nil {
+			t.Fatalf("get node: %v", err)
+		}
+		if n.Spec.ProviderID == "minikube://p-m02" {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Errorf("provider ID of node p-m02 was not set")
+}
+
+func TestTemplateNodeInfo(t *testing.T) {
+	_, _, c := testProvider(t)
+
+	resp, err := c.NodeGroupTemplateNodeInfo(testContext(t), &protos.NodeGroupTemplateNodeInfoRequest{Id: "web"})
+	if err != nil {
+		t.Fatalf("NodeGroupTemplateNodeInfo: %v", err)
+	}
+	var n core.Node
+	if err := n.Unmarshal(resp.GetNodeInfo()); err != nil {
+		t.Fatalf("unmarshal node: %v", err)
+	}
+	if n.Labels["tier"] != "web" || n.Labels[node.PoolLabel] != "web" {
+		t.Errorf("template labels = %v, want tier=web and %s=web", n.Labels, node.PoolLabel)
+	}
+	if cpu := n.Status.Allocatable.Cpu().Value(); cpu != 4 {
+		t.Errorf("template CPUs = %d, want 4", cpu)
+	}
+	if mem := n.Status.Allocatable.Memory().Value(); mem != 8192*1024*1024 {
+		t.Errorf("template memory = %d, want 8Gi", mem)
+	}
+	if len(n.Spec.Taints) != 1 || n.Spec.Taints[0].Effect != core.TaintEffectNoSchedule {
+		t.Errorf("template taints = %v, want tier=web:NoSchedule", n.Spec.Taints)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+
+	"google.golang.org/grpc/credentials"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util"
+)
+
+const (
+	// CloudConfigSecret is the secret in kube-system holding the cloud config and the client certificate of the cluster autoscaler
+	CloudConfigSecret = "cluster-autoscaler-cloud-config"
+	// CloudConfigDir is where the cluster autoscaler is expected to mount CloudConfigSecret
+	CloudConfigDir = "/etc/cluster-autoscaler"
+)
+
+// Certs are the files of the mutual TLS between minikube and the cluster autoscaler
+type Certs struct {
+	// CACert signs the server certificate and the client certificates which are accepted
+	CACert     string
+	ServerCert string
+	ServerKey  string
+	// ClientCert and ClientKey are only set for the certificates generated by minikube
+	ClientCert string
+	ClientKey  string
+}
+
+// GenerateCerts signs a server and a client certificate with the minikube CA, in the autoscaler directory of the profile.
+// The server certificate is valid for the host alias of the cluster and the given IPs.
+func GenerateCerts(profile string, ips []net.IP) (Certs, error) {
+	dir := filepath.Join(localpath.Profile(profile), "autoscaler")
+	c := Certs{
+		CACert:     localpath.CACert(),
+		ServerCert: filepath.Join(dir, "server.crt"),
+		ServerKey:  filepath.Join(dir, "server.key"),
+		ClientCert: filepath.Join(dir, "client.crt"),
+		ClientKey:  filepath.Join(dir, "client.key"),
+	}
+	caKey := filepath.Join(localpath.MiniPath(), "ca.key")
+
+	if err := util.GenerateSignedCert(c.ServerCert, c.ServerKey, "minikube-autoscaler", ips, []string{constants.HostAlias, "localhost"}, c.CACert, caKey, constants.DefaultCertExpiration); err != nil {
+		return c, fmt.Errorf("server certificate: %w", err)
+	}
+	if err := util.GenerateSignedCert(c.ClientCert, c.ClientKey, "cluster-autoscaler", nil, nil, c.CACert, caKey, constants.DefaultCertExpiration); err != nil {
+		return c, fmt.Errorf("client certificate: %w", err)
+	}
+	return c, nil
+}
+
+// ServerCredentials returns the TLS credentials of the server, which only accepts clients with a certificate signed by c.CACert
+func ServerCredentials(c Certs) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(c.ServerCert, c.ServerKey)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := os.ReadFile(c.CACert)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", c.CACert)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// CloudConfig returns the externalgrpc cloud config of the cluster autoscaler, with the certificates mounted in dir
+func CloudConfig(address, dir string) string {
+	return fmt.Sprintf("address: %q\ncert: %q\nkey: %q\ncacert: %q\n", address,
+		path.Join(dir, core.TLSCertKey), path.Join(dir, core.TLSPrivateKeyKey), path.Join(dir, "ca.crt"))
+}
+
+// SaveCloudConfig creates or updates CloudConfigSecret with the cloud config and the client certificate generated by minikube
+func SaveCloudConfig(ctx context.Context, client kubernetes.Interface, c Certs, address string) error {
+	data := map[string][]byte{"cloud-config": []byte(CloudConfig(address, CloudConfigDir))}
+	for key, file := range map[string]string{"ca.crt": c.CACert, core.TLSCertKey: c.ClientCert, core.TLSPrivateKeyKey: c.ClientKey} {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		data[key] = b
+	}
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: CloudConfigSecret, Namespace: meta.NamespaceSystem},
+		Data:       data,
+	}
+	secrets := client.CoreV1().Secrets(meta.NamespaceSystem)
+	_, err := secrets.Create(ctx, secret, meta.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(ctx, secret, meta.UpdateOptions{})
+	}
+	return err
+}
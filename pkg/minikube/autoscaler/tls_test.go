@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/pkg/util"
+	"k8s.io/minikube/third_party/cluster-autoscaler/cloudprovider/externalgrpc/protos"
+)
+
+func TestMutualTLS(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	if err := util.GenerateCACert(localpath.CACert(), filepath.Join(localpath.MiniPath(), "ca.key"), "minikubeCA"); err != nil {
+		t.Fatalf("generate CA: %v", err)
+	}
+	certs, err := GenerateCerts("p", []net.IP{net.ParseIP("127.0.0.1")})
+	if err != nil {
+		t.Fatalf("GenerateCerts: %v", err)
+	}
+	creds, err := ServerCredentials(certs)
+	if err != nil {
+		t.Fatalf("ServerCredentials: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	p := NewProvider(&config.ClusterConfig{Name: "p"}, fake.NewSimpleClientset(), time.Minute, &run.CommandOptions{})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Serve(ctx, lis, grpc.Creds(creds)) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	pem, err := os.ReadFile(certs.CACert)
+	if err != nil {
+		t.Fatalf("read CA: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(pem)
+	client, err := tls.LoadX509KeyPair(certs.ClientCert, certs.ClientKey)
+	if err != nil {
+		t.Fatalf("load client certificate: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		certs []tls.Certificate
+		ok    bool
+	}{
+		{"client certificate", []tls.Certificate{client}, true},
+		{"no client certificate", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			creds := credentials.NewTLS(&tls.Config{RootCAs: roots, Certificates: tc.certs, ServerName: "host.minikube.internal", MinVersion: tls.VersionTLS12})
+			conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(creds))
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer conn.Close()
+			_, err = protos.NewCloudProviderClient(conn).NodeGroups(testContext(t), &protos.NodeGroupsRequest{})
+			if (err == nil) != tc.ok {
+				t.Errorf("NodeGroups() = %v, want success %v", err, tc.ok)
+			}
+		})
+	}
+}
+
+func TestSaveCloudConfig(t *testing.T) {
+	dir := t.TempDir()
+	certs := Certs{CACert: filepath.Join(dir, "ca.crt"), ClientCert: filepath.Join(dir, "client.crt"), ClientKey: filepath.Join(dir, "client.key")}
+	for _, f := range []string{certs.CACert, certs.ClientCert, certs.ClientKey} {
+		if err := os.WriteFile(f, []byte(filepath.Base(f)), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := fake.NewSimpleClientset()
+
+	// the second save updates the secret
+	for range 2 {
+		if err := SaveCloudConfig(context.Background(), client, certs, "host.minikube.internal:8086"); err != nil {
+			t.Fatalf("SaveCloudConfig: %v", err)
+		}
+	}
+	s, err := client.CoreV1().Secrets(meta.NamespaceSystem).Get(context.Background(), CloudConfigSecret, meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get secret: %v", err)
+	}
+	if string(s.Data["tls.key"]) != "client.key" || string(s.Data["ca.crt"]) != "ca.crt" {
+		t.Errorf("secret data = %v, want the client certificate and the CA", s.Data)
+	}
+	want := `address: "host.minikube.internal:8086"` + "\n" + `cert: "/etc/cluster-autoscaler/tls.crt"`
+	if !strings.HasPrefix(string(s.Data["cloud-config"]), want) {
+		t.Errorf("cloud config = %q, want it to start with %q", s.Data["cloud-config"], want)
+	}
+}
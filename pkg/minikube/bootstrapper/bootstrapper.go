@@ -17,6 +17,7 @@ limitations under the License.
 package bootstrapper
 
 import (
+	"context"
 	"time"
 
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
@@ -38,17 +39,20 @@ type LogOptions struct {
 type Bootstrapper interface {
 	// LabelAndUntaintNode applies minikube labels to node and removes NoSchedule taints from control-plane nodes.
 	LabelAndUntaintNode(config.ClusterConfig, config.Node) error
-	StartCluster(config.ClusterConfig, *run.CommandOptions) error
-	UpdateCluster(config.ClusterConfig) error
+	StartCluster(context.Context, config.ClusterConfig, *run.CommandOptions) error
+	UpdateCluster(context.Context, config.ClusterConfig) error
 	DeleteCluster(config.KubernetesConfig) error
-	WaitForNode(config.ClusterConfig, config.Node, time.Duration) error
-	JoinCluster(config.ClusterConfig, config.Node, string) error
+	WaitForNode(context.Context, config.ClusterConfig, config.Node, time.Duration) error
+	JoinCluster(context.Context, config.ClusterConfig, config.Node, string) error
 	UpdateNode(config.ClusterConfig, config.Node, cruntime.Manager) error
+	// UpgradeNode upgrades Kubernetes on an existing node to the version of the given config, force allows downgrades.
+	// It does not write the kube-vip manifest of HA control-plane nodes, which the caller restores once the node is upgraded.
+	UpgradeNode(ctx context.Context, cfg config.ClusterConfig, n config.Node, force bool) error
 	GenerateToken(config.ClusterConfig) (string, error)
 	// LogCommands returns a map of log type to a command which will display that log.
 	LogCommands(config.ClusterConfig, LogOptions) map[string]string
 	// SetupCerts gets the generated credentials required to talk to the APIServer.
-	SetupCerts(config.ClusterConfig, config.Node, cruntime.CommandRunner) error
+	SetupCerts(context.Context, config.ClusterConfig, config.Node, cruntime.CommandRunner) error
 	GetAPIServerStatus(string, int) (string, error)
 }
 
@@ -22,11 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
 	"os"
 	"os/exec"
 	"path"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -66,6 +68,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/sysinit"
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/network"
+	"k8s.io/minikube/pkg/trace"
 	"k8s.io/minikube/pkg/util"
 	"k8s.io/minikube/pkg/util/retry"
 	"k8s.io/minikube/pkg/version"
@@ -172,7 +175,13 @@ func (k *Bootstrapper) clearStaleConfigs(cfg config.ClusterConfig) {
 }
 
 // init initialises primary control-plane using kubeadm.
-func (k *Bootstrapper) init(cfg config.ClusterConfig, options *run.CommandOptions) error {
+func (k *Bootstrapper) init(ctx context.Context, cfg config.ClusterConfig, options *run.CommandOptions) (err error) {
+	ctx, span := trace.Start(ctx, "kubeadm init")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
 	ver, err := util.ParseKubernetesVersion(cfg.KubernetesConfig.KubernetesVersion)
 	if err != nil {
 		return fmt.Errorf("parsing Kubernetes version: %w", err)
@@ -226,7 +235,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig, options *run.CommandOption
 	k.clearStaleConfigs(cfg)
 
 	conf := constants.KubeadmYamlPath
-	ctx, cancel := context.WithTimeout(context.Background(), initTimeoutMinutes*time.Minute)
+	initCtx, cancel := context.WithTimeout(ctx, initTimeoutMinutes*time.Minute)
 	defer cancel()
 	kr, kw := io.Pipe()
 
@@ -237,7 +246,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig, options *run.CommandOption
 		extraFlags,
 		strings.Join(ignore, ","),
 	)
-	c := exec.CommandContext(ctx, "sudo", "/bin/bash", "-c", cmd)
+	c := exec.CommandContext(initCtx, "sudo", "/bin/bash", "-c", cmd)
 
 	c.Stdout = kw
 	c.Stderr = kw
@@ -250,7 +259,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig, options *run.CommandOption
 		outputKubeadmInitSteps(kr)
 	})
 	if _, err := k.c.WaitCmd(sc); err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
+		if initCtx.Err() == context.DeadlineExceeded {
 			return ErrInitTimedout
 		}
 
@@ -262,7 +271,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig, options *run.CommandOption
 	kw.Close()
 	wg.Wait()
 
-	if err := k.applyCNI(cfg, true); err != nil {
+	if err := k.applyCNI(ctx, cfg, true); err != nil {
 		return fmt.Errorf("apply cni: %w", err)
 	}
 
@@ -340,7 +349,7 @@ func outputKubeadmInitSteps(logs io.Reader) {
 }
 
 // applyCNI applies CNI to a cluster. Needs to be done every time a VM is powered up.
-func (k *Bootstrapper) applyCNI(cfg config.ClusterConfig, registerStep ...bool) error {
+func (k *Bootstrapper) applyCNI(ctx context.Context, cfg config.ClusterConfig, registerStep ...bool) error {
 	regStep := false
 	if len(registerStep) > 0 {
 		regStep = registerStep[0]
@@ -363,7 +372,10 @@ func (k *Bootstrapper) applyCNI(cfg config.ClusterConfig, registerStep ...bool)
 		out.Styled(style.CNI, "Configuring {{.name}} (Container Networking Interface) ...", out.V{"name": cnm.String()})
 	}
 
+	_, span := trace.Start(ctx, "cni apply", trace.String("cni", cnm.String()))
+	defer span.End()
 	if err := cnm.Apply(k.c); err != nil {
+		span.RecordError(err)
 		return fmt.Errorf("cni apply: %w", err)
 	}
 
@@ -391,7 +403,10 @@ func (k *Bootstrapper) unpause(cfg config.ClusterConfig) error {
 }
 
 // StartCluster starts the cluster
-func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig, options *run.CommandOptions) error {
+func (k *Bootstrapper) StartCluster(ctx context.Context, cfg config.ClusterConfig, options *run.CommandOptions) error {
+	ctx, span := trace.Start(ctx, "kubeadm StartCluster")
+	defer span.End()
+
 	start := time.Now()
 	klog.Infof("StartCluster: %+v", cfg)
 	defer func() {
@@ -412,7 +427,7 @@ func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig, options *run.Comma
 		klog.Infof("found existing configuration files, will attempt cluster restart")
 
 		var rerr error
-		if rerr := k.restartPrimaryControlPlane(cfg); rerr == nil {
+		if rerr := k.restartPrimaryControlPlane(ctx, cfg); rerr == nil {
 			return nil
 		}
 		out.ErrT(style.Embarrassed, "Unable to restart control-plane node(s), will reset cluster: {{.error}}", out.V{"error": rerr})
@@ -427,7 +442,7 @@ func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig, options *run.Comma
 		return fmt.Errorf("cp: %w", err)
 	}
 
-	err := k.init(cfg, options)
+	err := k.init(ctx, cfg, options)
 	if err == nil {
 		return nil
 	}
@@ -438,7 +453,7 @@ func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig, options *run.Comma
 		if err := k.DeleteCluster(cfg.KubernetesConfig); err != nil {
 			klog.Warningf("delete failed: %v", err)
 		}
-		return k.init(cfg, options)
+		return k.init(ctx, cfg, options)
 	}
 	return err
 }
@@ -496,7 +511,10 @@ func (k *Bootstrapper) client(ip string, port int) (*kubernetes.Clientset, error
 
 // WaitForNode blocks until the node appears to be healthy.
 // It should not be called for [re]started primary control-plane node in HA clusters.
-func (k *Bootstrapper) WaitForNode(cfg config.ClusterConfig, n config.Node, timeout time.Duration) error {
+func (k *Bootstrapper) WaitForNode(ctx context.Context, cfg config.ClusterConfig, n config.Node, timeout time.Duration) error {
+	ctx, span := trace.Start(ctx, "WaitForNode", trace.String("node", config.MachineName(cfg, n)))
+	defer span.End()
+
 	start := time.Now()
 	register.Reg.SetStep(register.VerifyingKubernetes)
 	out.Step(style.HealthCheck, "Verifying Kubernetes components...")
@@ -533,7 +551,9 @@ func (k *Bootstrapper) WaitForNode(cfg config.ClusterConfig, n config.Node, time
 	// if extra waiting for system pods to be ready is required, we need node to be ready beforehand
 	if cfg.VerifyComponents[kverify.NodeReadyKey] || cfg.VerifyComponents[kverify.ExtraKey] {
 		name := bsutil.KubeNodeName(cfg, n)
-		if err := kverify.WaitNodeCondition(client, name, core.NodeReady, timeout); err != nil {
+		if err := waitForComponent(ctx, kverify.NodeReadyKey, func() error {
+			return kverify.WaitNodeCondition(client, name, core.NodeReady, timeout)
+		}); err != nil {
 			return fmt.Errorf("waiting for node to be ready: %w", err)
 		}
 	}
@@ -545,36 +565,48 @@ func (k *Bootstrapper) WaitForNode(cfg config.ClusterConfig, n config.Node, time
 
 	if n.ControlPlane {
 		if cfg.VerifyComponents[kverify.APIServerWaitKey] {
-			if err := kverify.WaitForAPIServerProcess(cr, k, cfg, k.c, start, timeout); err != nil {
-				return fmt.Errorf("wait for apiserver proc: %w", err)
-			}
-
-			if err := kverify.WaitForHealthyAPIServer(cr, k, cfg, k.c, client, start, hostname, port, timeout); err != nil {
-				return fmt.Errorf("wait for healthy API server: %w", err)
+			if err := waitForComponent(ctx, kverify.APIServerWaitKey, func() error {
+				if err := kverify.WaitForAPIServerProcess(cr, k, cfg, k.c, start, timeout); err != nil {
+					return fmt.Errorf("wait for apiserver proc: %w", err)
+				}
+				if err := kverify.WaitForHealthyAPIServer(cr, k, cfg, k.c, client, start, hostname, port, timeout); err != nil {
+					return fmt.Errorf("wait for healthy API server: %w", err)
+				}
+				return nil
+			}); err != nil {
+				return err
 			}
 		}
 
 		if cfg.VerifyComponents[kverify.SystemPodsWaitKey] {
-			if err := kverify.WaitForSystemPods(cr, k, cfg, k.c, client, start, timeout); err != nil {
+			if err := waitForComponent(ctx, kverify.SystemPodsWaitKey, func() error {
+				return kverify.WaitForSystemPods(cr, k, cfg, k.c, client, start, timeout)
+			}); err != nil {
 				return fmt.Errorf("waiting for system pods: %w", err)
 			}
 		}
 
 		if cfg.VerifyComponents[kverify.DefaultSAWaitKey] {
-			if err := kverify.WaitForDefaultSA(client, timeout); err != nil {
+			if err := waitForComponent(ctx, kverify.DefaultSAWaitKey, func() error {
+				return kverify.WaitForDefaultSA(client, timeout)
+			}); err != nil {
 				return fmt.Errorf("waiting for default service account: %w", err)
 			}
 		}
 
 		if cfg.VerifyComponents[kverify.AppsRunningKey] {
-			if err := kverify.WaitForAppsRunning(client, kverify.AppsRunningList, timeout); err != nil {
+			if err := waitForComponent(ctx, kverify.AppsRunningKey, func() error {
+				return kverify.WaitForAppsRunning(client, kverify.AppsRunningList, timeout)
+			}); err != nil {
 				return fmt.Errorf("waiting for apps_running: %w", err)
 			}
 		}
 	}
 
 	if cfg.VerifyComponents[kverify.KubeletKey] {
-		if err := kverify.WaitForService(k.c, "kubelet", timeout); err != nil {
+		if err := waitForComponent(ctx, kverify.KubeletKey, func() error {
+			return kverify.WaitForService(k.c, "kubelet", timeout)
+		}); err != nil {
 			return fmt.Errorf("waiting for kubelet: %w", err)
 		}
 	}
@@ -588,9 +620,23 @@ func (k *Bootstrapper) WaitForNode(cfg config.ClusterConfig, n config.Node, time
 	return nil
 }
 
+// waitForComponent traces waiting for a single component of WaitForNode
+func waitForComponent(ctx context.Context, component string, wait func() error) error {
+	_, span := trace.Start(ctx, "wait "+component, trace.String("component", component))
+	defer span.End()
+	err := wait()
+	span.RecordError(err)
+	return err
+}
+
 // restartPrimaryControlPlane restarts the kubernetes cluster configured by kubeadm.
-func (k *Bootstrapper) restartPrimaryControlPlane(cfg config.ClusterConfig) error { //nolint: gocyclo
+func (k *Bootstrapper) restartPrimaryControlPlane(ctx context.Context, cfg config.ClusterConfig) (err error) { //nolint: gocyclo
 	klog.Infof("restartPrimaryControlPlane start ...")
+	ctx, span := trace.Start(ctx, "kubeadm restart")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 
 	start := time.Now()
 	defer func() {
@@ -689,7 +735,7 @@ func (k *Bootstrapper) restartPrimaryControlPlane(cfg config.ClusterConfig) erro
 	}
 
 	// because reboots clear /etc/cni
-	if err := k.applyCNI(cfg); err != nil {
+	if err := k.applyCNI(ctx, cfg); err != nil {
 		return fmt.Errorf("apply cni: %w", err)
 	}
 
@@ -748,7 +794,13 @@ func (k *Bootstrapper) restartPrimaryControlPlane(cfg config.ClusterConfig) erro
 }
 
 // JoinCluster adds new node to an existing cluster.
-func (k *Bootstrapper) JoinCluster(cc config.ClusterConfig, n config.Node, joinCmd string) error {
+func (k *Bootstrapper) JoinCluster(ctx context.Context, cc config.ClusterConfig, n config.Node, joinCmd string) (err error) {
+	_, span := trace.Start(ctx, "kubeadm join", trace.String("node", config.MachineName(cc, n)))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
 	// Join the control plane by specifying its token
 	joinCmd = fmt.Sprintf("%s --node-name=%s", joinCmd, config.MachineName(cc, n))
 
@@ -870,12 +922,16 @@ func (k *Bootstrapper) DeleteCluster(k8s config.KubernetesConfig) error {
 }
 
 // SetupCerts sets up certificates within the cluster.
-func (k *Bootstrapper) SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd cruntime.CommandRunner) error {
-	return bootstrapper.SetupCerts(k8s, n, pcpCmd, k.c)
+func (k *Bootstrapper) SetupCerts(ctx context.Context, k8s config.ClusterConfig, n config.Node, pcpCmd cruntime.CommandRunner) error {
+	_, span := trace.Start(ctx, "SetupCerts", trace.String("node", config.MachineName(k8s, n)))
+	defer span.End()
+	err := bootstrapper.SetupCerts(k8s, n, pcpCmd, k.c)
+	span.RecordError(err)
+	return err
 }
 
 // UpdateCluster updates the control plane with cluster-level info.
-func (k *Bootstrapper) UpdateCluster(cfg config.ClusterConfig) error {
+func (k *Bootstrapper) UpdateCluster(ctx context.Context, cfg config.ClusterConfig) error {
 	klog.Infof("updating cluster %+v ...", cfg)
 
 	imgs, err := images.Kubeadm(cfg.KubernetesConfig.ImageRepository, cfg.KubernetesConfig.KubernetesVersion)
@@ -897,7 +953,11 @@ func (k *Bootstrapper) UpdateCluster(cfg config.ClusterConfig) error {
 		return fmt.Errorf("runtime: %w", err)
 	}
 
-	if err := r.Preload(cfg); err != nil {
+	_, span := trace.Start(ctx, "cruntime Preload", trace.String("runtime", r.Name()))
+	err = r.Preload(cfg)
+	span.RecordError(err)
+	span.End()
+	if err != nil {
 		switch err.(type) {
 		case *cruntime.ErrISOFeature:
 			out.ErrT(style.Tip, "Existing disk is missing new features ({{.error}}). To upgrade, run 'minikube delete'", out.V{"error": err})
@@ -927,6 +987,11 @@ func (k *Bootstrapper) UpdateCluster(cfg config.ClusterConfig) error {
 
 // UpdateNode updates new or existing node.
 func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cruntime.Manager) error {
+	return k.updateNode(cfg, n, r, true)
+}
+
+// updateNode updates new or existing node, writing the kube-vip manifest of HA control-plane nodes if kubeVip is set
+func (k *Bootstrapper) updateNode(cfg config.ClusterConfig, n config.Node, r cruntime.Manager, kubeVip bool) error {
 	klog.Infof("updating node %v ...", n)
 
 	kubeletCfg, err := bsutil.NewKubeletConfig(cfg, n, r)
@@ -958,7 +1023,7 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 			files = append(files, assets.NewMemoryAssetTarget(kubeadmCfg, constants.KubeadmYamlPath+".new", "0640"))
 		}
 		// deploy kube-vip for ha (multi-control plane) cluster
-		if config.IsHA(cfg) {
+		if config.IsHA(cfg) && kubeVip {
 			// workaround for kube-vip
 			// only applicable for k8s v1.29+ during primary control-plane node's kubeadm init (ie, first boot)
 			// TODO (prezha): remove when fixed upstream - ref: https://github.com/kube-vip/kube-vip/issues/684#issuecomment-1864855405
@@ -1020,6 +1085,73 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 	return nil
 }
 
+// UpgradeNode upgrades Kubernetes on an existing node to the version of cfg with kubeadm.
+// The primary control-plane node upgrades the cluster with 'kubeadm upgrade apply', all other nodes follow with 'kubeadm upgrade node'.
+// ref: https://kubernetes.io/docs/tasks/administer-cluster/kubeadm/kubeadm-upgrade/
+func (k *Bootstrapper) UpgradeNode(ctx context.Context, cfg config.ClusterConfig, n config.Node, force bool) (err error) {
+	_, span := trace.Start(ctx, "kubeadm upgrade", trace.String("node", config.MachineName(cfg, n)))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	start := time.Now()
+	defer func() {
+		klog.Infof("duration metric: took %s to upgrade node %q", time.Since(start), n.Name)
+	}()
+
+	kv := cfg.KubernetesConfig.KubernetesVersion
+	ver, err := util.ParseKubernetesVersion(kv)
+	if err != nil {
+		return fmt.Errorf("parsing Kubernetes version: %w", err)
+	}
+	r, err := cruntime.New(cruntime.Config{
+		Type:              cfg.KubernetesConfig.ContainerRuntime,
+		Runner:            k.c,
+		Socket:            cfg.KubernetesConfig.CRISocket,
+		KubernetesVersion: ver,
+	})
+	if err != nil {
+		return fmt.Errorf("runtime: %w", err)
+	}
+
+	// kubeadm pulls whatever is missing, the preload only saves the downloads
+	if err := r.Preload(cfg); err != nil {
+		klog.Infof("preload failed, kubeadm will pull the images: %v", err)
+	}
+
+	// transfers the new binaries and writes the kubelet config (and kubeadm.yaml.new on the primary control-plane node) for the new version.
+	// The kube-vip manifest was removed to hand the VIP off to another node, and is only written back once the node is upgraded.
+	if err := k.updateNode(cfg, n, r, false); err != nil {
+		return fmt.Errorf("update node: %w", err)
+	}
+
+	c := fmt.Sprintf("%s upgrade node", bsutil.KubeadmCmdWithPath(kv))
+	primary := config.IsPrimaryControlPlane(cfg, n)
+	if primary {
+		c = fmt.Sprintf("%s upgrade apply %s --yes", bsutil.KubeadmCmdWithPath(kv), kv)
+		if force {
+			c += " --force"
+		}
+	}
+	if _, err := k.c.RunCmd(exec.Command("sudo", "/bin/bash", "-c", c)); err != nil {
+		return fmt.Errorf("kubeadm upgrade: %w", err)
+	}
+
+	if primary {
+		// keep the kubeadm config in line with the cluster, so that the next restart does not detect a drift
+		conf := constants.KubeadmYamlPath
+		if _, err := k.c.RunCmd(exec.Command("sudo", "cp", conf+".new", conf)); err != nil {
+			return fmt.Errorf("cp: %w", err)
+		}
+	}
+
+	if err := sysinit.New(k.c).Restart("kubelet"); err != nil {
+		return fmt.Errorf("restart kubelet: %w", err)
+	}
+	return nil
+}
+
 // copyResolvConf is a workaround for a regression introduced with https://github.com/kubernetes/kubernetes/pull/109441
 // The regression is resolved by making a copy of /etc/resolv.conf, removing the line "search ." from the copy, and setting kubelet to use the copy
 // Only Kubernetes v1.25.0 is affected by this regression
@@ -1076,8 +1208,14 @@ func (k *Bootstrapper) labelAndUntaintNode(cfg config.ClusterConfig, n config.No
 
 	// example:
 	// sudo /var/lib/minikube/binaries/<version>/kubectl --kubeconfig=/var/lib/minikube/kubeconfig label --overwrite nodes test-357 minikube.k8s.io/version=<version> minikube.k8s.io/commit=aa91f39ffbcf27dcbb93c4ff3f457c54e585cf4a-dirty minikube.k8s.io/name=p1 minikube.k8s.io/updated_at=2020_02_20T12_05_35_0700
-	cmd := exec.CommandContext(ctx, "sudo", kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
-		"label", "--overwrite", "nodes", nodeName, createdAtLbl, verLbl, commitLbl, profileNameLbl, primaryLbl)
+	args := []string{kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
+		"label", "--overwrite", "nodes", nodeName, createdAtLbl, verLbl, commitLbl, profileNameLbl, primaryLbl}
+	// labels requested by the user for this node
+	keys := slices.Sorted(maps.Keys(n.Labels))
+	for _, key := range keys {
+		args = append(args, key+"="+n.Labels[key])
+	}
+	cmd := exec.CommandContext(ctx, "sudo", args...)
 	if _, err := k.c.RunCmd(cmd); err != nil {
 		if ctx.Err() == context.DeadlineExceeded {
 			return fmt.Errorf("timeout apply node labels: %w", err)
@@ -1099,6 +1237,21 @@ func (k *Bootstrapper) labelAndUntaintNode(cfg config.ClusterConfig, n config.No
 		}
 	}
 
+	// taints requested by the user for this node
+	if len(n.Taints) > 0 {
+		// example:
+		// sudo /var/lib/minikube/binaries/<version>/kubectl --kubeconfig=/var/lib/minikube/kubeconfig taint --overwrite nodes test-357-m02 gpu=true:NoSchedule
+		args := []string{kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
+			"taint", "--overwrite", "nodes", nodeName}
+		cmd := exec.CommandContext(ctx, "sudo", append(args, n.Taints...)...)
+		if _, err := k.c.RunCmd(cmd); err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("timeout apply node taints: %w", err)
+			}
+			return fmt.Errorf("apply node taints: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaos injects reversible faults into the nodes of a cluster, for resilience testing
+package chaos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/libmachine"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// The reversible faults
+const (
+	// NodeKill kills the machine of the node, reverted by starting it again
+	NodeKill = "node-kill"
+	// NodePause stops the kubelet and pauses all the containers of the node
+	NodePause = "node-pause"
+	// NetworkPartition drops the traffic between the node and the other nodes
+	NetworkPartition = "network-partition"
+	// DiskFill fills the disk of the node up to a percentage
+	DiskFill = "disk-fill"
+)
+
+// Fault is a fault injected into a node, which stays until it is reverted
+type Fault struct {
+	Action string
+	// Node is the name of the node in the cluster config
+	Node  string
+	Since time.Time
+	// Percent is how full DiskFill makes the disk
+	Percent int `json:",omitempty"`
+}
+
+// Target is the node a fault is injected into
+type Target struct {
+	API     libmachine.API
+	Config  *config.ClusterConfig
+	Node    config.Node
+	Options *run.CommandOptions
+}
+
+func stateFile(profile string) string {
+	return filepath.Join(localpath.Profile(profile), "chaos.json")
+}
+
+// Faults returns the faults injected into the cluster which have not been reverted yet
+func Faults(profile string) ([]Fault, error) {
+	data, err := os.ReadFile(stateFile(profile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var faults []Fault
+	return faults, json.Unmarshal(data, &faults)
+}
+
+func saveFaults(profile string, faults []Fault) error {
+	if len(faults) == 0 {
+		if err := os.Remove(stateFile(profile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(faults, "", "    ")
+	if err != nil {
+		return err
+	}
+	return lock.WriteFile(stateFile(profile), data, 0600)
+}
+
+// record adds the fault to the faults of the cluster, replacing the same fault of the same node
+func record(profile string, f Fault) error {
+	faults, err := Faults(profile)
+	if err != nil {
+		return err
+	}
+	faults = slices.DeleteFunc(faults, func(o Fault) bool { return o.Action == f.Action && o.Node == f.Node })
+	return saveFaults(profile, append(faults, f))
+}
+
+// forget removes the fault from the faults of the cluster
+func forget(profile string, f Fault) error {
+	faults, err := Faults(profile)
+	if err != nil {
+		return err
+	}
+	return saveFaults(profile, slices.DeleteFunc(faults, func(o Fault) bool { return o.Action == f.Action && o.Node == f.Node }))
+}
+
+// Inject injects the fault into the node and records it, so that it can be reverted later on
+func Inject(t Target, f Fault) error {
+	klog.Infof("injecting %s into node %q", f.Action, f.Node)
+	if err := inject(t, f); err != nil {
+		return fmt.Errorf("%s: %w", f.Action, err)
+	}
+	return record(t.Config.Name, f)
+}
+
+// Revert reverts the fault and forgets about it
+func Revert(t Target, f Fault) error {
+	klog.Infof("reverting %s of node %q", f.Action, f.Node)
+	if err := revert(t, f); err != nil {
+		return fmt.Errorf("revert %s: %w", f.Action, err)
+	}
+	return forget(t.Config.Name, f)
+}
+
+func inject(t Target, f Fault) error {
+	if f.Action == NodeKill {
+		h, err := machine.LoadHost(t.API, config.MachineName(*t.Config, t.Node))
+		if err != nil {
+			return fmt.Errorf("load host: %w", err)
+		}
+		return h.Driver.Kill()
+	}
+
+	r, cr, err := runtime(t)
+	if err != nil {
+		return err
+	}
+	switch f.Action {
+	case NodePause:
+		_, err = cluster.Pause(cr, r, nil)
+		return err
+	case NetworkPartition:
+		cidrs, err := podCIDRs(t.Config.Name)
+		if err != nil {
+			// the pods of the other nodes stay reachable, but the nodes themselves do not
+			klog.Warningf("unable to get the pod CIDRs of the nodes: %v", err)
+		}
+		return Partition(r, peers(*t.Config, t.Node, cidrs))
+	case DiskFill:
+		_, err = FillDisk(r, f.Percent)
+		return err
+	}
+	return fmt.Errorf("unknown fault %q", f.Action)
+}
+
+func revert(t Target, f Fault) error {
+	if f.Action == NodeKill {
+		machineName := config.MachineName(*t.Config, t.Node)
+		if machine.IsRunning(t.API, machineName) {
+			return nil
+		}
+		r, p, m, h, err := node.Provision(t.Config, &t.Node, false, t.Options)
+		if err != nil {
+			return fmt.Errorf("provision: %w", err)
+		}
+		_, err = node.Start(node.Starter{Runner: r, PreExists: p, MachineAPI: m, Host: h, Cfg: t.Config, Node: &t.Node, ExistingAddons: t.Config.Addons}, t.Options)
+		return err
+	}
+
+	r, cr, err := runtime(t)
+	if err != nil {
+		return err
+	}
+	switch f.Action {
+	case NodePause:
+		_, err = cluster.Unpause(cr, r, nil)
+		return err
+	case NetworkPartition:
+		return Heal(r)
+	case DiskFill:
+		return FreeDisk(r)
+	}
+	return fmt.Errorf("unknown fault %q", f.Action)
+}
+
+// runtime returns the command runner and the container runtime of the node
+func runtime(t Target) (command.Runner, cruntime.Manager, error) {
+	h, err := machine.LoadHost(t.API, config.MachineName(*t.Config, t.Node))
+	if err != nil {
+		return nil, nil, fmt.Errorf("load host: %w", err)
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return nil, nil, fmt.Errorf("command runner: %w", err)
+	}
+	cr, err := cruntime.New(cruntime.Config{Type: t.Config.KubernetesConfig.ContainerRuntime, Runner: r})
+	if err != nil {
+		return nil, nil, fmt.Errorf("container runtime: %w", err)
+	}
+	return r, cr, nil
+}
+
+// RestartAPIServer stops the kube-apiserver container of the control-plane node, which the kubelet then starts again
+func RestartAPIServer(t Target) error {
+	_, cr, err := runtime(t)
+	if err != nil {
+		return err
+	}
+	ids, err := cr.ListContainers(cruntime.ListContainersOptions{State: cruntime.Running, Name: "kube-apiserver"})
+	if err != nil {
+		return fmt.Errorf("list kube-apiserver containers: %w", err)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("no kube-apiserver container is running on node %q", config.MachineName(*t.Config, t.Node))
+	}
+	return cr.StopContainers(ids)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+func TestRecordAndForget(t *testing.T) {
+	tests.MakeTempDir(t)
+	if err := os.MkdirAll(localpath.Profile("p1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	faults, err := Faults("p1")
+	if err != nil || len(faults) != 0 {
+		t.Fatalf("Faults() = %v, %v, want none", faults, err)
+	}
+
+	since := time.Now().Truncate(time.Second)
+	for _, f := range []Fault{
+		{Action: NodePause, Node: "m02", Since: since},
+		{Action: DiskFill, Node: "", Since: since, Percent: 80},
+		{Action: DiskFill, Node: "", Since: since, Percent: 90},
+	} {
+		if err := record("p1", f); err != nil {
+			t.Fatalf("record(%v): %v", f, err)
+		}
+	}
+	faults, err = Faults("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(faults) != 2 || faults[1].Percent != 90 || !faults[0].Since.Equal(since) {
+		t.Errorf("Faults() = %+v, want the pause of m02 and the latest disk fill", faults)
+	}
+
+	if err := forget("p1", Fault{Action: NodePause, Node: "m02"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := forget("p1", Fault{Action: DiskFill}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(stateFile("p1")); !os.IsNotExist(err) {
+		t.Errorf("state file should be removed with the last fault, got: %v", err)
+	}
+}
+
+func TestPeers(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name: "p1",
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.49.2"},
+			{Name: "m02", IP: "192.168.49.3"},
+			{Name: "m03", IP: "192.168.49.4"},
+		},
+	}
+	if got, want := peers(cc, cc.Nodes[1], nil), []string{"192.168.49.2", "192.168.49.4"}; !slices.Equal(got, want) {
+		t.Errorf("peers() = %v, want %v", got, want)
+	}
+
+	cc.KubernetesConfig.APIServerHAVIP = "192.168.49.254"
+	if got, want := peers(cc, cc.Nodes[0], nil), []string{"192.168.49.3", "192.168.49.4", "192.168.49.254"}; !slices.Equal(got, want) {
+		t.Errorf("peers() = %v, want %v", got, want)
+	}
+
+	cidrs := map[string][]string{
+		"p1":     {"10.244.0.0/24"},
+		"p1-m02": {"10.244.1.0/24"},
+		"p1-m03": {"10.244.2.0/24"},
+	}
+	if got, want := peers(cc, cc.Nodes[1], cidrs), []string{"192.168.49.2", "10.244.0.0/24", "192.168.49.4", "10.244.2.0/24", "192.168.49.254"}; !slices.Equal(got, want) {
+		t.Errorf("peers() with pod CIDRs = %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{
+		"sudo iptables -N MINIKUBE-CHAOS":                         "",
+		"sudo iptables -A MINIKUBE-CHAOS -s 192.168.49.3 -j DROP": "",
+		"sudo iptables -A MINIKUBE-CHAOS -d 192.168.49.3 -j DROP": "",
+		"sudo iptables -I INPUT -j MINIKUBE-CHAOS":                "",
+		"sudo iptables -I OUTPUT -j MINIKUBE-CHAOS":               "",
+		"sudo iptables -I FORWARD -j MINIKUBE-CHAOS":              "",
+	})
+	// the chain does not exist yet, so there is nothing to heal first
+	if err := Partition(r, []string{"192.168.49.3"}); err != nil {
+		t.Errorf("Partition: %v", err)
+	}
+	if err := Partition(r, nil); err == nil {
+		t.Error("expected an error partitioning a node without peers")
+	}
+}
+
+func TestHeal(t *testing.T) {
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{
+		"sudo iptables -n -L MINIKUBE-CHAOS":         "Chain MINIKUBE-CHAOS (3 references)",
+		"sudo iptables -D INPUT -j MINIKUBE-CHAOS":   "",
+		"sudo iptables -D OUTPUT -j MINIKUBE-CHAOS":  "",
+		"sudo iptables -D FORWARD -j MINIKUBE-CHAOS": "",
+		"sudo iptables -F MINIKUBE-CHAOS":            "",
+		"sudo iptables -X MINIKUBE-CHAOS":            "",
+	})
+	if err := Heal(r); err != nil {
+		t.Errorf("Heal: %v", err)
+	}
+}
+
+func TestFillDisk(t *testing.T) {
+	df := `Filesystem     1024-blocks     Used Available Capacity Mounted on
+/dev/vda1         10000000  6000000   4000000      60% /var
+`
+	tcs := []struct {
+		percent int
+		want    int64
+	}{
+		{percent: 90, want: 3000000 * 1024},
+		{percent: 50, want: 0},
+	}
+	for _, tc := range tcs {
+		r := command.NewFakeCommandRunner()
+		r.SetCommandToOutput(map[string]string{
+			"sudo rm -f /var/lib/minikube-chaos-fill": "",
+			"df -Pk /var": df,
+			"sudo fallocate -l 3072000000 /var/lib/minikube-chaos-fill": "",
+		})
+		got, err := FillDisk(r, tc.percent)
+		if err != nil {
+			t.Errorf("FillDisk(%d): %v", tc.percent, err)
+		}
+		if got != tc.want {
+			t.Errorf("FillDisk(%d) = %d, want %d", tc.percent, got, tc.want)
+		}
+	}
+
+	if _, err := FillDisk(command.NewFakeCommandRunner(), 101); err == nil {
+		t.Error("expected an error filling a disk over 100%")
+	}
+}
+
+func TestParseDF(t *testing.T) {
+	if _, _, err := parseDF("Filesystem 1024-blocks Used\n"); err == nil {
+		t.Error("expected an error parsing df output without filesystems")
+	}
+	if _, _, err := parseDF("Filesystem 1024-blocks Used\n/dev/vda1 lots 12\n"); err == nil {
+		t.Error("expected an error parsing a non-numeric size")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+// fillFile takes up the space of a disk fill, on the filesystem holding the images and volumes of the node
+const fillFile = "/var/lib/minikube-chaos-fill"
+
+// FillDisk allocates a file until the filesystem of /var is percent full, and returns its size in bytes.
+// Nothing is allocated if the filesystem is already that full.
+func FillDisk(r command.Runner, percent int) (int64, error) {
+	if percent <= 0 || percent > 100 {
+		return 0, fmt.Errorf("percent must be between 1 and 100, got %d", percent)
+	}
+	// start over, so that the file does not count as used space
+	if err := FreeDisk(r); err != nil {
+		return 0, err
+	}
+
+	rr, err := r.RunCmd(exec.Command("df", "-Pk", "/var"))
+	if err != nil {
+		return 0, fmt.Errorf("df: %w", err)
+	}
+	size, used, err := parseDF(rr.Stdout.String())
+	if err != nil {
+		return 0, err
+	}
+
+	fill := size*int64(percent)/100 - used
+	if fill <= 0 {
+		return 0, nil
+	}
+	if _, err := r.RunCmd(exec.Command("sudo", "fallocate", "-l", strconv.FormatInt(fill, 10), fillFile)); err != nil {
+		return 0, fmt.Errorf("fallocate: %w", err)
+	}
+	return fill, nil
+}
+
+// parseDF returns the size and used space in bytes of the filesystem in the POSIX output of df -Pk
+func parseDF(output string) (int64, int64, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) < 2 {
+		return 0, 0, fmt.Errorf("unexpected df output: %q", output)
+	}
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 3 {
+		return 0, 0, fmt.Errorf("unexpected df output: %q", output)
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse df size: %w", err)
+	}
+	used, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse df used: %w", err)
+	}
+	return size * 1024, used * 1024, nil
+}
+
+// FreeDisk removes the file allocated by FillDisk
+func FreeDisk(r command.Runner) error {
+	if _, err := r.RunCmd(exec.Command("sudo", "rm", "-f", fillFile)); err != nil {
+		return fmt.Errorf("remove %s: %w", fillFile, err)
+	}
+	return nil
+}
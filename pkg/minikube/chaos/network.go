@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+)
+
+// chain holds the rules of a network partition, so that they can be removed at once
+const chain = "MINIKUBE-CHAOS"
+
+// podCIDRs returns the pod CIDRs of the Kubernetes nodes of the cluster by node name.
+// The CNI routes the pods natively, so their traffic does not carry the IPs of the nodes.
+func podCIDRs(profile string) (map[string][]string, error) {
+	client, err := kapi.Client(profile)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	nodes, err := client.CoreV1().Nodes().List(context.Background(), meta.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+	cidrs := map[string][]string{}
+	for _, n := range nodes.Items {
+		all := n.Spec.PodCIDRs
+		if len(all) == 0 && n.Spec.PodCIDR != "" {
+			all = []string{n.Spec.PodCIDR}
+		}
+		for _, c := range all {
+			// the partition is made of iptables rules, which only match IPv4
+			if ip, _, err := net.ParseCIDR(c); err == nil && ip.To4() != nil {
+				cidrs[n.Name] = append(cidrs[n.Name], c)
+			}
+		}
+	}
+	return cidrs, nil
+}
+
+// peers returns the addresses the node is cut off from by a network partition: the IPs and the pod CIDRs of the other nodes,
+// and the virtual IP of the API server of HA clusters. The host stays reachable, so the node can still be managed.
+func peers(cc config.ClusterConfig, n config.Node, cidrs map[string][]string) []string {
+	var ips []string
+	for _, o := range cc.Nodes {
+		if o.Name == n.Name {
+			continue
+		}
+		ip := o.IP
+		if driver.IsKIC(cc.Driver) {
+			// the containers may have got new addresses since the config was saved
+			live, _, err := oci.ContainerIPs(cc.Driver, config.MachineName(cc, o))
+			if err != nil {
+				klog.Warningf("unable to get the IP of %s, using %q: %v", o.Name, ip, err)
+			} else if live != "" {
+				ip = live
+			}
+		}
+		if ip != "" {
+			ips = append(ips, ip)
+		}
+		ips = append(ips, cidrs[config.MachineName(cc, o)]...)
+	}
+	if vip := cc.KubernetesConfig.APIServerHAVIP; vip != "" {
+		ips = append(ips, vip)
+	}
+	return ips
+}
+
+func iptables(r command.Runner, args ...string) error {
+	if _, err := r.RunCmd(exec.Command("sudo", append([]string{"iptables"}, args...)...)); err != nil {
+		return fmt.Errorf("iptables: %w", err)
+	}
+	return nil
+}
+
+// Partition drops all the traffic between the node, including its pods, and the peer addresses, which may be CIDRs
+func Partition(r command.Runner, peers []string) error {
+	if len(peers) == 0 {
+		return fmt.Errorf("the node has no peers to be cut off from")
+	}
+	// start over, so that partitioning a node twice does not stack up rules
+	if err := Heal(r); err != nil {
+		return err
+	}
+	if err := iptables(r, "-N", chain); err != nil {
+		return err
+	}
+	for _, p := range peers {
+		if err := iptables(r, "-A", chain, "-s", p, "-j", "DROP"); err != nil {
+			return err
+		}
+		if err := iptables(r, "-A", chain, "-d", p, "-j", "DROP"); err != nil {
+			return err
+		}
+	}
+	for _, c := range []string{"INPUT", "OUTPUT", "FORWARD"} {
+		if err := iptables(r, "-I", c, "-j", chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Heal removes the rules of a network partition, if any
+func Heal(r command.Runner) error {
+	if err := iptables(r, "-n", "-L", chain); err != nil {
+		// no partition
+		return nil
+	}
+	for _, c := range []string{"INPUT", "OUTPUT", "FORWARD"} {
+		if err := iptables(r, "-D", c, "-j", chain); err != nil {
+			return err
+		}
+	}
+	if err := iptables(r, "-F", chain); err != nil {
+		return err
+	}
+	return iptables(r, "-X", chain)
+}
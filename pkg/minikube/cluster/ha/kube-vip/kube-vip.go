@@ -31,6 +31,9 @@ import (
 
 const Manifest = "kube-vip.yaml"
 
+// LeaseName is the name of the lease in kube-system held by the kube-vip instance owning the VIP, as set with vip_leasename
+const LeaseName = "plndr-cp-lock"
+
 // KubeVipTemplate is kube-vip static pod config template
 // ref: https://kube-vip.io/docs/installation/static/#generating-a-manifest
 // note: to check if the latest kube-vip version introduces any significant changes, compare the current one with a latest default manifest generated with:
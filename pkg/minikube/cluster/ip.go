@@ -22,6 +22,7 @@ import (
 	"os/exec"
 	"reflect"
 	"regexp"
+	"runtime"
 	"strings"
 
 	"errors"
@@ -144,6 +145,20 @@ func HostIP(hostInfo *host.Host, clusterName string) (net.IP, error) {
 	}
 }
 
+// ListenIP returns the address for the host to listen on for the nodes to reach it at host.minikube.internal: the gateway of the network,
+// or the loopback address where the driver forwards the connections to the gateway to the loopback interface of the host
+func ListenIP(hostInfo *host.Host, clusterName string) (net.IP, error) {
+	ip, err := HostIP(hostInfo, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	// Docker Desktop forwards host.docker.internal, and the qemu user network forwards 10.0.2.2, to the loopback interface
+	if (driver.IsKIC(hostInfo.DriverName) && runtime.GOOS != "linux") || ip.Equal(net.ParseIP("10.0.2.2")) {
+		return net.IPv4(127, 0, 0, 1), nil
+	}
+	return ip, nil
+}
+
 // DriverIP gets the ip address of the current minikube cluster
 func DriverIP(api libmachine.API, machineName string) (net.IP, error) {
 	hostInfo, err := machine.LoadHost(api, machineName)
@@ -19,24 +19,78 @@ package cluster
 import (
 	"fmt"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"runtime"
 	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/util/lock"
 )
 
+const (
+	// MountType9P serves the host directory to the nodes with the 9p server of minikube
+	MountType9P = "9p"
+	// MountTypeVirtiofs shares the host directory with virtiofs devices, attached to the VM when it starts
+	MountTypeVirtiofs = virtiofs.MountType
+	// MountTypeNFS exports the host directory to the nodes with the NFS server of the host
+	MountTypeNFS = "nfs"
+	// MountTypeBind bind mounts a host directory that is already shared with the node, such as a volume of the container
+	MountTypeBind = "bind"
+)
+
+// MountTypes returns the mount types supported by a driver
+func MountTypes(driverName string) []string {
+	switch {
+	case driver.BareMetal(driverName):
+		return nil
+	case driver.IsKIC(driverName):
+		return []string{MountType9P, MountTypeNFS, MountTypeBind}
+	case driver.SupportsVirtiofsMounts(driverName) || driver.IsKVM(driverName):
+		return []string{MountType9P, MountTypeNFS, MountTypeVirtiofs}
+	case driver.IsQEMU(driverName) && runtime.GOOS == "linux":
+		// virtiofsd only runs on linux
+		return []string{MountType9P, MountTypeNFS, MountTypeVirtiofs}
+	default:
+		return []string{MountType9P, MountTypeNFS}
+	}
+}
+
+// ValidateMountType returns an error if the driver does not support the mount type
+func ValidateMountType(driverName, mountType string) error {
+	types := MountTypes(driverName)
+	if !slices.Contains(types, mountType) {
+		return fmt.Errorf("the %s driver does not support %q mounts, supported types: %s", driverName, mountType, strings.Join(types, ", "))
+	}
+	if mountType == MountTypeNFS && runtime.GOOS == "windows" {
+		return fmt.Errorf("nfs mounts need the NFS server of a linux or macOS host")
+	}
+	return nil
+}
+
+// SharedPath returns the path in the node of hostPath when it is inside of sharedHost, a host directory shared
+// with the node at sharedNode, such as a volume of the container or a virtiofs device of the VM
+func SharedPath(sharedHost, sharedNode, hostPath string) (string, bool) {
+	rel, err := filepath.Rel(sharedHost, hostPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return "", false
+	}
+	return path.Join(sharedNode, filepath.ToSlash(rel)), true
+}
+
 // MountConfig defines the options available to the Mount command
 type MountConfig struct {
-	// Type is the filesystem type (Typically 9p)
+	// Type is the filesystem type: 9p, virtiofs, nfs or bind
 	Type string
 	// UID is the User ID which this path will be mounted as
 	UID string
@@ -78,7 +132,8 @@ func (m *MountError) Error() string {
 	return m.UnderlyingError.Error()
 }
 
-// Mount runs the mount command from the 9p client on the VM to the 9p server on the host
+// Mount mounts source at target in the node with the mount type of the config: the 9p server address for 9p,
+// the host:path export for nfs, the tag for virtiofs and the node path for bind. pid is the process serving the mount, if any.
 func Mount(r mountRunner, source string, target string, c *MountConfig, pid int) error {
 	if err := Unmount(r, target); err != nil {
 		return &MountError{ErrorType: MountErrorUnknown, UnderlyingError: fmt.Errorf("umount: %w", err)}
@@ -96,10 +151,17 @@ func Mount(r mountRunner, source string, target string, c *MountConfig, pid int)
 		return &MountError{ErrorType: MountErrorUnknown, UnderlyingError: fmt.Errorf("mount with cmd %s : %w", rr.Command(), err)}
 	}
 
+	if pid == 0 {
+		klog.Infof("mount successful: %q", rr.Output())
+		return nil
+	}
 	profile := viper.GetString("profile")
 	if err := lock.AppendToFile(filepath.Join(localpath.Profile(profile), constants.MountProcessFileName), []byte(fmt.Sprintf(" %s", strconv.Itoa(pid))), 0o644); err != nil {
 		exit.Error(reason.HostMountPid, "Error writing mount pid", err)
 	}
+	if err := SetMountPID(profile, target, pid); err != nil {
+		exit.Error(reason.HostMountPid, "Error writing mount pid", err)
+	}
 
 	klog.Infof("mount successful: %q", rr.Output())
 	return nil
@@ -134,20 +196,26 @@ func resolveGID(id string) string {
 
 // mntCmd returns a mount command based on a config.
 func mntCmd(source string, target string, c *MountConfig) string {
-	options := map[string]string{
-		"dfltgid": resolveGID(c.GID),
-		"dfltuid": resolveUID(c.UID),
-		"trans":   "tcp",
-	}
-
-	if c.Port != 0 {
-		options["port"] = strconv.Itoa(c.Port)
-	}
-	if c.Version != "" {
-		options["version"] = c.Version
-	}
-	if c.MSize != 0 {
-		options["msize"] = strconv.Itoa(c.MSize)
+	options := map[string]string{}
+	switch c.Type {
+	case MountTypeNFS:
+		// the NFS server of macOS only speaks v3, and the nodes do not run the lock daemon
+		options["vers"] = "3"
+		options["nolock"] = ""
+	case MountTypeVirtiofs, MountTypeBind:
+	default:
+		options["dfltgid"] = resolveGID(c.GID)
+		options["dfltuid"] = resolveUID(c.UID)
+		options["trans"] = "tcp"
+		if c.Port != 0 {
+			options["port"] = strconv.Itoa(c.Port)
+		}
+		if c.Version != "" {
+			options["version"] = c.Version
+		}
+		if c.MSize != 0 {
+			options["msize"] = strconv.Itoa(c.MSize)
+		}
 	}
 
 	// Copy in all of the user-supplied keys and values
@@ -166,6 +234,15 @@ func mntCmd(source string, target string, c *MountConfig) string {
 		opts = append(opts, fmt.Sprintf("%s=%s", k, v))
 	}
 	slices.Sort(opts)
+	if c.Type == MountTypeBind {
+		if len(opts) == 0 {
+			return fmt.Sprintf("sudo mount --bind %s %s", source, target)
+		}
+		return fmt.Sprintf("sudo mount --bind -o %s %s %s", strings.Join(opts, ","), source, target)
+	}
+	if len(opts) == 0 {
+		return fmt.Sprintf("sudo mount -t %s %s %s", c.Type, source, target)
+	}
 	return fmt.Sprintf("sudo mount -t %s -o %s %s %s", c.Type, strings.Join(opts, ","), source, target)
 }
 
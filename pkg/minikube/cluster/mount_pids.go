@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// mountPIDsPath returns the path of the file of the processes serving the mounts of a profile
+func mountPIDsPath(profile string) string {
+	return filepath.Join(localpath.Profile(profile), constants.MountPIDsFileName)
+}
+
+// MountPIDs returns the processes serving the mounts of a profile, by node path
+func MountPIDs(profile string) (map[string]int, error) {
+	pids := map[string]int{}
+	data, err := os.ReadFile(mountPIDsPath(profile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return pids, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &pids); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", mountPIDsPath(profile), err)
+	}
+	return pids, nil
+}
+
+// SetMountPID records pid as the process serving the mount at target
+func SetMountPID(profile, target string, pid int) error {
+	return updateMountPIDs(profile, func(pids map[string]int) {
+		pids[target] = pid
+	})
+}
+
+// RemoveMountPID forgets the process serving the mount at target
+func RemoveMountPID(profile, target string) error {
+	return updateMountPIDs(profile, func(pids map[string]int) {
+		delete(pids, target)
+	})
+}
+
+// updateMountPIDs updates the processes serving the mounts of a profile, holding the lock of the file
+// so that the mount processes started together do not lose each other's updates
+func updateMountPIDs(profile string, update func(map[string]int)) error {
+	path := mountPIDsPath(profile)
+	releaser, err := lock.Acquire(lock.PathMutexSpec(path))
+	if err != nil {
+		return fmt.Errorf("failed to acquire lock for %s: %w", path, err)
+	}
+	defer releaser.Release()
+
+	pids, err := MountPIDs(profile)
+	if err != nil {
+		return err
+	}
+	update(pids)
+	data, err := json.Marshal(pids)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestMountPIDs(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	if err := os.MkdirAll(localpath.Profile("p1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	pids, err := MountPIDs("p1")
+	if err != nil {
+		t.Fatalf("MountPIDs without a file: %v", err)
+	}
+	if len(pids) != 0 {
+		t.Errorf("MountPIDs without a file = %v, want none", pids)
+	}
+
+	for target, pid := range map[string]int{"/src/api": 10, "/src/web": 11} {
+		if err := SetMountPID("p1", target, pid); err != nil {
+			t.Fatalf("SetMountPID(%s): %v", target, err)
+		}
+	}
+	if err := SetMountPID("p1", "/src/api", 12); err != nil {
+		t.Fatalf("SetMountPID again: %v", err)
+	}
+	if err := RemoveMountPID("p1", "/src/web"); err != nil {
+		t.Fatalf("RemoveMountPID: %v", err)
+	}
+
+	pids, err = MountPIDs("p1")
+	if err != nil {
+		t.Fatalf("MountPIDs: %v", err)
+	}
+	if diff := cmp.Diff(map[string]int{"/src/api": 12}, pids); diff != "" {
+		t.Errorf("MountPIDs diff (-want +got): %s", diff)
+	}
+}
@@ -63,6 +63,34 @@ func TestMntCmd(t *testing.T) {
 			}},
 			want: "sudo mount -t 9p -o dfltgid=0,dfltuid=0,trans=tcp,version=9p2000.L src tgt",
 		},
+		{
+			name:   "nfs",
+			source: "10.0.0.1:/Users/me/src",
+			target: "/src",
+			cfg:    &MountConfig{Type: "nfs", UID: "docker", GID: "docker", Port: 1234, Options: map[string]string{"vers": "4"}},
+			want:   "sudo mount -t nfs -o nolock,vers=4 10.0.0.1:/Users/me/src /src",
+		},
+		{
+			name:   "virtiofs",
+			source: "tag",
+			target: "/src",
+			cfg:    &MountConfig{Type: "virtiofs", UID: "docker", MSize: 1024},
+			want:   "sudo mount -t virtiofs tag /src",
+		},
+		{
+			name:   "bind",
+			source: "/minikube-host/src",
+			target: "/src",
+			cfg:    &MountConfig{Type: "bind"},
+			want:   "sudo mount --bind /minikube-host/src /src",
+		},
+		{
+			name:   "bind-options",
+			source: "/minikube-host/src",
+			target: "/src",
+			cfg:    &MountConfig{Type: "bind", Options: map[string]string{"ro": ""}},
+			want:   "sudo mount --bind -o ro /minikube-host/src /src",
+		},
 	}
 
 	for _, tc := range tests {
@@ -75,3 +103,53 @@ func TestMntCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestSharedPath(t *testing.T) {
+	var tests = []struct {
+		name     string
+		hostPath string
+		want     string
+		ok       bool
+	}{
+		{name: "root", hostPath: "/home/me", want: "/minikube-host", ok: true},
+		{name: "nested", hostPath: "/home/me/src/app", want: "/minikube-host/src/app", ok: true},
+		{name: "unclean", hostPath: "/home/me/src/../bin", want: "/minikube-host/bin", ok: true},
+		{name: "outside", hostPath: "/home/other", ok: false},
+		{name: "prefix", hostPath: "/home/meme", ok: false},
+		{name: "parent", hostPath: "/home", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := SharedPath("/home/me", "/minikube-host", tc.hostPath)
+			if ok != tc.ok || got != tc.want {
+				t.Errorf("SharedPath(%q) = %q, %v; want %q, %v", tc.hostPath, got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestValidateMountType(t *testing.T) {
+	var tests = []struct {
+		driver    string
+		mountType string
+		ok        bool
+	}{
+		{driver: "docker", mountType: "9p", ok: true},
+		{driver: "docker", mountType: "bind", ok: true},
+		{driver: "docker", mountType: "virtiofs", ok: false},
+		{driver: "kvm2", mountType: "virtiofs", ok: true},
+		{driver: "kvm2", mountType: "bind", ok: false},
+		{driver: "none", mountType: "9p", ok: false},
+		{driver: "hyperkit", mountType: "xfs", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.driver+"/"+tc.mountType, func(t *testing.T) {
+			err := ValidateMountType(tc.driver, tc.mountType)
+			if (err == nil) != tc.ok {
+				t.Errorf("ValidateMountType(%q, %q) = %v, want ok: %v", tc.driver, tc.mountType, err, tc.ok)
+			}
+		})
+	}
+}
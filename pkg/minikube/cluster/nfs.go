@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// nfsExportsFile is the exports file of the NFS server of the host
+const nfsExportsFile = "/etc/exports"
+
+// runHostCmd runs a command on the host, leaving it the terminal to prompt for the sudo password
+var runHostCmd = func(stdin []byte, args ...string) error {
+	cmd := exec.Command(args[0], args[1:]...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	} else {
+		cmd.Stdin = os.Stdin
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	klog.Infof("Run: %v", cmd.Args)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %v\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+	return nil
+}
+
+// NFSExportID returns the identifier of the export of a host directory for a profile
+func NFSExportID(profile, hostPath string) string {
+	return fmt.Sprintf("minikube %s %s", profile, hostPath)
+}
+
+// ExportNFS exports the host directory to the client IP with the NFS server of the host, as the current user.
+// The export is identified by id, so that UnexportNFS can remove it.
+func ExportNFS(id, hostPath, clientIP string) error {
+	line := nfsExportLine(runtime.GOOS, hostPath, clientIP, os.Getuid(), os.Getgid())
+	return updateNFSExports(func(exports string) string {
+		return addNFSExport(removeNFSExport(exports, id), id, line)
+	})
+}
+
+// UnexportNFS removes the export identified by id from the NFS server of the host
+func UnexportNFS(id string) error {
+	return updateNFSExports(func(exports string) string {
+		return removeNFSExport(exports, id)
+	})
+}
+
+// UnexportProfileNFS removes every export of the profile from the NFS server of the host,
+// which the mount processes leave behind when they are killed
+func UnexportProfileNFS(profile string) error {
+	return updateNFSExports(func(exports string) string {
+		return removeProfileNFSExports(exports, profile)
+	})
+}
+
+// updateNFSExports rewrites the exports file with update, and reloads the NFS server of the host.
+// The exports file is locked against the other minikube processes, which mount concurrently for any profile.
+func updateNFSExports(update func(string) string) error {
+	spec := lock.PathMutexSpec(nfsExportsFile)
+	// leave time to enter the sudo password to the process holding the lock
+	spec.Timeout = 5 * time.Minute
+	klog.Infof("acquiring lock for %s: %+v", nfsExportsFile, spec)
+	releaser, err := lock.Acquire(spec)
+	if err != nil {
+		return fmt.Errorf("acquire lock for %s %+v: %w", nfsExportsFile, spec, err)
+	}
+	defer releaser.Release()
+
+	data, err := os.ReadFile(nfsExportsFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	exports := update(string(data))
+	if exports == string(data) {
+		return nil
+	}
+	if err := runHostCmd([]byte(exports), "sudo", "tee", nfsExportsFile); err != nil {
+		return fmt.Errorf("writing %s: %w", nfsExportsFile, err)
+	}
+	if runtime.GOOS == "darwin" {
+		if err := runHostCmd(nil, "sudo", "nfsd", "checkexports"); err != nil {
+			return fmt.Errorf("invalid NFS exports: %w", err)
+		}
+		if err := runHostCmd(nil, "sudo", "nfsd", "update"); err != nil {
+			return fmt.Errorf("reloading the NFS server: %w", err)
+		}
+		return nil
+	}
+	if err := runHostCmd(nil, "sudo", "exportfs", "-ra"); err != nil {
+		return fmt.Errorf("reloading the NFS server, is it installed and running? %w", err)
+	}
+	return nil
+}
+
+// nfsExportLine returns the line of the exports file sharing the host directory with the client IP,
+// mapping all the accesses to the user and group of the host
+func nfsExportLine(goos, hostPath, clientIP string, uid, gid int) string {
+	if goos == "darwin" {
+		return fmt.Sprintf("%q -alldirs -mapall=%d:%d %s", hostPath, uid, gid, clientIP)
+	}
+	return fmt.Sprintf("%q %s(rw,sync,no_subtree_check,insecure,all_squash,anonuid=%d,anongid=%d)", hostPath, clientIP, uid, gid)
+}
+
+// addNFSExport returns the exports with the export line, between markers of the identifier
+func addNFSExport(exports, id, line string) string {
+	if exports != "" && !strings.HasSuffix(exports, "\n") {
+		exports += "\n"
+	}
+	return exports + fmt.Sprintf("# BEGIN: %s\n%s\n# END: %s\n", id, line, id)
+}
+
+// removeProfileNFSExports returns the exports without the exports of the profile
+func removeProfileNFSExports(exports, profile string) string {
+	prefix := NFSExportID(profile, "")
+	for _, line := range strings.Split(exports, "\n") {
+		if id, ok := strings.CutPrefix(line, "# BEGIN: "); ok && strings.HasPrefix(id, prefix) {
+			exports = removeNFSExport(exports, id)
+		}
+	}
+	return exports
+}
+
+// removeNFSExport returns the exports without the export of the identifier
+func removeNFSExport(exports, id string) string {
+	begin := strings.Index(exports, fmt.Sprintf("# BEGIN: %s\n", id))
+	endMark := fmt.Sprintf("# END: %s\n", id)
+	end := strings.Index(exports, endMark)
+	if begin == -1 || end < begin {
+		return exports
+	}
+	return exports[:begin] + exports[end+len(endMark):]
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+)
+
+func TestNFSExportLine(t *testing.T) {
+	tests := []struct {
+		goos string
+		want string
+	}{
+		{"linux", `"/home/me/src" 192.168.49.2(rw,sync,no_subtree_check,insecure,all_squash,anonuid=1000,anongid=100)`},
+		{"darwin", `"/home/me/src" -alldirs -mapall=1000:100 192.168.49.2`},
+	}
+	for _, tc := range tests {
+		if got := nfsExportLine(tc.goos, "/home/me/src", "192.168.49.2", 1000, 100); got != tc.want {
+			t.Errorf("nfsExportLine(%s) = %s, want %s", tc.goos, got, tc.want)
+		}
+	}
+}
+
+func TestNFSExports(t *testing.T) {
+	existing := "/srv 10.0.0.0/8(ro)"
+	id := NFSExportID("minikube", "/src")
+
+	added := addNFSExport(existing, id, "/src 1.2.3.4(rw)")
+	want := "/srv 10.0.0.0/8(ro)\n# BEGIN: minikube minikube /src\n/src 1.2.3.4(rw)\n# END: minikube minikube /src\n"
+	if added != want {
+		t.Errorf("addNFSExport = %q, want %q", added, want)
+	}
+	other := addNFSExport(added, NFSExportID("other", "/src"), "/src 5.6.7.8(rw)")
+
+	removed := removeNFSExport(other, id)
+	if want := "/srv 10.0.0.0/8(ro)\n# BEGIN: minikube other /src\n/src 5.6.7.8(rw)\n# END: minikube other /src\n"; removed != want {
+		t.Errorf("removeNFSExport = %q, want %q", removed, want)
+	}
+	if got := removeNFSExport(removed, id); got != removed {
+		t.Errorf("removeNFSExport of a missing export changed the exports to %q", got)
+	}
+
+	profile := addNFSExport(other, NFSExportID("minikube", "/data"), "/data 1.2.3.4(rw)")
+	if got := removeProfileNFSExports(profile, "minikube"); got != removed {
+		t.Errorf("removeProfileNFSExports = %q, want %q", got, removed)
+	}
+}
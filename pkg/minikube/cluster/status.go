@@ -38,6 +38,8 @@ import (
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/out/register"
+	pkgpause "k8s.io/minikube/pkg/minikube/pause"
+	"k8s.io/minikube/pkg/minikube/schedule"
 	"k8s.io/minikube/pkg/version"
 )
 
@@ -124,9 +126,15 @@ type Status struct {
 	APIServer  string
 	Kubeconfig string
 	Worker     bool
+	// NodePool is the node pool the node belongs to, if any
+	NodePool   string `json:",omitempty"`
 	TimeToStop string `json:",omitempty"`
 	DockerEnv  string `json:",omitempty"`
 	PodManEnv  string `json:",omitempty"`
+	// AutoPausedSince is set when the auto-pause addon has paused the cluster
+	AutoPausedSince string `json:",omitempty"`
+	// NextSchedule is the next recurring start or stop of the cluster
+	NextSchedule string `json:",omitempty"`
 }
 
 // State holds a cluster state representation
@@ -144,6 +152,7 @@ type State struct {
 // NodeState holds a node state representation
 type NodeState struct {
 	BaseState
+	NodePool   string               `json:",omitempty"`
 	Components map[string]BaseState `json:",omitempty"`
 }
 
@@ -168,7 +177,7 @@ type BaseState struct {
 // GetStatus returns the statuses of each node
 func GetStatus(api libmachine.API, cc *config.ClusterConfig) ([]*Status, error) {
 	var statuses []*Status
-	for _, n := range cc.Nodes {
+	for _, n := range config.NodesByPool(*cc) {
 		machineName := config.MachineName(*cc, n)
 		klog.Infof("checking status of %s ...", machineName)
 		st, err := NodeStatus(api, *cc, n)
@@ -224,6 +233,7 @@ func GetState(sts []*Status, profile string, cc *config.ClusterConfig) State {
 				Name:       st.Name,
 				StatusCode: statusCode(st.Host),
 			},
+			NodePool: st.NodePool,
 			Components: map[string]BaseState{
 				"kubelet": {Name: "kubelet", StatusCode: statusCode(st.Kubelet)},
 			},
@@ -364,6 +374,7 @@ func NodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 		Kubelet:    Nonexistent,
 		Kubeconfig: Nonexistent,
 		Worker:     !controlPlane,
+		NodePool:   n.Pool,
 	}
 
 	hs, err := machine.Status(api, name)
@@ -427,6 +438,9 @@ func NodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 		initiationTime := time.Unix(cc.ScheduledStop.InitiationTime, 0)
 		st.TimeToStop = time.Until(initiationTime.Add(cc.ScheduledStop.Duration)).String()
 	}
+	if s, at := schedule.Next(cc.Schedules, time.Now()); !at.IsZero() {
+		st.NextSchedule = fmt.Sprintf("%s at %s", s.Action, at.Format("Mon 2006-01-02 15:04"))
+	}
 	if os.Getenv(constants.MinikubeActiveDockerdEnv) != "" {
 		st.DockerEnv = "in-use"
 	}
@@ -468,6 +482,15 @@ func NodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 		st.APIServer = sta.String()
 	}
 
+	if cc.Addons["auto-pause"] && st.APIServer == state.Paused.String() {
+		aps, err := pkgpause.QueryAutoPause(cr, constants.AutoPausePort)
+		if err != nil {
+			klog.Warningf("auto-pause status: %v", err)
+		} else if aps.Paused && !aps.PausedSince.IsZero() {
+			st.AutoPausedSince = aps.PausedSince.Local().Format(time.RFC3339)
+		}
+	}
+
 	return st, nil
 }
 
@@ -92,6 +92,22 @@ type Runner interface {
 	ReadableFile(sourcePath string) (assets.ReadableFile, error)
 }
 
+// Streamer is implemented by the runners that can stream the stdin and stdout of a command
+type Streamer interface {
+	// StreamCmd runs a cmd of exec.Cmd type, connecting cmd.Stdin and cmd.Stdout to it as they are,
+	// without buffering or logging them, so that they can carry large binary streams such as archives.
+	StreamCmd(cmd *exec.Cmd) error
+}
+
+// Stream runs the cmd with the runner, streaming its stdin and stdout
+func Stream(r Runner, cmd *exec.Cmd) error {
+	s, ok := r.(Streamer)
+	if !ok {
+		return fmt.Errorf("%T does not support streaming", r)
+	}
+	return s.StreamCmd(cmd)
+}
+
 // Command returns a human readable command string that does not induce eye fatigue
 func (rr RunResult) Command() string {
 	var sb strings.Builder
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -88,6 +89,20 @@ func (e *execRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	return rr, fmt.Errorf("%s: %v\nstdout:\n%s\nstderr:\n%s", rr.Command(), err, rr.Stdout.String(), rr.Stderr.String())
 }
 
+// StreamCmd implements the Command Runner interface to run a exec.Cmd object streaming its stdin and stdout
+func (e *execRunner) StreamCmd(cmd *exec.Cmd) error {
+	klog.Infof("Stream: %v", cmd.Args)
+	if e.sudo && runtime.GOOS != "linux" {
+		return fmt.Errorf("sudo not supported on %s", runtime.GOOS)
+	}
+	var errb bytes.Buffer
+	cmd.Stderr = &errb
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %v\nstderr:\n%s", strings.Join(cmd.Args, " "), err, errb.String())
+	}
+	return nil
+}
+
 // StartCmd implements the Command Runner interface to start a exec.Cmd object
 func (*execRunner) StartCmd(cmd *exec.Cmd) (*StartedCmd, error) {
 	rr := &RunResult{Args: cmd.Args}
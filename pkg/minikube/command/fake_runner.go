@@ -133,6 +133,28 @@ func (f *FakeCommandRunner) StartCmd(cmd *exec.Cmd) (*StartedCmd, error) {
 	return sc, nil
 }
 
+// StreamCmd implements the Command Runner interface to run a exec.Cmd object streaming its stdin and stdout.
+// The stdin it reads is stored as the contents of a file named after the command.
+func (f *FakeCommandRunner) StreamCmd(cmd *exec.Cmd) error {
+	rr, err := f.RunCmd(exec.Command(cmd.Args[0], cmd.Args[1:]...))
+	if err != nil {
+		return err
+	}
+	if cmd.Stdin != nil {
+		var b bytes.Buffer
+		if _, err := io.Copy(&b, cmd.Stdin); err != nil {
+			return fmt.Errorf("reading stdin: %w", err)
+		}
+		f.fileMap.Store(rr.Command(), b.String())
+	}
+	if cmd.Stdout != nil {
+		if _, err := io.Copy(cmd.Stdout, &rr.Stdout); err != nil {
+			return fmt.Errorf("writing stdout: %w", err)
+		}
+	}
+	return nil
+}
+
 // WaitCmd implements the Command Runner interface to wait until a started exec.Cmd object finishes
 func (f *FakeCommandRunner) WaitCmd(sc *StartedCmd) (*RunResult, error) {
 	return sc.rr, nil
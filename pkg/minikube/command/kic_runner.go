@@ -51,6 +51,26 @@ func NewKICRunner(containerNameOrID string, ociName string) Runner {
 	}
 }
 
+// StreamCmd implements the Command Runner interface to run a exec.Cmd object streaming its stdin and stdout
+func (k *kicRunner) StreamCmd(cmd *exec.Cmd) error {
+	args := []string{"exec", "--privileged"}
+	if cmd.Stdin != nil {
+		args = append(args, "-i")
+	}
+	args = append(append(args, k.nameOrID), cmd.Args...)
+	oc := oci.PrefixCmd(exec.Command(k.ociBin, args...))
+	klog.Infof("Stream: %v", oc.Args)
+
+	var errb bytes.Buffer
+	oc.Stdin = cmd.Stdin
+	oc.Stdout = cmd.Stdout
+	oc.Stderr = &errb
+	if err := oc.Run(); err != nil {
+		return fmt.Errorf("%s: %v\nstderr:\n%s", strings.Join(oc.Args, " "), err, errb.String())
+	}
+	return nil
+}
+
 func (k *kicRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	args := []string{
 		"exec",
@@ -236,6 +236,29 @@ func (s *SSHRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	return rr, fmt.Errorf("%s: %v\nstdout:\n%s\nstderr:\n%s", rr.Command(), err, rr.Stdout.String(), rr.Stderr.String())
 }
 
+// StreamCmd implements the Command Runner interface to run a exec.Cmd object streaming its stdin and stdout
+func (s *SSHRunner) StreamCmd(cmd *exec.Cmd) error {
+	klog.Infof("Stream: %v", cmd.Args)
+	sess, err := s.session()
+	if err != nil {
+		return fmt.Errorf("NewSession: %w", err)
+	}
+	defer func() {
+		if err := sess.Close(); err != nil && err != io.EOF {
+			klog.Errorf("session close: %v", err)
+		}
+	}()
+
+	var errb bytes.Buffer
+	sess.Stdin = cmd.Stdin
+	sess.Stdout = cmd.Stdout
+	sess.Stderr = &errb
+	if err := sess.Run(shellquote.Join(cmd.Args...)); err != nil {
+		return fmt.Errorf("%s: %v\nstderr:\n%s", shellquote.Join(cmd.Args...), err, errb.String())
+	}
+	return nil
+}
+
 // teeSSHStart starts a non-blocking SSH command, streaming stdout, stderr to logs
 func teeSSHStart(s *ssh.Session, cmd string, outB io.Writer, errB io.Writer, wg *sync.WaitGroup) error {
 	outPipe, err := s.StdoutPipe()
@@ -57,8 +57,16 @@ const (
 	AddonListFlag = "addons"
 	// EmbedCerts represents the config for embedding certificates in kubeconfig
 	EmbedCerts = "EmbedCerts"
-	// MaxAuditEntries is the maximum number of audit entries to retain
+	// MaxAuditEntries is deprecated and ignored, the audit log is rotated by MaxAuditFileSize and MaxAuditAge instead
 	MaxAuditEntries = "MaxAuditEntries"
+	// MaxAuditFileSize is the size at which the audit log is rotated
+	MaxAuditFileSize = "MaxAuditFileSize"
+	// MaxAuditFiles is the maximum number of rotated audit logs to retain
+	MaxAuditFiles = "MaxAuditFiles"
+	// MaxAuditAge is the maximum age of rotated audit logs to retain
+	MaxAuditAge = "MaxAuditAge"
+	// CompressAuditFiles is the key for compressing rotated audit logs with gzip
+	CompressAuditFiles = "CompressAuditFiles"
 	// DNSServers is the key for static DNS server addresses for VM drivers
 	DNSServers = "dns-servers"
 	// MDNS is the key for the mDNS parameter (boolean)
@@ -236,6 +244,9 @@ func (c *simpleConfigLoader) LoadConfigFromFile(profileName string, miniHome ...
 }
 
 func (c *simpleConfigLoader) WriteConfigToFile(profileName string, cc *ClusterConfig, miniHome ...string) error {
+	saveMu.Lock()
+	defer saveMu.Unlock()
+
 	path := profileFilePath(profileName, miniHome...)
 	contents, err := json.MarshalIndent(cc, "", "	")
 	if err != nil {
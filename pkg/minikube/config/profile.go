@@ -23,7 +23,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
@@ -35,6 +37,9 @@ import (
 
 var keywords = []string{"start", "stop", "status", "delete", "config", "open", "profile", "addons", "cache", "logs"}
 
+// saveMu serializes writes of cluster configs within this process
+var saveMu sync.Mutex
+
 // ControlPlane returns the first available control-plane node or error, if none found.
 func ControlPlane(cc ClusterConfig) (Node, error) {
 	cps := ControlPlanes(cc)
@@ -118,6 +123,10 @@ func CreateEmptyProfile(name string, miniHome ...string) error {
 
 // SaveNode saves a node to a cluster
 func SaveNode(cfg *ClusterConfig, node *Node) error {
+	// nodes of a pool are started in parallel, serialize their writes of the profile
+	saveMu.Lock()
+	defer saveMu.Unlock()
+
 	update := false
 	for i, n := range cfg.Nodes {
 		if n.Name == node.Name {
@@ -131,11 +140,18 @@ func SaveNode(cfg *ClusterConfig, node *Node) error {
 		cfg.Nodes = append(cfg.Nodes, *node)
 	}
 
-	return SaveProfile(viper.GetString(ProfileName), cfg)
+	return saveProfile(viper.GetString(ProfileName), cfg)
 }
 
 // SaveProfile creates an profile out of the cfg and stores in $MINIKUBE_HOME/profiles/<profilename>/config.json
 func SaveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
+	saveMu.Lock()
+	defer saveMu.Unlock()
+
+	return saveProfile(name, cfg, miniHome...)
+}
+
+func saveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
 	data, err := json.MarshalIndent(cfg, "", "    ")
 	if err != nil {
 		return err
@@ -335,6 +351,42 @@ func ProfileFolderPath(profile string, miniHome ...string) string {
 	return filepath.Join(miniPath, "profiles", profile)
 }
 
+// WithNodeResources returns a copy of cc with the CPUs, memory and disk size of the node, where the node has its own
+func WithNodeResources(cc ClusterConfig, n Node) ClusterConfig {
+	if n.CPUs != 0 {
+		cc.CPUs = n.CPUs
+	}
+	if n.Memory != 0 {
+		cc.Memory = n.Memory
+	}
+	if n.DiskSize != 0 {
+		cc.DiskSize = n.DiskSize
+	}
+	return cc
+}
+
+// NodesByPool returns the nodes of the cluster grouped by node pool: nodes outside of any pool come first,
+// followed by the nodes of each pool in the order the pools were created
+func NodesByPool(cc ClusterConfig) []Node {
+	rank := map[string]int{"": 0}
+	for i, p := range cc.NodePools {
+		rank[p.Name] = i + 1
+	}
+	nodes := slices.Clone(cc.Nodes)
+	slices.SortStableFunc(nodes, func(a, b Node) int {
+		ra, ok := rank[a.Pool]
+		if !ok {
+			ra = len(cc.NodePools) + 1
+		}
+		rb, ok := rank[b.Pool]
+		if !ok {
+			rb = len(cc.NodePools) + 1
+		}
+		return ra - rb
+	})
+	return nodes
+}
+
 // MachineName returns the name of the machine, as seen by the hypervisor given the cluster and node names
 func MachineName(cc ClusterConfig, n Node) string {
 	// For single node cluster, default to back to old naming
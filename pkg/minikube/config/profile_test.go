@@ -18,6 +18,7 @@ package config
 
 import (
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -308,3 +309,45 @@ func TestGetPrimaryControlPlane(t *testing.T) {
 		})
 	}
 }
+
+func TestWithNodeResources(t *testing.T) {
+	cc := ClusterConfig{CPUs: 2, Memory: 4000, DiskSize: 20000}
+
+	got := WithNodeResources(cc, Node{Name: "m02", CPUs: 8, Memory: 16000})
+	if got.CPUs != 8 || got.Memory != 16000 || got.DiskSize != 20000 {
+		t.Errorf("WithNodeResources() = CPUs %d, Memory %d, DiskSize %d, want 8, 16000, 20000", got.CPUs, got.Memory, got.DiskSize)
+	}
+	if cc.CPUs != 2 || cc.Memory != 4000 {
+		t.Errorf("WithNodeResources() modified the cluster config: %+v", cc)
+	}
+
+	got = WithNodeResources(cc, Node{Name: "m03"})
+	if got.CPUs != 2 || got.Memory != 4000 || got.DiskSize != 20000 {
+		t.Errorf("WithNodeResources() without node resources = CPUs %d, Memory %d, DiskSize %d, want 2, 4000, 20000", got.CPUs, got.Memory, got.DiskSize)
+	}
+}
+
+func TestNodesByPool(t *testing.T) {
+	cc := ClusterConfig{
+		Nodes: []Node{
+			{Name: ""},
+			{Name: "m02", Pool: "web"},
+			{Name: "m03", Pool: "db"},
+			{Name: "m04"},
+			{Name: "m05", Pool: "web"},
+		},
+		NodePools: []NodePool{{Name: "db"}, {Name: "web"}},
+	}
+
+	var got []string
+	for _, n := range NodesByPool(cc) {
+		got = append(got, n.Name)
+	}
+	want := []string{"", "m04", "m03", "m02", "m05"}
+	if !slices.Equal(got, want) {
+		t.Errorf("NodesByPool() = %q, want %q", got, want)
+	}
+	if cc.Nodes[1].Name != "m02" {
+		t.Errorf("NodesByPool() reordered the nodes of the cluster config")
+	}
+}
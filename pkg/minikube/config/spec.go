@@ -0,0 +1,438 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/minikube/pkg/util"
+)
+
+const (
+	// ClusterDefinitionAPIVersion is the current version of the cluster definition schema
+	ClusterDefinitionAPIVersion = "minikube.sigs.k8s.io/v1alpha1"
+	// ClusterDefinitionKind is the kind of the cluster definition schema
+	ClusterDefinitionKind = "Cluster"
+)
+
+// ClusterDefinition is a declarative description of a cluster, as loaded by `minikube start --config-file`
+type ClusterDefinition struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Metadata   DefinitionMetadata `json:"metadata,omitempty"`
+	Spec       ClusterSpec        `json:"spec"`
+}
+
+// DefinitionMetadata identifies the profile a ClusterDefinition applies to
+type DefinitionMetadata struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ClusterSpec maps onto ClusterConfig. Empty fields keep the defaults of `minikube start`.
+type ClusterSpec struct {
+	Driver           string         `json:"driver,omitempty"`
+	CPUs             string         `json:"cpus,omitempty"`
+	Memory           string         `json:"memory,omitempty"`
+	DiskSize         string         `json:"diskSize,omitempty"`
+	ContainerRuntime string         `json:"containerRuntime,omitempty"`
+	KeepContext      *bool          `json:"keepContext,omitempty"`
+	EmbedCerts       *bool          `json:"embedCerts,omitempty"`
+	Network          string         `json:"network,omitempty"`
+	Subnet           string         `json:"subnet,omitempty"`
+	StaticIP         string         `json:"staticIP,omitempty"`
+	ListenAddress    string         `json:"listenAddress,omitempty"`
+	Ports            []string       `json:"ports,omitempty"`
+	InsecureRegistry []string       `json:"insecureRegistry,omitempty"`
+	RegistryMirror   []string       `json:"registryMirror,omitempty"`
+	DockerEnv        []string       `json:"dockerEnv,omitempty"`
+	DockerOpt        []string       `json:"dockerOpt,omitempty"`
+	MountString      string         `json:"mountString,omitempty"`
+	GPUs             string         `json:"gpus,omitempty"`
+	Kubernetes       KubernetesSpec `json:"kubernetes,omitempty"`
+	Nodes            []NodeSpec     `json:"nodes,omitempty"`
+	NodePools        []NodePoolSpec `json:"nodePools,omitempty"`
+	Addons           []string       `json:"addons,omitempty"`
+}
+
+// KubernetesSpec maps onto KubernetesConfig
+type KubernetesSpec struct {
+	Version         string   `json:"version,omitempty"`
+	Namespace       string   `json:"namespace,omitempty"`
+	APIServerName   string   `json:"apiServerName,omitempty"`
+	APIServerNames  []string `json:"apiServerNames,omitempty"`
+	APIServerIPs    []string `json:"apiServerIPs,omitempty"`
+	APIServerPort   int      `json:"apiServerPort,omitempty"`
+	DNSDomain       string   `json:"dnsDomain,omitempty"`
+	ServiceCIDR     string   `json:"serviceCIDR,omitempty"`
+	FeatureGates    string   `json:"featureGates,omitempty"`
+	ImageRepository string   `json:"imageRepository,omitempty"`
+	CNI             string   `json:"cni,omitempty"`
+	ExtraConfig     []string `json:"extraConfig,omitempty"`
+}
+
+// nodeNameRe matches the names minikube gives to the nodes after the primary control-plane: m02, m03, ...
+var nodeNameRe = regexp.MustCompile(`^m[0-9]{2,}$`)
+
+// NodeSpec maps onto Node. The first node is the primary control-plane, which has no name and takes the resources of the cluster.
+type NodeSpec struct {
+	Name         string            `json:"name,omitempty"`
+	ControlPlane bool              `json:"controlPlane,omitempty"`
+	Worker       *bool             `json:"worker,omitempty"`
+	CPUs         string            `json:"cpus,omitempty"`
+	Memory       string            `json:"memory,omitempty"`
+	DiskSize     string            `json:"diskSize,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Taints       []string          `json:"taints,omitempty"`
+	Pool         string            `json:"pool,omitempty"`
+}
+
+// NodePoolSpec maps onto NodePool
+type NodePoolSpec struct {
+	Name     string            `json:"name"`
+	CPUs     string            `json:"cpus,omitempty"`
+	Memory   string            `json:"memory,omitempty"`
+	DiskSize string            `json:"diskSize,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Taints   []string          `json:"taints,omitempty"`
+	MinNodes int               `json:"minNodes,omitempty"`
+	MaxNodes int               `json:"maxNodes,omitempty"`
+}
+
+// LoadClusterDefinition reads and validates a ClusterDefinition from a YAML or JSON file
+func LoadClusterDefinition(path string) (*ClusterDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClusterDefinition(data)
+}
+
+// ParseClusterDefinition parses and validates a ClusterDefinition, rejecting unknown fields
+func ParseClusterDefinition(data []byte) (*ClusterDefinition, error) {
+	var def ClusterDefinition
+	if err := yaml.UnmarshalStrict(data, &def); err != nil {
+		return nil, fmt.Errorf("invalid cluster definition: %w", err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// Validate checks that the definition is of a supported version and describes a topology minikube can create
+func (d *ClusterDefinition) Validate() error {
+	if d.APIVersion != ClusterDefinitionAPIVersion {
+		return fmt.Errorf("unsupported apiVersion %q, expected %q", d.APIVersion, ClusterDefinitionAPIVersion)
+	}
+	if d.Kind != ClusterDefinitionKind {
+		return fmt.Errorf("unsupported kind %q, expected %q", d.Kind, ClusterDefinitionKind)
+	}
+	if d.Metadata.Name != "" && !ProfileNameValid(d.Metadata.Name) {
+		return fmt.Errorf("metadata.name: invalid profile name %q", d.Metadata.Name)
+	}
+
+	if err := d.validateNodeNames(); err != nil {
+		return err
+	}
+	if err := d.validateResources(); err != nil {
+		return err
+	}
+
+	cps := 0
+	for i, n := range d.Spec.Nodes {
+		if n.Worker != nil && !*n.Worker {
+			return fmt.Errorf("spec.nodes[%d]: worker cannot be false, all nodes created by minikube run workloads", i)
+		}
+		if !n.ControlPlane {
+			continue
+		}
+		if i != cps {
+			return fmt.Errorf("spec.nodes[%d]: control-plane nodes must be listed before worker nodes", i)
+		}
+		cps++
+	}
+	if len(d.Spec.Nodes) > 0 && cps != 1 && cps != 3 {
+		return fmt.Errorf("spec.nodes: found %d control-plane nodes, expected 1, or 3 for an HA (multi-control plane) cluster", cps)
+	}
+	return nil
+}
+
+// validateNodeNames checks that the node names are the ones minikube would give, in increasing order, so that
+// nodes added later get new names
+func (d *ClusterDefinition) validateNodeNames() error {
+	last := 0
+	for i, n := range d.Spec.Nodes {
+		if i == 0 {
+			if n.Name != "" {
+				return fmt.Errorf("spec.nodes[0]: the primary control-plane node cannot be named, got %q", n.Name)
+			}
+			last = 1
+			continue
+		}
+		if n.Name == "" {
+			last++
+			continue
+		}
+		if !nodeNameRe.MatchString(n.Name) {
+			return fmt.Errorf("spec.nodes[%d]: invalid node name %q, expected m02, m03, ...", i, n.Name)
+		}
+		id, _ := strconv.Atoi(n.Name[1:])
+		if id <= last {
+			return fmt.Errorf("spec.nodes[%d]: node %q must be listed after lower numbered nodes and not repeat them", i, n.Name)
+		}
+		last = id
+	}
+	return nil
+}
+
+// validateResources checks the resources of the nodes and of the node pools, and that the nodes belong to defined pools
+func (d *ClusterDefinition) validateResources() error {
+	pools := map[string]bool{}
+	for i, p := range d.Spec.NodePools {
+		field := fmt.Sprintf("spec.nodePools[%d]", i)
+		if p.Name == "" {
+			return fmt.Errorf("%s: name is required", field)
+		}
+		if pools[p.Name] {
+			return fmt.Errorf("%s: node pool %q is defined more than once", field, p.Name)
+		}
+		pools[p.Name] = true
+		if _, _, _, err := parseResources(field, p.CPUs, p.Memory, p.DiskSize); err != nil {
+			return err
+		}
+		if p.MinNodes < 0 || p.MaxNodes < 0 || (p.MaxNodes > 0 && p.MinNodes > p.MaxNodes) {
+			return fmt.Errorf("%s: invalid bounds %d..%d", field, p.MinNodes, p.MaxNodes)
+		}
+	}
+	for i, n := range d.Spec.Nodes {
+		field := fmt.Sprintf("spec.nodes[%d]", i)
+		if i == 0 && (n.CPUs != "" || n.Memory != "" || n.DiskSize != "" || len(n.Labels) > 0 || len(n.Taints) > 0 || n.Pool != "") {
+			return fmt.Errorf("%s: the primary control-plane node takes the resources of the cluster and cannot be in a node pool", field)
+		}
+		if _, _, _, err := parseResources(field, n.CPUs, n.Memory, n.DiskSize); err != nil {
+			return err
+		}
+		if n.Pool != "" && !pools[n.Pool] {
+			return fmt.Errorf("%s: node pool %q is not defined in spec.nodePools", field, n.Pool)
+		}
+	}
+	return nil
+}
+
+// parseResources parses the optional cpus, memory and disk size of a node or of a node pool, the sizes in MB
+func parseResources(field, cpus, memory, diskSize string) (int, int, int, error) {
+	var c, m, ds int
+	var err error
+	if cpus != "" {
+		if c, err = strconv.Atoi(cpus); err != nil || c <= 0 {
+			return 0, 0, 0, fmt.Errorf("%s.cpus: invalid cpu count %q", field, cpus)
+		}
+	}
+	if memory != "" {
+		if m, err = util.CalculateSizeInMB(memory); err != nil {
+			return 0, 0, 0, fmt.Errorf("%s.memory: invalid size %q: %w", field, memory, err)
+		}
+	}
+	if diskSize != "" {
+		if ds, err = util.CalculateSizeInMB(diskSize); err != nil {
+			return 0, 0, 0, fmt.Errorf("%s.diskSize: invalid size %q: %w", field, diskSize, err)
+		}
+	}
+	return c, m, ds, nil
+}
+
+// Nodes returns the configs of the nodes of the definition after the primary control-plane, without their
+// Kubernetes version, container runtime and port, which are the ones of the cluster
+func (d *ClusterDefinition) Nodes() []Node {
+	var nodes []Node
+	names := d.NodeNames()
+	for i, n := range d.Spec.Nodes {
+		if i == 0 {
+			continue
+		}
+		// validated by Validate
+		c, m, ds, _ := parseResources("", n.CPUs, n.Memory, n.DiskSize)
+		nodes = append(nodes, Node{
+			Name:         names[i],
+			ControlPlane: n.ControlPlane,
+			Worker:       true,
+			CPUs:         c,
+			Memory:       m,
+			DiskSize:     ds,
+			Labels:       maps.Clone(n.Labels),
+			Taints:       slices.Clone(n.Taints),
+			Pool:         n.Pool,
+		})
+	}
+	return nodes
+}
+
+// NodePools returns the node pools of the definition
+func (d *ClusterDefinition) NodePools() []NodePool {
+	var pools []NodePool
+	for _, p := range d.Spec.NodePools {
+		// validated by Validate
+		c, m, ds, _ := parseResources("", p.CPUs, p.Memory, p.DiskSize)
+		pools = append(pools, NodePool{
+			Name:     p.Name,
+			CPUs:     c,
+			Memory:   m,
+			DiskSize: ds,
+			Labels:   maps.Clone(p.Labels),
+			Taints:   slices.Clone(p.Taints),
+			MinNodes: p.MinNodes,
+			MaxNodes: p.MaxNodes,
+		})
+	}
+	return pools
+}
+
+// NodeNames returns the names of the nodes of the definition, naming the nodes without one after the previous node
+func (d *ClusterDefinition) NodeNames() []string {
+	names := []string{}
+	last := 0
+	for i, n := range d.Spec.Nodes {
+		switch {
+		case i == 0:
+			last = 1
+		case n.Name == "":
+			last++
+			n.Name = fmt.Sprintf("m%02d", last)
+		default:
+			last, _ = strconv.Atoi(n.Name[1:])
+		}
+		names = append(names, n.Name)
+	}
+	return names
+}
+
+// ControlPlanes returns the number of control-plane nodes in the definition
+func (d *ClusterDefinition) ControlPlanes() int {
+	cps := 0
+	for _, n := range d.Spec.Nodes {
+		if n.ControlPlane {
+			cps++
+		}
+	}
+	return cps
+}
+
+// NewClusterDefinition returns the definition describing an existing cluster
+func NewClusterDefinition(cc ClusterConfig) ClusterDefinition {
+	k := cc.KubernetesConfig
+	spec := ClusterSpec{
+		Driver:           cc.Driver,
+		ContainerRuntime: k.ContainerRuntime,
+		Network:          cc.Network,
+		Subnet:           cc.Subnet,
+		StaticIP:         cc.StaticIP,
+		ListenAddress:    cc.ListenAddress,
+		Ports:            cc.ExposedPorts,
+		InsecureRegistry: cc.InsecureRegistry,
+		RegistryMirror:   cc.RegistryMirror,
+		DockerEnv:        cc.DockerEnv,
+		DockerOpt:        cc.DockerOpt,
+		MountString:      cc.MountString,
+		GPUs:             cc.GPUs,
+		Kubernetes: KubernetesSpec{
+			Version:         k.KubernetesVersion,
+			Namespace:       k.Namespace,
+			APIServerName:   k.APIServerName,
+			APIServerNames:  k.APIServerNames,
+			APIServerPort:   cc.APIServerPort,
+			DNSDomain:       k.DNSDomain,
+			ServiceCIDR:     k.ServiceCIDR,
+			FeatureGates:    k.FeatureGates,
+			ImageRepository: k.ImageRepository,
+			CNI:             k.CNI,
+		},
+	}
+	spec.CPUs, spec.Memory, spec.DiskSize = resourceSpecs(cc.CPUs, cc.Memory, cc.DiskSize)
+	if cc.KeepContext {
+		spec.KeepContext = &cc.KeepContext
+	}
+	if cc.EmbedCerts {
+		spec.EmbedCerts = &cc.EmbedCerts
+	}
+	for _, ip := range k.APIServerIPs {
+		spec.Kubernetes.APIServerIPs = append(spec.Kubernetes.APIServerIPs, ip.String())
+	}
+	for _, eo := range k.ExtraOptions {
+		spec.Kubernetes.ExtraConfig = append(spec.Kubernetes.ExtraConfig, eo.String())
+	}
+	for _, n := range cc.Nodes {
+		cpus, memory, diskSize := resourceSpecs(n.CPUs, n.Memory, n.DiskSize)
+		spec.Nodes = append(spec.Nodes, NodeSpec{
+			Name:         n.Name,
+			ControlPlane: n.ControlPlane,
+			CPUs:         cpus,
+			Memory:       memory,
+			DiskSize:     diskSize,
+			Labels:       n.Labels,
+			Taints:       n.Taints,
+			Pool:         n.Pool,
+		})
+	}
+	for _, p := range cc.NodePools {
+		cpus, memory, diskSize := resourceSpecs(p.CPUs, p.Memory, p.DiskSize)
+		spec.NodePools = append(spec.NodePools, NodePoolSpec{
+			Name:     p.Name,
+			CPUs:     cpus,
+			Memory:   memory,
+			DiskSize: diskSize,
+			Labels:   p.Labels,
+			Taints:   p.Taints,
+			MinNodes: p.MinNodes,
+			MaxNodes: p.MaxNodes,
+		})
+	}
+	for name, enabled := range cc.Addons {
+		if enabled {
+			spec.Addons = append(spec.Addons, name)
+		}
+	}
+	slices.Sort(spec.Addons)
+
+	return ClusterDefinition{
+		APIVersion: ClusterDefinitionAPIVersion,
+		Kind:       ClusterDefinitionKind,
+		Metadata:   DefinitionMetadata{Name: cc.Name},
+		Spec:       spec,
+	}
+}
+
+// resourceSpecs returns the spec values of the cpus, memory and disk size in MB, empty when not set
+func resourceSpecs(cpus, memory, diskSize int) (string, string, string) {
+	var c, m, ds string
+	if cpus != 0 {
+		c = strconv.Itoa(cpus)
+	}
+	if memory != 0 {
+		m = fmt.Sprintf("%dmb", memory)
+	}
+	if diskSize != 0 {
+		ds = fmt.Sprintf("%dmb", diskSize)
+	}
+	return c, m, ds
+}
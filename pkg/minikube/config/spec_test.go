@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseClusterDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `apiVersion: minikube.sigs.k8s.io/v1alpha1
+kind: Cluster
+metadata:
+  name: demo
+spec:
+  driver: docker
+  cpus: "4"
+  kubernetes:
+    version: v1.30.0
+  nodes:
+  - controlPlane: true
+  - {}
+  addons: [ingress]
+`,
+		},
+		{
+			name:    "unknown field",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  cpu: 4\n",
+			wantErr: `unknown field "cpu"`,
+		},
+		{
+			name:    "wrong version",
+			data:    "apiVersion: minikube.sigs.k8s.io/v2\nkind: Cluster\n",
+			wantErr: "unsupported apiVersion",
+		},
+		{
+			name:    "wrong kind",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Node\n",
+			wantErr: "unsupported kind",
+		},
+		{
+			name:    "invalid name",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nmetadata:\n  name: a_b\n",
+			wantErr: "invalid profile name",
+		},
+		{
+			name:    "worker before control-plane",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - {}\n  - controlPlane: true\n",
+			wantErr: "must be listed before worker nodes",
+		},
+		{
+			name:    "two control-planes",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n  - controlPlane: true\n",
+			wantErr: "found 2 control-plane nodes",
+		},
+		{
+			name:    "non-worker node",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n    worker: false\n",
+			wantErr: "worker cannot be false",
+		},
+		{
+			name:    "named primary node",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - name: m01\n    controlPlane: true\n",
+			wantErr: "primary control-plane node cannot be named",
+		},
+		{
+			name:    "invalid node name",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n  - name: worker\n",
+			wantErr: "invalid node name",
+		},
+		{
+			name:    "resources of the primary node",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n    cpus: \"2\"\n",
+			wantErr: "takes the resources of the cluster",
+		},
+		{
+			name:    "invalid node memory",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n  - memory: lots\n",
+			wantErr: "spec.nodes[1].memory: invalid size",
+		},
+		{
+			name:    "undefined node pool",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n  - pool: gpu\n",
+			wantErr: `node pool "gpu" is not defined`,
+		},
+		{
+			name:    "node names out of order",
+			data:    "apiVersion: minikube.sigs.k8s.io/v1alpha1\nkind: Cluster\nspec:\n  nodes:\n  - controlPlane: true\n  - name: m03\n  - name: m02\n",
+			wantErr: "must be listed after lower numbered nodes",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseClusterDefinition([]byte(tc.data))
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNodeNames(t *testing.T) {
+	def := ClusterDefinition{Spec: ClusterSpec{Nodes: []NodeSpec{{ControlPlane: true}, {Name: "m03"}, {}, {Name: "m07"}}}}
+	want := []string{"", "m03", "m04", "m07"}
+	if diff := cmp.Diff(want, def.NodeNames()); diff != "" {
+		t.Errorf("NodeNames mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewClusterDefinitionRoundTrip(t *testing.T) {
+	cc := ClusterConfig{
+		Name:     "demo",
+		Driver:   "docker",
+		CPUs:     4,
+		Memory:   8192,
+		DiskSize: 20000,
+		KubernetesConfig: KubernetesConfig{
+			KubernetesVersion: "v1.30.0",
+			ContainerRuntime:  "containerd",
+			ExtraOptions:      ExtraOptionSlice{{Component: "kubelet", Key: "max-pods", Value: "150"}},
+		},
+		Nodes: []Node{
+			{Name: "", ControlPlane: true, Worker: true},
+			{Name: "m02", Worker: true, CPUs: 2, Memory: 4096, Labels: map[string]string{"size": "large"}},
+			{Name: "m03", Worker: true, DiskSize: 10000, Taints: []string{"gpu=true:NoSchedule"}, Pool: "gpu"},
+		},
+		NodePools: []NodePool{{Name: "gpu", CPUs: 8, Taints: []string{"gpu=true:NoSchedule"}, MaxNodes: 3}},
+		Addons:    map[string]bool{"metrics-server": true, "ingress": true, "dashboard": false},
+	}
+
+	def := NewClusterDefinition(cc)
+	data, err := yaml.Marshal(def)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	got, err := ParseClusterDefinition(data)
+	if err != nil {
+		t.Fatalf("parse exported definition: %v\n%s", err, data)
+	}
+
+	want := ClusterSpec{
+		Driver:           "docker",
+		CPUs:             "4",
+		Memory:           "8192mb",
+		DiskSize:         "20000mb",
+		ContainerRuntime: "containerd",
+		Kubernetes: KubernetesSpec{
+			Version:     "v1.30.0",
+			ExtraConfig: []string{"kubelet.max-pods=150"},
+		},
+		Nodes: []NodeSpec{
+			{ControlPlane: true},
+			{Name: "m02", CPUs: "2", Memory: "4096mb", Labels: map[string]string{"size": "large"}},
+			{Name: "m03", DiskSize: "10000mb", Taints: []string{"gpu=true:NoSchedule"}, Pool: "gpu"},
+		},
+		NodePools: []NodePoolSpec{{Name: "gpu", CPUs: "8", Taints: []string{"gpu=true:NoSchedule"}, MaxNodes: 3}},
+		Addons:    []string{"ingress", "metrics-server"},
+	}
+	if diff := cmp.Diff(want, got.Spec); diff != "" {
+		t.Errorf("spec mismatch (-want +got):\n%s", diff)
+	}
+	if got.Metadata.Name != "demo" {
+		t.Errorf("name = %q, want %q", got.Metadata.Name, "demo")
+	}
+	if diff := cmp.Diff(cc.Nodes[1:], got.Nodes()); diff != "" {
+		t.Errorf("nodes mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(cc.NodePools, got.NodePools()); diff != "" {
+		t.Errorf("node pools mismatch (-want +got):\n%s", diff)
+	}
+}
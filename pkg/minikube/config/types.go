@@ -76,12 +76,14 @@ type ClusterConfig struct {
 	SSHPort                 int    // Only used by ssh driver
 	KubernetesConfig        KubernetesConfig
 	Nodes                   []Node
+	NodePools               []NodePool `json:",omitempty"`
 	Addons                  map[string]bool
 	CustomAddonImages       map[string]string // Maps image names to the image to use for addons. e.g. Dashboard -> registry.k8s.io/echoserver:1.4 makes dashboard addon use echoserver for its Dashboard deployment.
 	CustomAddonRegistries   map[string]string // Maps image names to the registry to use for addons. See CustomAddonImages for example.
 	VerifyComponents        map[string]bool   // map of components to verify and wait for after start.
 	StartHostTimeout        time.Duration
 	ScheduledStop           *ScheduledStopConfig
+	Schedules               []Schedule
 	ExposedPorts            []string // Only used by the docker and podman driver
 	ListenAddress           string   // Only used by the docker and podman driver
 	Network                 string   // only used by docker driver
@@ -114,6 +116,15 @@ type ClusterConfig struct {
 	VmnetOffloading         bool          // Only used by krunkit driver
 	DNSServers              []netip.Addr  // Static DNS servers for the VM (VM drivers only)
 	MDNS                    bool          // Enable mDNS (.local) resolution via systemd-resolved
+	HostCredentials         bool          // Copy the registry credentials of the host into the kubelet config of every node
+	RegistryCache           []string      `json:",omitempty"` // Registries pulled through the pull-through caches on the host
+	Mounts                  []Mount       `json:",omitempty"` // Host directories mounted with minikube mount add
+	RuntimeConfigPatch      string        `json:",omitempty"` // Merged into the configuration of the container runtime on every start
+
+	// AutoPauseCPUThreshold is the workload CPU usage, in percent of one core, above which auto-pause considers the cluster active, 0 disabling the check
+	AutoPauseCPUThreshold float64 `json:",omitempty"`
+	// AutoPauseExcludedNamespaces are the namespaces whose containers auto-pause does not count as workloads, kube-system when empty
+	AutoPauseExcludedNamespaces []string `json:",omitempty"`
 }
 
 // KubernetesConfig contains the parameters used to configure the VM Kubernetes.
@@ -153,6 +164,41 @@ type Node struct {
 	ContainerRuntime  string
 	ControlPlane      bool
 	Worker            bool
+	// CPUs, Memory and DiskSize (in MB) override the resources of the cluster for this node when set
+	CPUs     int               `json:",omitempty"`
+	Memory   int               `json:",omitempty"`
+	DiskSize int               `json:",omitempty"`
+	Labels   map[string]string `json:",omitempty"`
+	// Taints are applied to the Kubernetes node, in the key[=value]:effect form
+	Taints []string `json:",omitempty"`
+	// Pool is the name of the node pool the node belongs to, if any
+	Pool string `json:",omitempty"`
+	// NetworkShape emulates network conditions on the traffic leaving the node, if set
+	NetworkShape *NetworkShape `json:",omitempty"`
+}
+
+// NetworkShape is the latency, packet loss and bandwidth emulated on the network interface of a node
+type NetworkShape struct {
+	Latency time.Duration `json:",omitempty"`
+	// Jitter is the random variation of the latency
+	Jitter time.Duration `json:",omitempty"`
+	// Loss is the percentage of packets dropped
+	Loss float64 `json:",omitempty"`
+	// Rate is the bandwidth in the tc rate syntax, such as 10mbit
+	Rate string `json:",omitempty"`
+}
+
+// NodePool is a named group of worker nodes sharing the same resources, labels and taints
+type NodePool struct {
+	Name     string
+	CPUs     int               `json:",omitempty"`
+	Memory   int               `json:",omitempty"`
+	DiskSize int               `json:",omitempty"`
+	Labels   map[string]string `json:",omitempty"`
+	Taints   []string          `json:",omitempty"`
+	// MinNodes and MaxNodes bound the size of the pool when scaled by the cluster autoscaler, which leaves the pool alone when MaxNodes is 0
+	MinNodes int `json:",omitempty"`
+	MaxNodes int `json:",omitempty"`
 }
 
 // Role returns the node role string for logging and error messages.
@@ -188,9 +234,26 @@ type VersionedExtraOption struct {
 	GreaterThanOrEqual semver.Version
 }
 
-// ScheduledStopConfig contains information around scheduled stop
-// not yet used, will be used to show status of scheduled stop
+// ScheduledStopConfig contains information around a one-shot scheduled stop
 type ScheduledStopConfig struct {
 	InitiationTime int64
 	Duration       time.Duration
 }
+
+// Mount is a host directory mounted into the primary control-plane node with minikube mount add
+type Mount struct {
+	HostPath string
+	NodePath string
+	Type     string
+	UID      string   `json:",omitempty"`
+	GID      string   `json:",omitempty"`
+	Options  []string `json:",omitempty"`
+	// Persistent mounts are mounted again by minikube start, the others are forgotten by minikube stop
+	Persistent bool `json:",omitempty"`
+}
+
+// Schedule is a recurring start or stop of a cluster
+type Schedule struct {
+	Action string // "start" or "stop"
+	Spec   string // cron expression or "HH:MM [days]", see the schedule/cron package
+}
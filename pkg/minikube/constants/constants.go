@@ -53,6 +53,8 @@ const (
 	APIServerPort = 8443
 	// AutoPauseProxyPort is the port to be used as a reverse proxy for apiserver port
 	AutoPauseProxyPort = 32443
+	// AutoPausePort is the port the auto-pause daemon listens on inside the control plane node
+	AutoPausePort = 8080
 
 	// SSHPort is the SSH serviceport on the node vm and container
 	SSHPort = 22
@@ -182,6 +184,8 @@ var (
 	IsMinikubeChildProcess = "IS_MINIKUBE_CHILD_PROCESS"
 	// MountProcessFileName is the filename of the mount process
 	MountProcessFileName = ".mount-process"
+	// MountPIDsFileName is the filename of the processes serving the mounts, by node path
+	MountPIDsFileName = ".mount-pids.json"
 
 	// SHASuffix is the suffix of a SHA-256 checksum file
 	SHASuffix = ".sha256"
@@ -47,12 +47,22 @@ import (
 const (
 	containerdNamespaceRoot = "/run/containerd/runc/k8s.io"
 	// ContainerdConfFile is the path to the containerd configuration
-	containerdConfigFile               = "/etc/containerd/config.toml"
+	containerdConfigFile = "/etc/containerd/config.toml"
+	// containerdConfigBackup is the containerd configuration before the configuration patch was merged into it
+	containerdConfigBackup             = containerdConfigFile + ".minikube"
 	containerdMirrorsRoot              = "/etc/containerd/certs.d"
 	containerdInsecureRegistryTemplate = `server = "{{.InsecureRegistry -}}"
 
 [host."{{.InsecureRegistry -}}"]
   skip_verify = true
+`
+	// containerdMirrorMarker tells the hosts.toml files of the registry caches apart from the ones of the insecure registries
+	containerdMirrorMarker   = "# minikube registry cache"
+	containerdMirrorTemplate = `{{.Marker}}
+server = "{{.Upstream -}}"
+
+[host."{{.Mirror -}}"]
+  capabilities = ["pull", "resolve"]
 `
 )
 
@@ -64,6 +74,8 @@ type Containerd struct {
 	KubernetesVersion semver.Version
 	Init              sysinit.Manager
 	InsecureRegistry  []string
+	Mirrors           []Mirror
+	ConfigPatch       string
 }
 
 // Name is a human readable name for containerd
@@ -224,6 +236,73 @@ func generateContainerdConfig(cr CommandRunner, imageRepository string, kv semve
 	return nil
 }
 
+// restoreContainerdConfig restores the containerd configuration from before the configuration patch of the previous start,
+// so that the settings of a patch do not outlive it
+func restoreContainerdConfig(cr CommandRunner) error {
+	c := exec.Command("sudo", "sh", "-c", fmt.Sprintf("if [ -f %s ]; then mv %s %s; fi", containerdConfigBackup, containerdConfigBackup, containerdConfigFile))
+	if _, err := cr.RunCmd(c); err != nil {
+		return fmt.Errorf("restoring %s: %w", containerdConfigFile, err)
+	}
+	return nil
+}
+
+// generateContainerdPatchConfig merges the configuration patch into the containerd configuration, keeping a backup of it
+func generateContainerdPatchConfig(cr CommandRunner, patch string) error {
+	if patch == "" {
+		return nil
+	}
+	rr, err := cr.RunCmd(exec.Command("sudo", "cat", containerdConfigFile))
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", containerdConfigFile, err)
+	}
+	patched, err := patchTOML(rr.Stdout.Bytes(), []byte(patch))
+	if err != nil {
+		return fmt.Errorf("patching %s: %w", containerdConfigFile, err)
+	}
+	if _, err := cr.RunCmd(exec.Command("sudo", "cp", "-a", containerdConfigFile, containerdConfigBackup)); err != nil {
+		return fmt.Errorf("backing up %s: %w", containerdConfigFile, err)
+	}
+	klog.Infof("merging the configuration patch into %s", containerdConfigFile)
+	if err := cr.Copy(assets.NewMemoryAssetTarget(patched, containerdConfigFile, "0644")); err != nil {
+		return fmt.Errorf("writing %s: %w", containerdConfigFile, err)
+	}
+	return nil
+}
+
+// generateContainerdMirrorsConfig makes containerd pull the images of the registries from their mirrors, falling back to the registries
+func generateContainerdMirrorsConfig(cr CommandRunner, mirrors []Mirror) error {
+	// forget about the mirrors of the previous start, which might not be wanted anymore
+	c := exec.Command("sh", "-c", fmt.Sprintf(`sudo grep -rlF %q %s | xargs -r sudo rm -f`, containerdMirrorMarker, containerdMirrorsRoot))
+	if _, err := cr.RunCmd(c); err != nil {
+		return fmt.Errorf("removing registry mirrors: %w", err)
+	}
+
+	t, err := template.New("hosts.toml").Parse(containerdMirrorTemplate)
+	if err != nil {
+		return fmt.Errorf("unable to parse registry mirror template: %w", err)
+	}
+	for _, m := range mirrors {
+		opts := struct {
+			Marker   string
+			Upstream string
+			Mirror   string
+		}{
+			Marker:   containerdMirrorMarker,
+			Upstream: m.Upstream,
+			Mirror:   m.URL,
+		}
+		var b bytes.Buffer
+		if err := t.Execute(&b, opts); err != nil {
+			return fmt.Errorf("unable to create registry mirror template: %w", err)
+		}
+		klog.Infof("configuring containerd to pull %s from %s", m.Registry, m.URL)
+		if err := cr.Copy(assets.NewMemoryAssetTarget(b.Bytes(), path.Join(containerdMirrorsRoot, m.Registry, "hosts.toml"), "0644")); err != nil {
+			return fmt.Errorf("configuring registry mirror of %s: %w", m.Registry, err)
+		}
+	}
+	return nil
+}
+
 // Enable idempotently enables containerd on a host
 // It is also called by docker.Enable() - if bound to containerd, to enforce proper containerd configuration completed by service restart.
 func (r *Containerd) Enable(disOthers bool, cgroupDriver string, inUserNamespace bool) error {
@@ -246,9 +325,18 @@ func (r *Containerd) Enable(disOthers bool, cgroupDriver string, inUserNamespace
 		return err
 	}
 
+	if err := restoreContainerdConfig(r.Runner); err != nil {
+		return err
+	}
 	if err := generateContainerdConfig(r.Runner, r.ImageRepository, r.KubernetesVersion, cgroupDriver, r.InsecureRegistry, inUserNamespace); err != nil {
 		return err
 	}
+	if err := generateContainerdPatchConfig(r.Runner, r.ConfigPatch); err != nil {
+		return err
+	}
+	if err := generateContainerdMirrorsConfig(r.Runner, r.Mirrors); err != nil {
+		return err
+	}
 	if err := enableIPForwarding(r.Runner); err != nil {
 		return err
 	}
@@ -317,6 +405,11 @@ func (r *Containerd) RemoveImage(name string) error {
 	return removeCRIImage(r.Runner, name, false)
 }
 
+// PruneImages removes the images that no container uses, returning the removed images
+func (r *Containerd) PruneImages(o PruneImagesOptions) ([]ListImage, error) {
+	return pruneCRIImages(r.Runner, o)
+}
+
 // TagImage tags an image in this runtime
 func (r *Containerd) TagImage(source string, target string) error {
 	klog.Infof("Tagging image %s: %s", source, target)
@@ -503,6 +596,11 @@ func (r *Containerd) UnpauseContainers(ids []string) error {
 	return unpauseCRIContainers(r.Runner, containerdNamespaceRoot, ids)
 }
 
+// ContainerStats returns a resource usage sample for containers based on ID
+func (r *Containerd) ContainerStats(ids []string) ([]ContainerStats, error) {
+	return criContainerStats(r.Runner, ids)
+}
+
 // KillContainers removes containers based on ID
 func (r *Containerd) KillContainers(ids []string) error {
 	return killCRIContainers(r.Runner, ids)
@@ -19,9 +19,39 @@ package cruntime
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"k8s.io/minikube/pkg/version"
 )
 
+func TestGenerateContainerdMirrorsConfig(t *testing.T) {
+	runner := &copyRunner{FakeRunner: NewFakeRunner(t), files: map[string]string{}}
+	mirrors := []Mirror{
+		{Registry: "docker.io", Upstream: "https://registry-1.docker.io", URL: "http://host.minikube.internal:5100"},
+		{Registry: "quay.io", Upstream: "https://quay.io", URL: "http://host.minikube.internal:5101"},
+	}
+	if err := generateContainerdMirrorsConfig(runner, mirrors); err != nil {
+		t.Fatalf("generateContainerdMirrorsConfig: %v", err)
+	}
+	want := map[string]string{
+		"/etc/containerd/certs.d/docker.io/hosts.toml": `# minikube registry cache
+server = "https://registry-1.docker.io"
+
+[host."http://host.minikube.internal:5100"]
+  capabilities = ["pull", "resolve"]
+`,
+		"/etc/containerd/certs.d/quay.io/hosts.toml": `# minikube registry cache
+server = "https://quay.io"
+
+[host."http://host.minikube.internal:5101"]
+  capabilities = ["pull", "resolve"]
+`,
+	}
+	if diff := cmp.Diff(want, runner.files); diff != "" {
+		t.Errorf("hosts.toml mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestAddRepoTagToImageName(t *testing.T) {
 	var tests = []struct {
 		imgName string
@@ -24,6 +24,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -189,6 +190,58 @@ func unpauseCRIContainers(cr CommandRunner, root string, ids []string) error {
 	return nil
 }
 
+// crictlStats maps to 'crictl stats -o json'
+type crictlStats struct {
+	Stats []struct {
+		Attributes struct {
+			ID string `json:"id"`
+		} `json:"attributes"`
+		CPU struct {
+			UsageNanoCores struct {
+				Value string `json:"value"`
+			} `json:"usageNanoCores"`
+		} `json:"cpu"`
+	} `json:"stats"`
+}
+
+// criContainerStats returns a resource usage sample for a list of containers using crictl
+func criContainerStats(cr CommandRunner, ids []string) ([]ContainerStats, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	crictl := getCrictlPath(cr)
+	rr, err := cr.RunCmd(exec.Command("sudo", crictl, "stats", "-o", "json"))
+	if err != nil {
+		return nil, fmt.Errorf("crictl: %w", err)
+	}
+	var cs crictlStats
+	if err := json.Unmarshal(rr.Stdout.Bytes(), &cs); err != nil {
+		return nil, fmt.Errorf("unmarshal crictl stats: %w", err)
+	}
+
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var stats []ContainerStats
+	for _, s := range cs.Stats {
+		if !wanted[s.Attributes.ID] {
+			continue
+		}
+		st := ContainerStats{ID: s.Attributes.ID}
+		// usageNanoCores is absent until the runtime has taken two samples
+		if v := s.CPU.UsageNanoCores.Value; v != "" {
+			nc, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse cpu usage of %s: %w", st.ID, err)
+			}
+			st.CPUPercent = float64(nc) / 1e7
+		}
+		stats = append(stats, st)
+	}
+	return stats, nil
+}
+
 // killCRIContainers kills a list of containers using crictl
 func killCRIContainers(cr CommandRunner, ids []string) error {
 	if len(ids) == 0 {
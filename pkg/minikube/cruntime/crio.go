@@ -41,6 +41,10 @@ import (
 const (
 	// crioConfigFile is the path to the CRI-O configuration
 	crioConfigFile = "/etc/crio/crio.conf.d/02-crio.conf"
+	// crioMirrorsFile is the path to the registries configuration of the registry caches
+	crioMirrorsFile = "/etc/containers/registries.conf.d/99-minikube-mirrors.conf"
+	// crioPatchFile is the path to the drop-in of the configuration patch, read after crioConfigFile
+	crioPatchFile = "/etc/crio/crio.conf.d/99-minikube-patch.conf"
 )
 
 // CRIO contains CRIO runtime state
@@ -50,6 +54,43 @@ type CRIO struct {
 	ImageRepository   string
 	KubernetesVersion semver.Version
 	Init              sysinit.Manager
+	Mirrors           []Mirror
+	ConfigPatch       string
+}
+
+// generateCRIOMirrorsConfig makes cri-o pull the images of the registries from their mirrors, falling back to the registries
+func generateCRIOMirrorsConfig(cr CommandRunner, mirrors []Mirror) error {
+	if len(mirrors) == 0 {
+		if _, err := cr.RunCmd(exec.Command("sudo", "rm", "-f", crioMirrorsFile)); err != nil {
+			return fmt.Errorf("removing registry mirrors: %w", err)
+		}
+		return nil
+	}
+	var b strings.Builder
+	for _, m := range mirrors {
+		fmt.Fprintf(&b, "[[registry]]\nprefix = %q\nlocation = %q\n\n", m.Registry, m.Registry)
+		fmt.Fprintf(&b, "[[registry.mirror]]\nlocation = %q\ninsecure = true\n\n", strings.TrimPrefix(m.URL, "http://"))
+	}
+	klog.Infof("configuring cri-o to use registry mirrors %v", mirrors)
+	if err := cr.Copy(assets.NewMemoryAssetTarget([]byte(b.String()), crioMirrorsFile, "0644")); err != nil {
+		return fmt.Errorf("configuring registry mirrors: %w", err)
+	}
+	return nil
+}
+
+// generateCRIOPatchConfig writes the configuration patch as a drop-in, which cri-o merges into its configuration
+func generateCRIOPatchConfig(cr CommandRunner, patch string) error {
+	if patch == "" {
+		if _, err := cr.RunCmd(exec.Command("sudo", "rm", "-f", crioPatchFile)); err != nil {
+			return fmt.Errorf("removing configuration patch: %w", err)
+		}
+		return nil
+	}
+	klog.Infof("configuring cri-o with the configuration patch in %s", crioPatchFile)
+	if err := cr.Copy(assets.NewMemoryAssetTarget([]byte(patch), crioPatchFile, "0644")); err != nil {
+		return fmt.Errorf("configuring configuration patch: %w", err)
+	}
+	return nil
 }
 
 // generateCRIOConfig sets up pause image and cgroup manager for cri-o in crioConfigFile
@@ -225,6 +266,12 @@ func (r *CRIO) Enable(disOthers bool, cgroupDriver string, inUserNamespace bool)
 	if err := generateCRIOConfig(r.Runner, r.ImageRepository, r.KubernetesVersion, cgroupDriver); err != nil {
 		return err
 	}
+	if err := generateCRIOMirrorsConfig(r.Runner, r.Mirrors); err != nil {
+		return err
+	}
+	if err := generateCRIOPatchConfig(r.Runner, r.ConfigPatch); err != nil {
+		return err
+	}
 	if err := enableIPForwarding(r.Runner); err != nil {
 		return err
 	}
@@ -299,6 +346,11 @@ func (r *CRIO) RemoveImage(name string) error {
 	return removeCRIImage(r.Runner, name, true)
 }
 
+// PruneImages removes the images that no container uses, returning the removed images
+func (r *CRIO) PruneImages(o PruneImagesOptions) ([]ListImage, error) {
+	return pruneCRIImages(r.Runner, o)
+}
+
 // TagImage tags an image in this runtime
 func (r *CRIO) TagImage(source string, target string) error {
 	klog.Infof("Tagging image %s: %s", source, target)
@@ -394,6 +446,11 @@ func (r *CRIO) UnpauseContainers(ids []string) error {
 	return unpauseCRIContainers(r.Runner, "", ids)
 }
 
+// ContainerStats returns a resource usage sample for containers based on ID
+func (r *CRIO) ContainerStats(ids []string) ([]ContainerStats, error) {
+	return criContainerStats(r.Runner, ids)
+}
+
 // KillContainers removes containers based on ID
 func (r *CRIO) KillContainers(ids []string) error {
 	return killCRIContainers(r.Runner, ids)
@@ -18,6 +18,7 @@ package cruntime
 
 import (
 	"bytes"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -25,7 +26,9 @@ import (
 	"testing"
 
 	"github.com/blang/semver/v4"
+	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/viper"
+	"k8s.io/minikube/pkg/minikube/assets"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/download"
@@ -64,6 +67,61 @@ func (f *MockRunner) RunCmd(cmd *exec.Cmd) (*command.RunResult, error) {
 	return f.FakeRunner.RunCmd(cmd)
 }
 
+// copyRunner records the files copied to the node by their target path
+type copyRunner struct {
+	*FakeRunner
+	files map[string]string
+}
+
+func (f *copyRunner) Copy(file assets.CopyableFile) error {
+	var b bytes.Buffer
+	if _, err := io.Copy(&b, file); err != nil {
+		return err
+	}
+	f.files[file.GetTargetPath()] = b.String()
+	return nil
+}
+
+func TestGenerateCRIOMirrorsConfig(t *testing.T) {
+	runner := &copyRunner{FakeRunner: NewFakeRunner(t), files: map[string]string{}}
+	mirrors := []Mirror{
+		{Registry: "docker.io", Upstream: "https://registry-1.docker.io", URL: "http://host.minikube.internal:5100"},
+		{Registry: "registry.k8s.io", Upstream: "https://registry.k8s.io", URL: "http://host.minikube.internal:5101"},
+	}
+	if err := generateCRIOMirrorsConfig(runner, mirrors); err != nil {
+		t.Fatalf("generateCRIOMirrorsConfig: %v", err)
+	}
+	want := `[[registry]]
+prefix = "docker.io"
+location = "docker.io"
+
+[[registry.mirror]]
+location = "host.minikube.internal:5100"
+insecure = true
+
+[[registry]]
+prefix = "registry.k8s.io"
+location = "registry.k8s.io"
+
+[[registry.mirror]]
+location = "host.minikube.internal:5101"
+insecure = true
+
+`
+	if diff := cmp.Diff(want, runner.files[crioMirrorsFile]); diff != "" {
+		t.Errorf("mirrors config mismatch (-want +got):\n%s", diff)
+	}
+
+	// without mirrors, the config of a previous start is removed
+	runner.cmds = nil
+	if err := generateCRIOMirrorsConfig(runner, nil); err != nil {
+		t.Fatalf("generateCRIOMirrorsConfig: %v", err)
+	}
+	if diff := cmp.Diff([]string{"sudo", "rm", "-f", crioMirrorsFile}, runner.cmds); diff != "" {
+		t.Errorf("commands mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestCRIOPreload(t *testing.T) {
 	viper.Set("preload", true)
 	tempDir := t.TempDir()
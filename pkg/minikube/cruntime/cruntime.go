@@ -119,6 +119,8 @@ type Manager interface {
 
 	// RemoveImage remove image based on name
 	RemoveImage(string) error
+	// PruneImages removes the images that no container uses, returning the removed images
+	PruneImages(PruneImagesOptions) ([]ListImage, error)
 
 	// ListContainers returns a list of containers managed by this container runtime
 	ListContainers(ListContainersOptions) ([]string, error)
@@ -130,6 +132,8 @@ type Manager interface {
 	PauseContainers([]string) error
 	// UnpauseContainers unpauses containers based on ID
 	UnpauseContainers([]string) error
+	// ContainerStats returns a resource usage sample for containers based on ID
+	ContainerStats([]string) ([]ContainerStats, error)
 	// ContainerLogCmd returns the command to retrieve the log for a container based on ID
 	ContainerLogCmd(string, int, bool) string
 	// SystemLogCmd returns the command to return the system logs
@@ -156,8 +160,22 @@ type Config struct {
 	KubernetesVersion semver.Version
 	// InsecureRegistry list of insecure registries
 	InsecureRegistry []string
+	// Mirrors are the pull-through caches to pull the images of registries from
+	Mirrors []Mirror
 	// GPUs add GPU devices to the container
 	GPUs string
+	// ConfigPatch is merged into the configuration of the runtime: TOML for containerd and cri-o, JSON for docker
+	ConfigPatch string
+}
+
+// Mirror is a pull-through cache of a registry
+type Mirror struct {
+	// Registry is the registry, as it appears in image names, e.g. docker.io
+	Registry string
+	// Upstream is the URL of the registry that serves its images
+	Upstream string
+	// URL is the URL the nodes reach the cache at
+	URL string
 }
 
 // ListContainersOptions are the options to use for listing containers
@@ -170,6 +188,14 @@ type ListContainersOptions struct {
 	Namespaces []string
 }
 
+// ContainerStats is a point in time resource usage sample of a container
+type ContainerStats struct {
+	// ID is the container ID
+	ID string
+	// CPUPercent is the CPU usage, where 100 means one fully used core
+	CPUPercent float64
+}
+
 // ListImagesOptions are the options to use for listing images
 type ListImagesOptions struct {
 }
@@ -231,6 +257,8 @@ func New(c Config) (Manager, error) {
 			UseCRI:            (sp != ""), // !dockershim
 			CRIService:        cs,
 			GPUs:              c.GPUs,
+			Mirrors:           c.Mirrors,
+			ConfigPatch:       c.ConfigPatch,
 		}, nil
 	case "crio", "cri-o":
 		return &CRIO{
@@ -239,6 +267,8 @@ func New(c Config) (Manager, error) {
 			ImageRepository:   c.ImageRepository,
 			KubernetesVersion: c.KubernetesVersion,
 			Init:              sm,
+			Mirrors:           c.Mirrors,
+			ConfigPatch:       c.ConfigPatch,
 		}, nil
 	case "containerd":
 		return &Containerd{
@@ -248,6 +278,8 @@ func New(c Config) (Manager, error) {
 			KubernetesVersion: c.KubernetesVersion,
 			Init:              sm,
 			InsecureRegistry:  c.InsecureRegistry,
+			Mirrors:           c.Mirrors,
+			ConfigPatch:       c.ConfigPatch,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unknown runtime type: %q", c.Type)
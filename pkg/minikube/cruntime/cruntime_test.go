@@ -813,3 +813,22 @@ func TestContainerFunctions(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDockerStats(t *testing.T) {
+	got, err := parseDockerStats("abc123 12.50%\ndef456 --\n\nghi789 0.00%\n")
+	if err != nil {
+		t.Fatalf("parseDockerStats: %v", err)
+	}
+	want := []ContainerStats{
+		{ID: "abc123", CPUPercent: 12.5},
+		{ID: "def456"},
+		{ID: "ghi789"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseDockerStats unexpected results, diff (-want +got): %s", diff)
+	}
+
+	if _, err := parseDockerStats("abc123 lots%\n"); err == nil {
+		t.Errorf("parseDockerStats expected an error for an invalid percentage")
+	}
+}
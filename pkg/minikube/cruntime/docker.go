@@ -24,6 +24,8 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -77,6 +79,8 @@ type Docker struct {
 	UseCRI            bool
 	CRIService        string
 	GPUs              string
+	Mirrors           []Mirror
+	ConfigPatch       string
 }
 
 // Name is a human readable name for Docker
@@ -349,6 +353,35 @@ func (r *Docker) RemoveImage(name string) error {
 	return nil
 }
 
+// PruneImages removes the images that no container uses, returning the removed images
+func (r *Docker) PruneImages(o PruneImagesOptions) ([]ListImage, error) {
+	imgs, err := r.ListImages(ListImagesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// docker knows about the containers that were not created by kubernetes too, unlike cri-dockerd
+	rr, err := r.Runner.RunCmd(exec.Command("docker", "ps", "-a", "-q", "--no-trunc"))
+	if err != nil {
+		return nil, fmt.Errorf("docker ps: %w", err)
+	}
+	var inUse []string
+	if ids := strings.Fields(rr.Stdout.String()); len(ids) > 0 {
+		rr, err = r.Runner.RunCmd(exec.Command("docker", append([]string{"inspect", "--format", "{{.Image}} {{.Config.Image}}"}, ids...)...))
+		if err != nil {
+			return nil, fmt.Errorf("docker inspect: %w", err)
+		}
+		inUse = strings.Fields(rr.Stdout.String())
+	}
+	return pruneImages(imgs, inUse, o, func(img ListImage) error {
+		// docker lists an image once per tag, so remove the tag rather than every tag of the image
+		name := img.ID
+		if !r.UseCRI && !dangling(img) {
+			name = img.RepoTags[0]
+		}
+		return r.RemoveImage(name)
+	})
+}
+
 // TagImage tags an image in this runtime
 func (r *Docker) TagImage(source string, target string) error {
 	klog.Infof("Tagging image %s: %s", source, target)
@@ -527,6 +560,45 @@ func (r *Docker) UnpauseContainers(ids []string) error {
 	return nil
 }
 
+// ContainerStats returns a resource usage sample for containers based on ID
+func (r *Docker) ContainerStats(ids []string) ([]ContainerStats, error) {
+	if r.UseCRI {
+		return criContainerStats(r.Runner, ids)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := append([]string{"stats", "--no-stream", "--format", "{{.ID}} {{.CPUPerc}}"}, ids...)
+	rr, err := r.Runner.RunCmd(exec.Command("docker", args...))
+	if err != nil {
+		return nil, fmt.Errorf("docker: %w", err)
+	}
+	return parseDockerStats(rr.Stdout.String())
+}
+
+// parseDockerStats parses the output of 'docker stats --format "{{.ID}} {{.CPUPerc}}"'
+func parseDockerStats(s string) ([]ContainerStats, error) {
+	var stats []ContainerStats
+	for line := range strings.Lines(s) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		// CPUPerc is "--" while the container is not running
+		cpu := strings.TrimSuffix(fields[1], "%")
+		if cpu == "--" {
+			stats = append(stats, ContainerStats{ID: fields[0]})
+			continue
+		}
+		p, err := strconv.ParseFloat(cpu, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse cpu usage of %s: %w", fields[0], err)
+		}
+		stats = append(stats, ContainerStats{ID: fields[0], CPUPercent: p})
+	}
+	return stats, nil
+}
+
 // ContainerLogCmd returns the command to retrieve the log for a container based on ID
 func (r *Docker) ContainerLogCmd(id string, length int, follow bool) string {
 	if r.UseCRI {
@@ -551,12 +623,13 @@ func (r *Docker) SystemLogCmd(length int) string {
 }
 
 type dockerDaemonConfig struct {
-	ExecOpts       []string              `json:"exec-opts"`
-	LogDriver      string                `json:"log-driver"`
-	LogOpts        dockerDaemonLogOpts   `json:"log-opts"`
-	StorageDriver  string                `json:"storage-driver"`
-	DefaultRuntime string                `json:"default-runtime,omitempty"`
-	Runtimes       *dockerDaemonRuntimes `json:"runtimes,omitempty"`
+	ExecOpts        []string              `json:"exec-opts"`
+	LogDriver       string                `json:"log-driver"`
+	LogOpts         dockerDaemonLogOpts   `json:"log-opts"`
+	StorageDriver   string                `json:"storage-driver"`
+	DefaultRuntime  string                `json:"default-runtime,omitempty"`
+	Runtimes        *dockerDaemonRuntimes `json:"runtimes,omitempty"`
+	RegistryMirrors []string              `json:"registry-mirrors,omitempty"`
 }
 type dockerDaemonLogOpts struct {
 	MaxSize string `json:"max-size"`
@@ -596,10 +669,21 @@ func (r *Docker) configureDocker(driver string) error {
 		assets.Addons["amd-gpu-device-plugin"].EnableByDefault()
 	}
 
+	// docker only mirrors docker.io, the caches of the other registries are not started for it
+	if i := slices.IndexFunc(r.Mirrors, func(m Mirror) bool { return m.Registry == "docker.io" }); i >= 0 {
+		daemonConfig.RegistryMirrors = []string{r.Mirrors[i].URL}
+	}
+
 	daemonConfigBytes, err := json.Marshal(daemonConfig)
 	if err != nil {
 		return err
 	}
+	if r.ConfigPatch != "" {
+		klog.Infof("merging the configuration patch into the docker daemon config...")
+		if daemonConfigBytes, err = patchJSON(daemonConfigBytes, []byte(r.ConfigPatch)); err != nil {
+			return fmt.Errorf("patching daemon.json: %w", err)
+		}
+	}
 	ma := assets.NewMemoryAsset(daemonConfigBytes, "/etc/docker", "daemon.json", "0644")
 	return r.Runner.Copy(ma)
 }
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cruntime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pelletier/go-toml/v2"
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// ValidateConfigPatch returns an error if patch is not a configuration fragment of the runtime:
+// TOML tables for containerd and cri-o, a JSON object for docker
+func ValidateConfigPatch(runtime string, patch []byte) error {
+	switch runtime {
+	case constants.Containerd, constants.CRIO, "cri-o":
+		var m map[string]any
+		if err := toml.Unmarshal(patch, &m); err != nil {
+			return fmt.Errorf("the configuration patch of %s is not valid TOML: %w", runtime, err)
+		}
+	case "", constants.Docker:
+		var m map[string]any
+		if err := json.Unmarshal(patch, &m); err != nil {
+			return fmt.Errorf("the configuration patch of docker is not a valid JSON object: %w", err)
+		}
+	default:
+		return fmt.Errorf("the %s runtime does not support configuration patches", runtime)
+	}
+	return nil
+}
+
+// patchTOML returns the TOML document doc with the tables of patch merged in
+func patchTOML(doc, patch []byte) ([]byte, error) {
+	var base, p map[string]any
+	if err := toml.Unmarshal(doc, &base); err != nil {
+		return nil, fmt.Errorf("parsing configuration: %w", err)
+	}
+	if err := toml.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("parsing configuration patch: %w", err)
+	}
+	return toml.Marshal(mergeConfig(base, p))
+}
+
+// patchJSON returns the JSON object doc with the objects of patch merged in
+func patchJSON(doc, patch []byte) ([]byte, error) {
+	var base, p map[string]any
+	if err := json.Unmarshal(doc, &base); err != nil {
+		return nil, fmt.Errorf("parsing configuration: %w", err)
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("parsing configuration patch: %w", err)
+	}
+	return json.Marshal(mergeConfig(base, p))
+}
+
+// mergeConfig merges patch into base: tables present in both are merged recursively, while
+// the other values of patch, including arrays, replace the ones of base
+func mergeConfig(base, patch map[string]any) map[string]any {
+	if base == nil {
+		base = map[string]any{}
+	}
+	for k, v := range patch {
+		pm, ok := v.(map[string]any)
+		if bm, isMap := base[k].(map[string]any); ok && isMap {
+			base[k] = mergeConfig(bm, pm)
+			continue
+		}
+		base[k] = v
+	}
+	return base
+}
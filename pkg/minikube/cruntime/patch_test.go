@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cruntime
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateConfigPatch(t *testing.T) {
+	var tests = []struct {
+		runtime string
+		patch   string
+		ok      bool
+	}{
+		{runtime: "containerd", patch: "[plugins.\"io.containerd.grpc.v1.cri\".containerd]\nsnapshotter = \"native\"\n", ok: true},
+		{runtime: "crio", patch: "[crio.runtime]\nlog_level = \"debug\"\n", ok: true},
+		{runtime: "cri-o", patch: "[crio.runtime\n", ok: false},
+		{runtime: "docker", patch: `{"log-level": "debug"}`, ok: true},
+		{runtime: "docker", patch: `["debug"]`, ok: false},
+		{runtime: "docker", patch: "log-level = \"debug\"", ok: false},
+		{runtime: "rkt", patch: "{}", ok: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.runtime+"/"+tc.patch, func(t *testing.T) {
+			err := ValidateConfigPatch(tc.runtime, []byte(tc.patch))
+			if (err == nil) != tc.ok {
+				t.Errorf("ValidateConfigPatch(%q, %q) = %v, want ok: %v", tc.runtime, tc.patch, err, tc.ok)
+			}
+		})
+	}
+}
+
+func TestPatchTOML(t *testing.T) {
+	doc := `version = 2
+
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.k8s.io/pause:3.10"
+
+  [plugins."io.containerd.grpc.v1.cri".containerd]
+    snapshotter = "overlayfs"
+    discard_unpacked_layers = true
+`
+	patch := `[plugins."io.containerd.grpc.v1.cri".containerd]
+snapshotter = "stargz"
+
+[debug]
+level = "debug"
+`
+	got, err := patchTOML([]byte(doc), []byte(patch))
+	if err != nil {
+		t.Fatalf("patchTOML: %v", err)
+	}
+	want := `version = 2
+
+[debug]
+level = 'debug'
+
+[plugins]
+[plugins.'io.containerd.grpc.v1.cri']
+sandbox_image = 'registry.k8s.io/pause:3.10'
+
+[plugins.'io.containerd.grpc.v1.cri'.containerd]
+discard_unpacked_layers = true
+snapshotter = 'stargz'
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("patchTOML mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPatchJSON(t *testing.T) {
+	doc := `{"exec-opts":["native.cgroupdriver=systemd"],"log-driver":"json-file","log-opts":{"max-size":"100m"}}`
+	patch := `{"log-opts":{"max-file":"3"},"exec-opts":["native.cgroupdriver=cgroupfs"],"debug":true}`
+	got, err := patchJSON([]byte(doc), []byte(patch))
+	if err != nil {
+		t.Fatalf("patchJSON: %v", err)
+	}
+	want := `{"debug":true,"exec-opts":["native.cgroupdriver=cgroupfs"],"log-driver":"json-file","log-opts":{"max-file":"3","max-size":"100m"}}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("patchJSON mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateCRIOPatchConfig(t *testing.T) {
+	runner := &copyRunner{FakeRunner: NewFakeRunner(t), files: map[string]string{}}
+	patch := "[crio.runtime]\nlog_level = \"debug\"\n"
+	if err := generateCRIOPatchConfig(runner, patch); err != nil {
+		t.Fatalf("generateCRIOPatchConfig: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{crioPatchFile: patch}, runner.files); diff != "" {
+		t.Errorf("drop-in mismatch (-want +got):\n%s", diff)
+	}
+}
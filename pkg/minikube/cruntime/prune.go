@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cruntime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// PruneImagesOptions are the options to use for pruning images
+type PruneImagesOptions struct {
+	// All prunes every image that no container uses, not only the dangling ones
+	All bool
+	// Keep are the images never to prune, even if no container uses them
+	Keep []string
+	// DryRun returns the images that would be pruned, without removing them
+	DryRun bool
+}
+
+// crictlContainers maps to 'crictl ps -a -o json'
+type crictlContainers struct {
+	Containers []struct {
+		ImageRef string `json:"imageRef"`
+		Image    struct {
+			Image string `json:"image"`
+		} `json:"image"`
+	} `json:"containers"`
+}
+
+// imageKey normalizes the references to an image, so that they can be compared
+func imageKey(ref string) string {
+	ref = strings.TrimPrefix(ref, "sha256:")
+	if strings.Contains(ref, "/") || strings.Contains(ref, ":") {
+		return AddDockerIO(ref)
+	}
+	return ref
+}
+
+// withoutTag returns the repository of an image reference, without its tag
+func withoutTag(ref string) string {
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i]
+	}
+	return ref
+}
+
+// dangling returns whether the image has no tag left
+func dangling(img ListImage) bool {
+	for _, tag := range img.RepoTags {
+		if tag != "" && !strings.Contains(tag, "<none>") {
+			return false
+		}
+	}
+	return true
+}
+
+// prunable returns the images to prune out of the images of the runtime, given the references of the images that the containers use
+func prunable(imgs []ListImage, inUse []string, o PruneImagesOptions) []ListImage {
+	keep := map[string]bool{}
+	for _, ref := range inUse {
+		keep[imageKey(ref)] = true
+	}
+	for _, ref := range o.Keep {
+		// an image pinned by digest, like the images of the addons, is listed by its tag and by its digest
+		if name, digest, ok := strings.Cut(ref, "@"); ok {
+			keep[imageKey(name)] = true
+			keep[imageKey(withoutTag(name)+"@"+digest)] = true
+			continue
+		}
+		keep[imageKey(ref)] = true
+	}
+	var pruned []ListImage
+	for _, img := range imgs {
+		refs := append(append([]string{img.ID}, img.RepoTags...), img.RepoDigests...)
+		used := false
+		for _, ref := range refs {
+			if keep[imageKey(ref)] {
+				used = true
+				break
+			}
+		}
+		if used || (!o.All && !dangling(img)) {
+			continue
+		}
+		pruned = append(pruned, img)
+	}
+	return pruned
+}
+
+// pruneImages removes the images that are prunable, returning the ones that were removed
+func pruneImages(imgs []ListImage, inUse []string, o PruneImagesOptions, remove func(ListImage) error) ([]ListImage, error) {
+	candidates := prunable(imgs, inUse, o)
+	if o.DryRun {
+		return candidates, nil
+	}
+	var pruned []ListImage
+	var errs []error
+	for _, img := range candidates {
+		klog.Infof("pruning image %s %v", img.ID, img.RepoTags)
+		if err := remove(img); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		pruned = append(pruned, img)
+	}
+	return pruned, errors.Join(errs...)
+}
+
+// criImagesInUse returns the references of the images that the CRI containers use
+func criImagesInUse(cr CommandRunner) ([]string, error) {
+	rr, err := cr.RunCmd(exec.Command("sudo", getCrictlPath(cr), timeoutOverrideFlag, "ps", "-a", "-o", "json"))
+	if err != nil {
+		return nil, fmt.Errorf("crictl ps: %w", err)
+	}
+	var cs crictlContainers
+	if err := json.Unmarshal(rr.Stdout.Bytes(), &cs); err != nil {
+		return nil, fmt.Errorf("parsing crictl ps: %w", err)
+	}
+	var refs []string
+	for _, c := range cs.Containers {
+		refs = append(refs, c.ImageRef, c.Image.Image)
+	}
+	return refs, nil
+}
+
+// pruneCRIImages prunes the images of a CRI runtime
+func pruneCRIImages(cr CommandRunner, o PruneImagesOptions) ([]ListImage, error) {
+	imgs, err := listCRIImages(cr)
+	if err != nil {
+		return nil, err
+	}
+	inUse, err := criImagesInUse(cr)
+	if err != nil {
+		return nil, err
+	}
+	return pruneImages(imgs, inUse, o, func(img ListImage) error {
+		return removeCRIImage(cr, img.ID, false)
+	})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cruntime
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrunable(t *testing.T) {
+	imgs := []ListImage{
+		{ID: "sha256:aaa", RepoTags: []string{"registry.k8s.io/pause:3.10"}},
+		{ID: "sha256:bbb", RepoTags: []string{"docker.io/library/nginx:latest"}},
+		{ID: "sha256:ccc"},
+		{ID: "ddd", RepoTags: []string{"docker.io/library/<none>:<none>"}},
+		{ID: "sha256:eee", RepoTags: []string{"docker.io/library/busybox:1.37"}},
+		{ID: "sha256:fff", RepoDigests: []string{"docker.io/library/redis@sha256:123"}},
+		{ID: "sha256:ggg", RepoTags: []string{"docker.io/library/registry:3.1.1"}},
+		{ID: "sha256:hhh", RepoTags: []string{"docker.io/kicbase/echo-server:latest"}, RepoDigests: []string{"docker.io/kicbase/echo-server@sha256:456"}},
+	}
+	inUse := []string{"sha256:bbb", "docker.io/library/redis@sha256:123"}
+
+	var tests = []struct {
+		description string
+		opts        PruneImagesOptions
+		want        []string
+	}{
+		{"dangling", PruneImagesOptions{}, []string{"sha256:ccc", "ddd"}},
+		{"all", PruneImagesOptions{All: true}, []string{"sha256:aaa", "sha256:ccc", "ddd", "sha256:eee", "sha256:ggg", "sha256:hhh"}},
+		{"keep", PruneImagesOptions{All: true, Keep: []string{"registry.k8s.io/pause:3.10", "busybox:1.37"}}, []string{"sha256:ccc", "ddd", "sha256:ggg", "sha256:hhh"}},
+		// images pinned by digest are kept whether the runtime lists them by tag or by digest
+		{"keep pinned", PruneImagesOptions{All: true, Keep: []string{"registry:3.1.1@sha256:789", "docker.io/kicbase/echo-server:1.0@sha256:456"}}, []string{"sha256:aaa", "sha256:ccc", "ddd", "sha256:eee"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var got []string
+			for _, img := range prunable(imgs, inUse, tc.opts) {
+				got = append(got, img.ID)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("prunable mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package filesync keeps directories of the nodes in sync with a directory of the host, one-way, transferring only
+// the files whose content differs, and pushing the changes of the host directory as they happen.
+package filesync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/archive"
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+// chunkSize is the number of paths passed to a single command on the node, to stay below the argument length limit
+const chunkSize = 1000
+
+// the prefixes of the hashes of the entries that are not regular files, and of the modes of regular files
+const (
+	dirHash     = "dir"
+	symlinkHash = "link:"
+	modePrefix  = "mode:"
+)
+
+// listScript describes every entry of the directory $0: the sha256 and the mode of regular files, and the type of the others
+const listScript = `cd "$0" 2>/dev/null || exit 0
+find . -mindepth 1 \( -type d -printf 'dir  %p\n' \) -o \( -type l -printf 'link:%l  %p\n' \) -o \( -type f -printf 'mode:%m  %p\n' \)
+find . -type f -exec sha256sum {} +`
+
+// Target is a node directory kept in sync with a host directory
+type Target struct {
+	// Node is the name of the node
+	Node string
+	// Runner runs commands on the node
+	Runner command.Runner
+	// Dir is the absolute path of the directory on the node
+	Dir string
+}
+
+// Options are the options of a sync
+type Options struct {
+	// Ignore are extra patterns of the paths to leave out, in the syntax of .gitignore files
+	Ignore []string
+	// Delete removes the files of the destination that do not exist in the source
+	Delete bool
+}
+
+// Result is the outcome of a sync to a target
+type Result struct {
+	Node    string
+	Copied  int
+	Deleted int
+	Err     error
+}
+
+// Syncer syncs a host directory to the targets
+type Syncer struct {
+	root    string
+	targets []Target
+	opts    Options
+	ignore  *Ignorer
+	// synced are the hashes of the host entries as they were last pushed to each target, by slash separated relative path
+	synced []map[string]string
+	// dirty are the host paths changed since they were last pushed to each target, nil until the target was synced fully
+	dirty []map[string]bool
+}
+
+// NewSyncer returns a Syncer of the host directory root to the targets
+func NewSyncer(root string, targets []Target, o Options) (*Syncer, error) {
+	fi, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+	ig, err := NewIgnorer(root, o.Ignore)
+	if err != nil {
+		return nil, fmt.Errorf("reading ignore patterns: %w", err)
+	}
+	return &Syncer{root: root, targets: targets, opts: o, ignore: ig}, nil
+}
+
+// Sync transfers the host files whose content differs on the targets, in a single stream per target
+func (s *Syncer) Sync() []Result {
+	s.synced = make([]map[string]string, len(s.targets))
+	s.dirty = make([]map[string]bool, len(s.targets))
+	hashes, err := hostHashes(s.root, s.root, s.ignore)
+	if err != nil {
+		return s.failed(fmt.Errorf("listing %s: %w", s.root, err))
+	}
+
+	var all []int
+	for i := range s.targets {
+		all = append(all, i)
+	}
+	return s.each(all, func(i int) Result {
+		return s.syncTarget(i, hashes)
+	})
+}
+
+// syncTarget transfers the host entries of hashes whose content differs on the target i, and records them as synced
+func (s *Syncer) syncTarget(i int, hashes map[string]string) Result {
+	t := s.targets[i]
+	r := Result{Node: t.Node}
+	remote, err := nodeHashes(t)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	copies, deletes := delta(hashes, remote, s.ignore, s.opts.Delete)
+	if r.Err = push(t, s.root, copies, deletes); r.Err != nil {
+		return r
+	}
+	s.synced[i] = maps.Clone(hashes)
+	s.dirty[i] = map[string]bool{}
+	r.Copied, r.Deleted = len(copies), len(deletes)
+	return r
+}
+
+// failed returns a failed result of err for every target
+func (s *Syncer) failed(err error) []Result {
+	var rs []Result
+	for _, t := range s.targets {
+		rs = append(rs, Result{Node: t.Node, Err: err})
+	}
+	return rs
+}
+
+// each runs fn for the given targets concurrently, returning their results in the same order
+func (s *Syncer) each(targets []int, fn func(int) Result) []Result {
+	rs := make([]Result, len(targets))
+	var wg sync.WaitGroup
+	for j, i := range targets {
+		wg.Add(1)
+		go func(j, i int) {
+			defer wg.Done()
+			rs[j] = fn(i)
+		}(j, i)
+	}
+	wg.Wait()
+	return rs
+}
+
+// Pull transfers the files of the target directory whose content differs in the host directory root, in a single stream
+func Pull(t Target, root string, o Options) Result {
+	r := Result{Node: t.Node}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		r.Err = err
+		return r
+	}
+	ig, err := NewIgnorer(root, o.Ignore)
+	if err != nil {
+		r.Err = fmt.Errorf("reading ignore patterns: %w", err)
+		return r
+	}
+	local, err := hostHashes(root, root, ig)
+	if err != nil {
+		r.Err = fmt.Errorf("listing %s: %w", root, err)
+		return r
+	}
+	remote, err := nodeHashes(t)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	copies, deletes := delta(remote, local, ig, o.Delete)
+
+	for _, names := range chunks(copies) {
+		err := archive.Pipe(func(w io.Writer) error {
+			cmd := archive.PackDirCmd(t.Dir, names...)
+			cmd.Stdout = w
+			return command.Stream(t.Runner, cmd)
+		}, func(r io.Reader) error {
+			return archive.Extract(r, root)
+		})
+		if err != nil {
+			r.Err = fmt.Errorf("copying from %s: %w", t.Node, err)
+			return r
+		}
+	}
+	for _, name := range deletes {
+		if err := os.RemoveAll(filepath.Join(root, filepath.FromSlash(name))); err != nil {
+			r.Err = err
+			return r
+		}
+	}
+	r.Copied, r.Deleted = len(copies), len(deletes)
+	return r
+}
+
+// delta returns the entries of src to copy because they differ in dst, and, when del is set,
+// the entries of dst to delete because they do not exist in src, leaving ignored entries alone
+func delta(src, dst map[string]string, ig *Ignorer, del bool) ([]string, []string) {
+	var copies, deletes []string
+	for name, h := range src {
+		if dst[name] != h {
+			copies = append(copies, name)
+		}
+	}
+	if del {
+		for name, h := range dst {
+			if _, ok := src[name]; !ok && !ig.Ignored(name, h == dirHash) {
+				deletes = append(deletes, name)
+			}
+		}
+	}
+	sort.Strings(copies)
+	return copies, topLevel(deletes)
+}
+
+// topLevel returns the sorted paths without the ones inside another of the paths
+func topLevel(paths []string) []string {
+	sort.Strings(paths)
+	var top []string
+	for _, p := range paths {
+		if len(top) > 0 && strings.HasPrefix(p, top[len(top)-1]+"/") {
+			continue
+		}
+		top = append(top, p)
+	}
+	return top
+}
+
+// push copies the named host entries of root into the target directory and removes the deleted ones from it
+func push(t Target, root string, copies, deletes []string) error {
+	for _, names := range chunks(deletes) {
+		args := []string{"rm", "-rf", "--"}
+		for _, n := range names {
+			args = append(args, path.Join(t.Dir, n))
+		}
+		if _, err := t.Runner.RunCmd(exec.Command("sudo", args...)); err != nil {
+			return fmt.Errorf("deleting from %s: %w", t.Node, err)
+		}
+	}
+	if len(copies) == 0 {
+		return nil
+	}
+	err := archive.Pipe(func(w io.Writer) error {
+		return archive.PackFiles(w, root, copies...)
+	}, func(r io.Reader) error {
+		cmd := archive.ExtractCmd(t.Dir)
+		cmd.Stdin = r
+		return command.Stream(t.Runner, cmd)
+	})
+	if err != nil {
+		return fmt.Errorf("copying to %s: %w", t.Node, err)
+	}
+	return nil
+}
+
+// chunks splits the names in groups of at most chunkSize
+func chunks(names []string) [][]string {
+	var cs [][]string
+	for len(names) > chunkSize {
+		cs = append(cs, names[:chunkSize])
+		names = names[chunkSize:]
+	}
+	if len(names) > 0 {
+		cs = append(cs, names)
+	}
+	return cs
+}
+
+// hostHashes returns the hash of every entry of the host directory start, itself inside of root, that is not ignored.
+// The entries are named after their slash separated path relative to root.
+func hostHashes(root, start string, ig *Ignorer) (map[string]string, error) {
+	hashes := map[string]string{}
+	err := filepath.Walk(start, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if ig.Ignored(rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		h, err := hostHash(p, info)
+		if err != nil {
+			return err
+		}
+		if h != "" {
+			hashes[rel] = h
+		}
+		return nil
+	})
+	return hashes, err
+}
+
+// hostHash returns the hash of the host entry p, or "" if it can not be synced.
+// The hash of regular files covers their content and their mode.
+func hostHash(p string, info os.FileInfo) (string, error) {
+	switch {
+	case info.IsDir():
+		return dirHash, nil
+	case info.Mode()&os.ModeSymlink != 0:
+		l, err := os.Readlink(p)
+		if err != nil {
+			return "", err
+		}
+		return symlinkHash + l, nil
+	case info.Mode().IsRegular():
+		f, err := os.Open(p)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return "", err
+		}
+		return fileHash(hex.EncodeToString(h.Sum(nil)), info.Mode().Perm()), nil
+	default:
+		klog.Warningf("skipping %s: unsupported file type %s", p, info.Mode().Type())
+		return "", nil
+	}
+}
+
+// nodeHashes returns the hash of every entry of the target directory
+func nodeHashes(t Target) (map[string]string, error) {
+	rr, err := t.Runner.RunCmd(exec.Command("sudo", "sh", "-c", listScript, t.Dir))
+	if err != nil {
+		return nil, fmt.Errorf("listing %s on %s: %w", t.Dir, t.Node, err)
+	}
+	return parseHashes(rr.Stdout.String())
+}
+
+// fileHash returns the hash of a regular file of the given sha256 and mode
+func fileHash(sha string, mode os.FileMode) string {
+	// the modes of the files of windows hosts do not map to the ones of the nodes
+	if runtime.GOOS == "windows" {
+		return sha
+	}
+	return fmt.Sprintf("%s:%o", sha, mode)
+}
+
+// parseHashes parses the output of listScript
+func parseHashes(s string) (map[string]string, error) {
+	hashes := map[string]string{}
+	modes := map[string]os.FileMode{}
+	for _, line := range strings.Split(s, "\n") {
+		// sha256sum escapes the names holding a backslash or a newline: they are transferred every time
+		if line == "" || strings.HasPrefix(line, `\`) {
+			continue
+		}
+		h, name, ok := strings.Cut(line, "  ./")
+		if !ok {
+			return nil, errors.New("unexpected listing line: " + line)
+		}
+		if m, ok := strings.CutPrefix(h, modePrefix); ok {
+			mode, err := strconv.ParseUint(m, 8, 32)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected mode in listing line %q: %w", line, err)
+			}
+			modes[name] = os.FileMode(mode).Perm()
+			continue
+		}
+		hashes[name] = h
+	}
+	for name, mode := range modes {
+		if h, ok := hashes[name]; ok {
+			hashes[name] = fileHash(h, mode)
+		}
+	}
+	return hashes, nil
+}
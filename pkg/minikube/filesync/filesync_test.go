@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesync
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/archive"
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+func cmdKey(cmd *exec.Cmd) string {
+	return (&command.RunResult{Args: cmd.Args}).Command()
+}
+
+func sha(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// archiveNames returns the names of the entries of the archive streamed to the extract command of dir
+func archiveNames(t *testing.T, f *command.FakeCommandRunner, dir string) []string {
+	t.Helper()
+	stream, err := f.GetFileToContents(cmdKey(archive.ExtractCmd(dir)))
+	if err != nil {
+		t.Fatalf("nothing was streamed to the node: %v", err)
+	}
+	var names []string
+	tr := tar.NewReader(bytes.NewBufferString(stream))
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, strings.TrimSuffix(h.Name, "/"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestParseHashes(t *testing.T) {
+	listing := "dir  ./a\nlink:../b  ./a/l\nmode:644  ./a/f\n" + sha("f") + "  ./a/f\n\\" + sha("x") + "  ./a/x\\\\y\n"
+	got, err := parseHashes(listing)
+	if err != nil {
+		t.Fatalf("parseHashes: %v", err)
+	}
+	want := map[string]string{"a": dirHash, "a/l": "link:../b", "a/f": fileHash(sha("f"), 0o644)}
+	if len(got) != len(want) {
+		t.Fatalf("parseHashes = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("hash of %s = %q, want %q", k, got[k], v)
+		}
+	}
+
+	if _, err := parseHashes("garbage\n"); err == nil {
+		t.Errorf("parseHashes of garbage succeeded, want an error")
+	}
+}
+
+func TestDelta(t *testing.T) {
+	ig := &Ignorer{}
+	ig.add("", "*.tmp")
+	src := map[string]string{"a": dirHash, "a/same": "1", "a/changed": "2", "new": "3"}
+	dst := map[string]string{"a": dirHash, "a/same": "1", "a/changed": "0", "old": dirHash, "old/f": "4", "x.tmp": "5"}
+
+	copies, deletes := delta(src, dst, ig, false)
+	if got, want := strings.Join(copies, ","), "a/changed,new"; got != want {
+		t.Errorf("copies = %s, want %s", got, want)
+	}
+	if len(deletes) != 0 {
+		t.Errorf("deletes = %v without delete, want none", deletes)
+	}
+	_, deletes = delta(src, dst, ig, true)
+	if got, want := strings.Join(deletes, ","), "old"; got != want {
+		t.Errorf("deletes = %s, want %s", got, want)
+	}
+}
+
+func TestSync(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range map[string]string{"same": "same", "changed": "new", "sub/added": "added", "debug.log": "log"} {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f := command.NewFakeCommandRunner()
+	list := exec.Command("sudo", "sh", "-c", listScript, "/app")
+	f.SetCommandToOutput(map[string]string{
+		cmdKey(list): "dir  ./gone\nmode:644  ./same\nmode:644  ./changed\n" + sha("same") + "  ./same\n" + sha("old") + "  ./changed\n",
+		cmdKey(exec.Command("sudo", "rm", "-rf", "--", "/app/gone")): "",
+		cmdKey(archive.ExtractCmd("/app")):                           "",
+	})
+
+	s, err := NewSyncer(root, []Target{{Node: "m01", Runner: f, Dir: "/app"}}, Options{Ignore: []string{"*.log"}, Delete: true})
+	if err != nil {
+		t.Fatalf("NewSyncer: %v", err)
+	}
+	rs := s.Sync()
+	if len(rs) != 1 || rs[0].Err != nil {
+		t.Fatalf("Sync = %+v", rs)
+	}
+	if rs[0].Copied != 3 || rs[0].Deleted != 1 {
+		t.Errorf("Sync copied %d and deleted %d, want 3 and 1", rs[0].Copied, rs[0].Deleted)
+	}
+	if got, want := strings.Join(archiveNames(t, f, "/app"), ","), "changed,sub,sub/added"; got != want {
+		t.Errorf("streamed %s, want %s", got, want)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "gone"), []byte("gone"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := command.NewFakeCommandRunner()
+	f.SetCommandToOutput(map[string]string{
+		cmdKey(exec.Command("sudo", "sh", "-c", listScript, "/app")): "mode:644  ./gone\n" + sha("gone") + "  ./gone\n",
+		cmdKey(exec.Command("sudo", "rm", "-rf", "--", "/app/gone")): "",
+		cmdKey(archive.ExtractCmd("/app")):                           "",
+	})
+	s, err := NewSyncer(root, []Target{{Node: "m01", Runner: f, Dir: "/app"}}, Options{})
+	if err != nil {
+		t.Fatalf("NewSyncer: %v", err)
+	}
+	if rs := s.Sync(); rs[0].Err != nil || rs[0].Copied != 0 {
+		t.Fatalf("Sync = %+v, want nothing copied", rs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results := make(chan []Result, 10)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.Watch(ctx, func(rs []Result) { results <- rs })
+	}()
+	// give the watcher the time to be set up
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.Remove(filepath.Join(root, "gone")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "dir", "new"), []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	copied, deleted := 0, 0
+	timeout := time.After(10 * time.Second)
+	for copied < 2 || deleted < 1 {
+		select {
+		case rs := <-results:
+			if rs[0].Err != nil {
+				t.Fatalf("push failed: %v", rs[0].Err)
+			}
+			copied += rs[0].Copied
+			deleted += rs[0].Deleted
+		case <-timeout:
+			t.Fatalf("changes were not pushed: %d copied and %d deleted, want 2 and 1", copied, deleted)
+		}
+	}
+	cancel()
+	if err := <-errc; err != nil {
+		t.Errorf("Watch: %v", err)
+	}
+}
+
+func TestFlushRetries(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "a"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	list := cmdKey(exec.Command("sudo", "sh", "-c", listScript, "/app"))
+	listing := "mode:644  ./a\n" + sha("a") + "  ./a\n"
+	good, bad := command.NewFakeCommandRunner(), command.NewFakeCommandRunner()
+	good.SetCommandToOutput(map[string]string{list: listing, cmdKey(archive.ExtractCmd("/app")): ""})
+	bad.SetCommandToOutput(map[string]string{list: listing})
+	s, err := NewSyncer(root, []Target{{Node: "m01", Runner: good, Dir: "/app"}, {Node: "m02", Runner: bad, Dir: "/app"}}, Options{})
+	if err != nil {
+		t.Fatalf("NewSyncer: %v", err)
+	}
+	if rs := s.Sync(); rs[0].Err != nil || rs[1].Err != nil {
+		t.Fatalf("Sync = %+v", rs)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "b"), []byte("b"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s.mark("b")
+	rs := s.flush()
+	if len(rs) != 2 || rs[0].Err != nil || rs[0].Copied != 1 || rs[1].Err == nil {
+		t.Fatalf("flush = %+v, want b copied to m01 and failing on m02", rs)
+	}
+	if !s.behind() {
+		t.Fatalf("behind = false after a failed push")
+	}
+
+	// only the target that failed is pushed to again
+	bad.SetCommandToOutput(map[string]string{cmdKey(archive.ExtractCmd("/app")): ""})
+	rs = s.flush()
+	if len(rs) != 1 || rs[0].Node != "m02" || rs[0].Err != nil || rs[0].Copied != 1 {
+		t.Fatalf("flush = %+v, want b copied to m02 only", rs)
+	}
+	if s.behind() {
+		t.Errorf("behind = true after all the pushes succeeded")
+	}
+	if rs := s.flush(); rs != nil {
+		t.Errorf("flush = %+v, want nothing pushed", rs)
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesync
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// gitignore is the name of the files holding ignore patterns in the synced tree
+const gitignore = ".gitignore"
+
+// rule is a single ignore pattern, in the syntax of .gitignore files
+type rule struct {
+	// base is the slash separated directory the pattern is relative to
+	base string
+	re   *regexp.Regexp
+	// negate re-includes what an earlier pattern excluded
+	negate bool
+	// dirOnly only matches directories
+	dirOnly bool
+	// anchored patterns match the path relative to base, the others match the name at any depth
+	anchored bool
+}
+
+// Ignorer decides which paths of the synced tree are left out, from the .gitignore files of the tree and extra patterns
+type Ignorer struct {
+	rules []rule
+}
+
+// NewIgnorer returns an Ignorer of the extra patterns, and of the .gitignore files found in the root directory.
+// The .git directory is always ignored.
+func NewIgnorer(root string, patterns []string) (*Ignorer, error) {
+	ig := &Ignorer{}
+	ig.add("", ".git/")
+	for _, p := range patterns {
+		ig.add("", p)
+	}
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if rel != "." && ig.Ignored(rel, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() != gitignore {
+			return nil
+		}
+		return ig.load(p, path.Dir(rel))
+	})
+	return ig, err
+}
+
+// load adds the patterns of the .gitignore file at p, relative to the slash separated directory base
+func (ig *Ignorer) load(p, base string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if base == "." {
+		base = ""
+	}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		ig.add(base, s.Text())
+	}
+	return s.Err()
+}
+
+// add adds a pattern relative to the slash separated directory base
+func (ig *Ignorer) add(base, pattern string) {
+	pattern = strings.TrimRight(pattern, " \t\r")
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return
+	}
+	r := rule{base: base}
+	if strings.HasPrefix(pattern, "!") {
+		r.negate = true
+		pattern = pattern[1:]
+	}
+	if strings.HasSuffix(pattern, "/") {
+		r.dirOnly = true
+		pattern = strings.TrimSuffix(pattern, "/")
+	}
+	if strings.Contains(pattern, "/") {
+		r.anchored = true
+		pattern = strings.TrimPrefix(pattern, "/")
+	}
+	re, err := regexp.Compile("^" + globRegexp(pattern) + "$")
+	if err != nil {
+		return
+	}
+	r.re = re
+	ig.rules = append(ig.rules, r)
+}
+
+// Ignored returns whether the slash separated path rel, relative to the root of the tree, is ignored,
+// either itself or because one of its parent directories is
+func (ig *Ignorer) Ignored(rel string, isDir bool) bool {
+	parts := strings.Split(rel, "/")
+	for i := 1; i < len(parts); i++ {
+		if ig.match(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return ig.match(rel, isDir)
+}
+
+// match returns whether the last pattern matching rel ignores it
+func (ig *Ignorer) match(rel string, isDir bool) bool {
+	ignored := false
+	for _, r := range ig.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		p := rel
+		if r.base != "" {
+			if !strings.HasPrefix(rel, r.base+"/") {
+				continue
+			}
+			p = strings.TrimPrefix(rel, r.base+"/")
+		}
+		if !r.anchored {
+			p = path.Base(p)
+		}
+		if r.re.MatchString(p) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+// globRegexp translates a .gitignore glob into a regular expression, where ** matches any number of directories
+func globRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**") && i+3 == len(glob):
+			sb.WriteString("(/.*)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
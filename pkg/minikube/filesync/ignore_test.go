@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIgnored(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "web", "static"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		gitignore:                       "# build output\n*.log\n!keep.log\n/dist\nnode_modules/\ndocs/**/*.tmp\n",
+		filepath.Join("web", gitignore): "static/*.map\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ig, err := NewIgnorer(root, []string{"*.swp"})
+	if err != nil {
+		t.Fatalf("NewIgnorer: %v", err)
+	}
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{".git", true, true},
+		{".git/config", false, true},
+		{"main.go", false, false},
+		{"app.log", false, true},
+		{"logs/app.log", false, true},
+		{"keep.log", false, false},
+		{"dist", true, true},
+		{"dist/app.js", false, true},
+		{"web/dist", true, false},
+		{"node_modules", true, true},
+		{"web/node_modules/x/index.js", false, true},
+		{"node_modules", false, false},
+		{"docs/a/b/c.tmp", false, true},
+		{"docs/c.tmp", false, true},
+		{"c.tmp", false, false},
+		{"web/static/app.js.map", false, true},
+		{"static/app.js.map", false, false},
+		{"main.go.swp", false, true},
+	}
+	for _, tc := range tests {
+		if got := ig.Ignored(tc.path, tc.isDir); got != tc.want {
+			t.Errorf("Ignored(%q, %v) = %v, want %v", tc.path, tc.isDir, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesync
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// debounce is how long the changes are collected before being pushed, so that the writes of a single save are pushed together
+	debounce = 100 * time.Millisecond
+	// maxDelay is how long the changes are held back at most while more keep coming, so that a continuous burst still gets pushed
+	maxDelay = 500 * time.Millisecond
+	// retryDelay is how long to wait before pushing again to the targets the last push failed to
+	retryDelay = 5 * time.Second
+)
+
+// Watch pushes the changes of the host directory to the targets as they happen, until ctx is done.
+// Sync must be called first. report is called with the results of every push.
+// The pushes that fail are retried until they succeed, along with the changes that happened since.
+func (s *Syncer) Watch(ctx context.Context, report func([]Result)) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating watcher: %w", err)
+	}
+	defer w.Close()
+	if err := s.watchDirs(w, s.root); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(retryDelay)
+	if !s.behind() {
+		timer.Stop()
+	}
+	// first is when the oldest of the changes that were not flushed yet happened
+	var first time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			rel, err := filepath.Rel(s.root, ev.Name)
+			if err != nil || rel == "." {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+			if path.Base(rel) == gitignore {
+				if ig, err := NewIgnorer(s.root, s.opts.Ignore); err != nil {
+					klog.Warningf("reading ignore patterns: %v", err)
+				} else {
+					s.ignore = ig
+				}
+			}
+			if ev.Has(fsnotify.Create) {
+				if fi, err := os.Lstat(ev.Name); err == nil && fi.IsDir() && !s.ignore.Ignored(rel, true) {
+					if err := s.watchDirs(w, ev.Name); err != nil {
+						klog.Warningf("watching %s: %v", ev.Name, err)
+					}
+				}
+			}
+			s.mark(rel)
+			if first.IsZero() {
+				first = time.Now()
+			}
+			timer.Reset(min(debounce, time.Until(first.Add(maxDelay))))
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			klog.Warningf("watching %s: %v", s.root, err)
+		case <-timer.C:
+			first = time.Time{}
+			if rs := s.flush(); rs != nil {
+				report(rs)
+			}
+			if s.behind() {
+				timer.Reset(retryDelay)
+			}
+		}
+	}
+}
+
+// watchDirs watches the directory dir and all its directories that are not ignored
+func (s *Syncer) watchDirs(w *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the directory may have been removed since
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		if rel != "." && s.ignore.Ignored(filepath.ToSlash(rel), true) {
+			return filepath.SkipDir
+		}
+		if err := w.Add(p); err != nil {
+			return fmt.Errorf("watching %s: %w", p, err)
+		}
+		return nil
+	})
+}
+
+// mark records the host path rel as changed for the targets that were synced fully
+func (s *Syncer) mark(rel string) {
+	for _, d := range s.dirty {
+		if d != nil {
+			d[rel] = true
+		}
+	}
+}
+
+// behind returns whether some target misses changes of the host directory
+func (s *Syncer) behind() bool {
+	for _, d := range s.dirty {
+		if len(d) > 0 || d == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// state is the state of a changed host path
+type state struct {
+	// gone is set when the path does not exist anymore
+	gone bool
+	// hashes are the hashes of the path and of its content that is not ignored
+	hashes map[string]string
+}
+
+// change is what is pushed to a target
+type change struct {
+	copies  []string
+	deletes []string
+	// hashes are the hashes of the copied entries
+	hashes map[string]string
+}
+
+// flush pushes the changes to the targets that miss them, syncing fully the ones that were never synced,
+// and returns nil if none of them needed to be pushed
+func (s *Syncer) flush() []Result {
+	var full, partial []int
+	changed := map[string]bool{}
+	for i, d := range s.dirty {
+		switch {
+		case d == nil:
+			full = append(full, i)
+		case len(d) > 0:
+			partial = append(partial, i)
+			for rel := range d {
+				changed[rel] = true
+			}
+		}
+	}
+
+	var hashes map[string]string
+	if len(full) > 0 {
+		var err error
+		if hashes, err = hostHashes(s.root, s.root, s.ignore); err != nil {
+			klog.Warningf("listing %s: %v", s.root, err)
+			full = nil
+		}
+	}
+	states := s.scan(changed)
+	changes := map[int]change{}
+	for _, i := range partial {
+		c := s.plan(i, states)
+		if len(c.copies) == 0 && len(c.deletes) == 0 {
+			s.dirty[i] = map[string]bool{}
+			continue
+		}
+		changes[i] = c
+	}
+
+	targets := full
+	for i := range changes {
+		targets = append(targets, i)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	sort.Ints(targets)
+	return s.each(targets, func(i int) Result {
+		if c, ok := changes[i]; ok {
+			return s.pushChange(i, c)
+		}
+		return s.syncTarget(i, hashes)
+	})
+}
+
+// scan returns the state of the changed host paths, leaving out the ones that can not be read
+func (s *Syncer) scan(changed map[string]bool) map[string]state {
+	states := map[string]state{}
+	for rel := range changed {
+		p := filepath.Join(s.root, filepath.FromSlash(rel))
+		info, err := os.Lstat(p)
+		if os.IsNotExist(err) {
+			states[rel] = state{gone: true}
+			continue
+		}
+		if err != nil {
+			klog.Warningf("skipping %s: %v", p, err)
+			continue
+		}
+		hashes := map[string]string{}
+		if s.ignore.Ignored(rel, info.IsDir()) {
+			states[rel] = state{hashes: hashes}
+			continue
+		}
+		if info.IsDir() {
+			// a new directory, or one moved into the tree: its files may never have been pushed
+			hashes, err = hostHashes(s.root, p, s.ignore)
+		} else {
+			var h string
+			h, err = hostHash(p, info)
+			if h != "" {
+				hashes[rel] = h
+			}
+		}
+		if err != nil {
+			klog.Warningf("skipping %s: %v", p, err)
+			continue
+		}
+		states[rel] = state{hashes: hashes}
+	}
+	return states
+}
+
+// plan returns the change of the paths the target i misses
+func (s *Syncer) plan(i int, states map[string]state) change {
+	c := change{hashes: map[string]string{}}
+	for rel := range s.dirty[i] {
+		st, ok := states[rel]
+		if !ok {
+			continue
+		}
+		if st.gone {
+			if s.known(i, rel) {
+				c.deletes = append(c.deletes, rel)
+			}
+			continue
+		}
+		for name, h := range st.hashes {
+			if s.synced[i][name] != h {
+				c.hashes[name] = h
+			}
+		}
+	}
+	c.copies = slices.Sorted(maps.Keys(c.hashes))
+	c.deletes = topLevel(c.deletes)
+	return c
+}
+
+// pushChange pushes c to the target i, and records it as synced once it succeeded
+func (s *Syncer) pushChange(i int, c change) Result {
+	t := s.targets[i]
+	r := Result{Node: t.Node, Err: push(t, s.root, c.copies, c.deletes)}
+	if r.Err != nil {
+		// the paths stay dirty, to be pushed again
+		return r
+	}
+	for _, rel := range c.deletes {
+		s.forget(i, rel)
+	}
+	maps.Copy(s.synced[i], c.hashes)
+	s.dirty[i] = map[string]bool{}
+	r.Copied, r.Deleted = len(c.copies), len(c.deletes)
+	return r
+}
+
+// known returns whether rel or its content was synced to the target i
+func (s *Syncer) known(i int, rel string) bool {
+	for name := range s.synced[i] {
+		if name == rel || strings.HasPrefix(name, rel+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// forget removes rel and its content from the hashes synced to the target i
+func (s *Syncer) forget(i int, rel string) {
+	for name := range s.synced[i] {
+		if name == rel || strings.HasPrefix(name, rel+"/") {
+			delete(s.synced[i], name)
+		}
+	}
+}
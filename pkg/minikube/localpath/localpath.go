@@ -64,6 +64,16 @@ func Profile(name string) string {
 	return filepath.Join(MiniPath(), "profiles", name)
 }
 
+// Snapshots returns the path to the directory holding the snapshots of a profile
+func Snapshots(profile string) string {
+	return filepath.Join(MiniPath(), "snapshots", profile)
+}
+
+// Snapshot returns the path to a named snapshot of a profile
+func Snapshot(profile, name string) string {
+	return filepath.Join(Snapshots(profile), name)
+}
+
 // EventLog returns the path to a CloudEvents log
 // This log contains the transient state of minikube and the completed steps on start.
 func EventLog(name string) string {
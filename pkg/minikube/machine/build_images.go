@@ -141,7 +141,7 @@ func shouldSkipNode(n config.Node, cp config.Node, machineName string, allNodes
 		return false
 	}
 	if nodeName == "" {
-		return n != cp
+		return n.Name != cp.Name
 	}
 	// nodeName can match either the user-friendly node name (n.Name) or the full VM machine name (machineName).
 	return nodeName != n.Name && nodeName != machineName
@@ -20,6 +20,7 @@ import (
 	"cmp"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
 	"path"
@@ -51,6 +52,9 @@ import (
 	"k8s.io/minikube/pkg/minikube/vmpath"
 )
 
+// cacheImageConfigKey is the config field name used to store which images "cache add" added
+const cacheImageConfigKey = "cache"
+
 // loadRoot is where images should be loaded from within the guest VM
 var loadRoot = path.Join(vmpath.GuestPersistentDir, "images")
 
@@ -661,6 +665,68 @@ func RemoveImages(images []string, profile *config.Profile, options *run.Command
 	return nil
 }
 
+// PruneImages removes the images that no container uses from the running nodes of the profile, or only from the named node if not blank.
+// The images of Kubernetes and of the enabled addons are kept, so that nodes don't pull them again. It returns the pruned images by machine name.
+func PruneImages(profile *config.Profile, nodeName string, o cruntime.PruneImagesOptions, options *run.CommandOptions) (map[string][]cruntime.ListImage, error) {
+	api, err := NewAPIClient(options)
+	if err != nil {
+		return nil, fmt.Errorf("error creating api client: %w", err)
+	}
+	defer api.Close()
+
+	c, err := config.Load(profile.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error loading config for profile :%v: %w", profile.Name, err)
+	}
+	keep, err := bootstrapper.GetCachedImageList(c.KubernetesConfig.ImageRepository, c.KubernetesConfig.KubernetesVersion)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes images: %w", err)
+	}
+	o.Keep = append(append(o.Keep, keep...), addonImages(c)...)
+	cached, err := cachedImages()
+	if err != nil {
+		return nil, fmt.Errorf("cached images: %w", err)
+	}
+	o.Keep = append(o.Keep, cached...)
+
+	pruned := map[string][]cruntime.ListImage{}
+	for _, n := range c.Nodes {
+		m := config.MachineName(*c, n)
+		if nodeName != "" && n.Name != nodeName && m != nodeName {
+			continue
+		}
+
+		status, err := Status(api, m)
+		if err != nil {
+			klog.Warningf("error getting status for %s: %v", m, err)
+			continue
+		}
+		if status != state.Running.String() {
+			out.WarningT("Skipping node {{.name}}, which is not running", out.V{"name": m})
+			continue
+		}
+		h, err := api.Load(m)
+		if err != nil {
+			klog.Warningf("Failed to load machine %q: %v", m, err)
+			continue
+		}
+		runner, err := CommandRunner(h)
+		if err != nil {
+			return pruned, err
+		}
+		cr, err := cruntime.New(cruntime.Config{Type: c.KubernetesConfig.ContainerRuntime, Runner: runner})
+		if err != nil {
+			return pruned, fmt.Errorf("error creating container runtime: %w", err)
+		}
+		imgs, err := cr.PruneImages(o)
+		pruned[m] = imgs
+		if err != nil {
+			return pruned, fmt.Errorf("pruning images of %s: %w", m, err)
+		}
+	}
+	return pruned, nil
+}
+
 // ListImages lists images on all nodes in profile
 func ListImages(profile *config.Profile, format string, options *run.CommandOptions) error {
 	api, err := NewAPIClient(options)
@@ -967,3 +1033,20 @@ func PushImages(images []string, profile *config.Profile, options *run.CommandOp
 	klog.Infof("failed pushing in: %s", strings.Join(failed, " "))
 	return nil
 }
+
+// ImagesInConfigFile returns the images that "cache add" added
+func ImagesInConfigFile() ([]string, error) {
+	configFile, err := config.ReadConfig(localpath.ConfigFile())
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	if values, ok := configFile[cacheImageConfigKey]; ok {
+		// Type assertion needed because config values are stored as interface{}
+		if m, ok := values.(map[string]interface{}); ok {
+			images := slices.Collect(maps.Keys(m))
+			return images, nil
+		}
+		return nil, fmt.Errorf("cache config value has unexpected type %T", values)
+	}
+	return []string{}, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/download"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// The kinds of files of the host cache
+const (
+	CacheImage   = "image"
+	CachePreload = "preload"
+)
+
+// CachePruneOptions are the options to use for pruning the host cache
+type CachePruneOptions struct {
+	// OlderThan prunes the files that were last written longer ago than this, if not zero
+	OlderThan time.Duration
+	// Unused prunes the files that no profile needs, for its Kubernetes version or its addons, and that "cache add" did not add
+	Unused bool
+	// DryRun returns the files that would be pruned, without removing them
+	DryRun bool
+}
+
+// CacheFile is a file of the host cache
+type CacheFile struct {
+	Path    string
+	Kind    string
+	Size    int64
+	ModTime time.Time
+}
+
+// imageCacheRoot is where the images are cached, in a directory per architecture
+func imageCacheRoot() string {
+	return localpath.MakeMiniPath("cache", "images")
+}
+
+// preloadCacheRoot is where the preloaded tarballs are downloaded to
+func preloadCacheRoot() string {
+	return localpath.MakeMiniPath("cache", "preloaded-tarball")
+}
+
+// cacheFiles returns the images and the preloads of the host cache
+func cacheFiles() ([]CacheFile, error) {
+	var files []CacheFile
+	for kind, root := range map[string]string{CacheImage: imageCacheRoot(), CachePreload: preloadCacheRoot()} {
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			if err != nil || d.IsDir() {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			files = append(files, CacheFile{Path: p, Kind: kind, Size: info.Size(), ModTime: info.ModTime()})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// addonImages returns the images of the addons enabled in the cluster
+func addonImages(cc *config.ClusterConfig) []string {
+	var imgs []string
+	for name, enabled := range cc.Addons {
+		addon, ok := assets.Addons[name]
+		if !enabled || !ok {
+			continue
+		}
+		for key, img := range addon.Images {
+			registry := addon.Registries[key]
+			if custom, ok := cc.CustomAddonImages[key]; ok {
+				// a custom image names its registry, if any, instead of the default one
+				img = custom
+				registry = ""
+			}
+			if custom, ok := cc.CustomAddonRegistries[key]; ok {
+				registry = custom
+			}
+			imgs = append(imgs, path.Join(registry, img))
+		}
+	}
+	return imgs
+}
+
+// cachedImages returns the images added with "cache add", which are loaded into every cluster.
+// An image added without a tag is loaded as its latest tag.
+func cachedImages() ([]string, error) {
+	imgs, err := ImagesInConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	for i, img := range imgs {
+		if !strings.Contains(img, "@") && !strings.Contains(img[strings.LastIndex(img, "/")+1:], ":") {
+			imgs[i] = img + ":latest"
+		}
+	}
+	return imgs, nil
+}
+
+// cacheReferences returns the paths of the cache files which the profiles need or "cache add" added
+func cacheReferences() (map[string]bool, error) {
+	imgs, err := ImagesInConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	// the invalid profiles might be half created ones, which still need their images
+	valid, invalid, err := config.ListProfiles()
+	if err != nil {
+		klog.Warningf("error listing profiles: %v", err)
+	}
+
+	refs := map[string]bool{}
+	for _, p := range append(valid, invalid...) {
+		if p == nil || p.Config == nil {
+			continue
+		}
+		cc := p.Config
+		imgs = append(imgs, addonImages(cc)...)
+		k8s := cc.KubernetesConfig
+		if k8s.KubernetesVersion == "" || k8s.KubernetesVersion == constants.NoKubernetesVersion {
+			continue
+		}
+		refs[download.TarballPath(k8s.KubernetesVersion, k8s.ContainerRuntime)] = true
+		k8sImages, err := bootstrapper.GetCachedImageList(k8s.ImageRepository, k8s.KubernetesVersion)
+		if err != nil {
+			klog.Warningf("images of kubernetes %s of profile %s: %v", k8s.KubernetesVersion, p.Name, err)
+			continue
+		}
+		imgs = append(imgs, k8sImages...)
+	}
+
+	// the images are cached in a directory per architecture, like cache/images/amd64/registry.k8s.io/pause_3.10
+	archs, err := os.ReadDir(imageCacheRoot())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, arch := range archs {
+		for _, img := range imgs {
+			refs[localpath.SanitizeCacheDir(filepath.Join(imageCacheRoot(), arch.Name(), img))] = true
+		}
+	}
+	return refs, nil
+}
+
+// PruneCache removes the images and the preloads of the host cache which match the options, returning the removed files
+func PruneCache(o CachePruneOptions) ([]CacheFile, error) {
+	if o.OlderThan == 0 && !o.Unused {
+		return nil, errors.New("nothing to prune without a condition")
+	}
+	files, err := cacheFiles()
+	if err != nil {
+		return nil, fmt.Errorf("listing cache: %w", err)
+	}
+	var refs map[string]bool
+	if o.Unused {
+		if refs, err = cacheReferences(); err != nil {
+			return nil, fmt.Errorf("listing used images: %w", err)
+		}
+	}
+
+	var pruned []CacheFile
+	var errs []error
+	for _, f := range files {
+		if o.OlderThan != 0 && time.Since(f.ModTime) < o.OlderThan {
+			continue
+		}
+		if o.Unused && refs[f.Path] {
+			continue
+		}
+		if !o.DryRun {
+			klog.Infof("pruning %s %s", f.Kind, f.Path)
+			if err := os.Remove(f.Path); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		pruned = append(pruned, f)
+	}
+	if !o.DryRun {
+		removeEmptyDirs(imageCacheRoot())
+	}
+	return pruned, errors.Join(errs...)
+}
+
+// removeEmptyDirs removes the directories under root which are left empty, deepest first
+func removeEmptyDirs(root string) {
+	var dirs []string
+	_ = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() && p != root {
+			dirs = append(dirs, p)
+		}
+		return nil
+	})
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if entries, err := os.ReadDir(dirs[i]); err == nil && len(entries) == 0 {
+			_ = os.Remove(dirs[i])
+		}
+	}
+}
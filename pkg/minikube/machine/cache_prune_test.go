@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/download"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+func TestPruneCache(t *testing.T) {
+	tests.MakeTempDir(t)
+
+	cc := &config.ClusterConfig{
+		Name:             "p1",
+		Driver:           "docker",
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.34.0", ContainerRuntime: "containerd"},
+		Nodes:            []config.Node{{ControlPlane: true, Worker: true, KubernetesVersion: "v1.34.0"}},
+		Addons:           map[string]bool{"registry": true},
+	}
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		t.Fatalf("SaveProfile: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(localpath.ConfigFile()), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WriteConfig(localpath.ConfigFile(), config.MinikubeConfig{cacheImageConfigKey: map[string]interface{}{"busybox:1.37": nil}}); err != nil {
+		t.Fatalf("WriteConfig: %v", err)
+	}
+
+	imageDir := filepath.Join(imageCacheRoot(), runtime.GOARCH)
+	old := time.Now().Add(-48 * time.Hour)
+	files := map[string]time.Time{
+		filepath.Join(imageDir, "registry.k8s.io", "pause_3.10.1"):                                                     old,
+		filepath.Join(imageDir, "registry.k8s.io", "kube-apiserver_v1.20.0"):                                           old,
+		localpath.SanitizeCacheDir(filepath.Join(imageDir, "docker.io", assets.Addons["registry"].Images["Registry"])): time.Now(),
+		filepath.Join(imageDir, "busybox_1.37"):                                                                        old,
+		filepath.Join(imageDir, "docker.io", "library", "nginx_latest"):                                                time.Now(),
+		download.TarballPath("v1.34.0", "containerd"):                                                                  old,
+		download.TarballPath("v1.20.0", "docker"):                                                                      old,
+		filepath.Join(imageDir, "registry.k8s.io", "kube-scheduler_v1.34.0"):                                           old,
+	}
+	for p, mtime := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("tarball"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths := func(files []CacheFile) []string {
+		var ps []string
+		for _, f := range files {
+			ps = append(ps, f.Path)
+		}
+		slices.Sort(ps)
+		return ps
+	}
+
+	if _, err := PruneCache(CachePruneOptions{}); err == nil {
+		t.Errorf("PruneCache without a condition should fail")
+	}
+
+	// the images of kubernetes v1.34.0 are referenced, so only the other ones are unused
+	unused, err := PruneCache(CachePruneOptions{Unused: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("PruneCache: %v", err)
+	}
+	want := []string{
+		filepath.Join(imageDir, "docker.io", "library", "nginx_latest"),
+		filepath.Join(imageDir, "registry.k8s.io", "kube-apiserver_v1.20.0"),
+		download.TarballPath("v1.20.0", "docker"),
+	}
+	slices.Sort(want)
+	if diff := cmp.Diff(want, paths(unused)); diff != "" {
+		t.Errorf("unused files mismatch (-want +got):\n%s", diff)
+	}
+	for p := range files {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("dry run removed %s: %v", p, err)
+		}
+	}
+
+	pruned, err := PruneCache(CachePruneOptions{Unused: true, OlderThan: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("PruneCache: %v", err)
+	}
+	want = []string{
+		filepath.Join(imageDir, "registry.k8s.io", "kube-apiserver_v1.20.0"),
+		download.TarballPath("v1.20.0", "docker"),
+	}
+	slices.Sort(want)
+	if diff := cmp.Diff(want, paths(pruned)); diff != "" {
+		t.Errorf("pruned files mismatch (-want +got):\n%s", diff)
+	}
+	for _, p := range want {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed: %v", p, err)
+		}
+	}
+}
+
+func TestAddonImages(t *testing.T) {
+	cc := &config.ClusterConfig{
+		Addons:                map[string]bool{"registry": true, "registry-creds": false},
+		CustomAddonImages:     map[string]string{"Registry": "my.io/registry:3"},
+		CustomAddonRegistries: map[string]string{"KubeRegistryProxy": "mirror.io"},
+	}
+	got := addonImages(cc)
+	slices.Sort(got)
+	// a custom image replaces the default registry, a custom registry replaces it for the default image
+	want := []string{
+		"mirror.io/" + assets.Addons["registry"].Images["KubeRegistryProxy"],
+		"my.io/registry:3",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("addonImages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCachedImages(t *testing.T) {
+	tests.MakeTempDir(t)
+
+	if err := os.MkdirAll(filepath.Dir(localpath.ConfigFile()), 0755); err != nil {
+		t.Fatal(err)
+	}
+	imgs := map[string]interface{}{"busybox:1.37": nil, "alpine": nil, "localhost:5000/app": nil, "nginx@sha256:123": nil}
+	if err := config.WriteConfig(localpath.ConfigFile(), config.MinikubeConfig{cacheImageConfigKey: imgs}); err != nil {
+		t.Fatalf("WriteConfig: %v", err)
+	}
+	got, err := cachedImages()
+	if err != nil {
+		t.Fatalf("cachedImages: %v", err)
+	}
+	slices.Sort(got)
+	// an image added without a tag is loaded as its latest tag
+	want := []string{"alpine:latest", "busybox:1.37", "localhost:5000/app:latest", "nginx@sha256:123"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("cachedImages mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/libmachine"
+	"k8s.io/minikube/pkg/libmachine/host"
+	"k8s.io/minikube/pkg/libmachine/mcnerror"
+	"k8s.io/minikube/pkg/libmachine/state"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+const (
+	snapshotMetaFile   = "snapshot.json"
+	snapshotConfigFile = "config.json"
+	snapshotHostsDir   = "machines"
+	snapshotCertsDir   = "certs"
+	snapshotDisksDir   = "disks"
+	// kicVolumeArchive is the archive of the /var volume of a kic node, which holds all of its cluster state.
+	// The rest of the container filesystem comes from the kicbase image and is not part of the snapshot:
+	// the kubeadm and runtime configs outside of /var are regenerated by the next start.
+	kicVolumeArchive = "var.tar"
+)
+
+var (
+	// ErrSnapshotExists is returned when a snapshot with the same name already exists
+	ErrSnapshotExists = errors.New("snapshot already exists")
+	// ErrSnapshotNotFound is returned when the requested snapshot does not exist
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	// ErrSnapshotIncompatible is returned when a snapshot cannot be restored over the existing profile
+	ErrSnapshotIncompatible = errors.New("snapshot is incompatible with profile")
+)
+
+// Snapshot describes a saved copy of a stopped cluster
+type Snapshot struct {
+	Name              string
+	Profile           string
+	Driver            string
+	KubernetesVersion string
+	ContainerRuntime  string
+	CreationTime      time.Time
+	Machines          []string
+}
+
+// hostRecord mirrors host.Host, keeping the driver config raw so that it can be handed back to api.NewHost
+type hostRecord struct {
+	ConfigVersion int
+	Driver        json.RawMessage
+	DriverName    string
+	HostOptions   *host.Options
+	Name          string
+}
+
+// SaveSnapshot captures the config, machine records, certs and node disks of a stopped cluster
+func SaveSnapshot(api libmachine.API, cc config.ClusterConfig, name string) (*Snapshot, error) {
+	if !config.ProfileNameValid(name) {
+		return nil, fmt.Errorf("invalid snapshot name %q", name)
+	}
+	dir := localpath.Snapshot(cc.Name, name)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%q: %w", name, ErrSnapshotExists)
+	}
+
+	hosts := []*host.Host{}
+	for _, n := range cc.Nodes {
+		machineName := config.MachineName(cc, n)
+		h, err := api.Load(machineName)
+		if err != nil {
+			return nil, fmt.Errorf("load %q: %w", machineName, err)
+		}
+		s, err := h.Driver.GetState()
+		if err != nil {
+			return nil, fmt.Errorf("state %q: %w", machineName, err)
+		}
+		if s != state.Stopped {
+			return nil, fmt.Errorf("node %q is %s, only stopped clusters can be snapshotted", machineName, s)
+		}
+		hosts = append(hosts, h)
+	}
+
+	// write to a temporary directory first, so that a failed save never leaves a partial snapshot behind
+	tmp := dir + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	for _, d := range []string{snapshotHostsDir, snapshotCertsDir, snapshotDisksDir} {
+		if err := os.MkdirAll(filepath.Join(tmp, d), 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeJSON(filepath.Join(tmp, snapshotConfigFile), cc); err != nil {
+		return nil, fmt.Errorf("save config: %w", err)
+	}
+
+	snap := &Snapshot{
+		Name:              name,
+		Profile:           cc.Name,
+		Driver:            cc.Driver,
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+		CreationTime:      time.Now(),
+	}
+	for _, h := range hosts {
+		if err := writeJSON(filepath.Join(tmp, snapshotHostsDir, h.Name+".json"), h); err != nil {
+			return nil, fmt.Errorf("save host %q: %w", h.Name, err)
+		}
+		if err := saveDisk(cc, h, filepath.Join(tmp, snapshotDisksDir, h.Name)); err != nil {
+			return nil, fmt.Errorf("save disk %q: %w", h.Name, err)
+		}
+		snap.Machines = append(snap.Machines, h.Name)
+	}
+
+	if err := copyProfileCerts(localpath.Profile(cc.Name), filepath.Join(tmp, snapshotCertsDir)); err != nil {
+		return nil, fmt.Errorf("save certs: %w", err)
+	}
+
+	if err := writeJSON(filepath.Join(tmp, snapshotMetaFile), snap); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		return nil, err
+	}
+	klog.Infof("saved snapshot %q of %q to %s", name, cc.Name, dir)
+	return snap, nil
+}
+
+// RestoreSnapshot replaces the state of a stopped profile with a previously saved snapshot
+func RestoreSnapshot(api libmachine.API, profile, name string) (*Snapshot, error) {
+	snap, err := LoadSnapshot(profile, name)
+	if err != nil {
+		return nil, err
+	}
+	dir := localpath.Snapshot(profile, name)
+
+	var cc config.ClusterConfig
+	if err := readJSON(filepath.Join(dir, snapshotConfigFile), &cc); err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	existing, err := config.Load(profile)
+	if err != nil {
+		if !config.IsNotExist(err) {
+			return nil, fmt.Errorf("load profile: %w", err)
+		}
+		existing = nil
+	}
+	if err := checkSnapshotCompatible(snap, existing); err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		if err := removeExtraMachines(api, *existing, snap); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, machineName := range snap.Machines {
+		var rec hostRecord
+		if err := readJSON(filepath.Join(dir, snapshotHostsDir, machineName+".json"), &rec); err != nil {
+			return nil, fmt.Errorf("read host %q: %w", machineName, err)
+		}
+		h, err := api.NewHost(rec.DriverName, rec.Driver)
+		if err != nil {
+			return nil, fmt.Errorf("new host %q: %w", machineName, err)
+		}
+		h.ConfigVersion = rec.ConfigVersion
+		h.Name = rec.Name
+		h.HostOptions = rec.HostOptions
+		if err := restoreDisk(cc, h, filepath.Join(dir, snapshotDisksDir, machineName)); err != nil {
+			return nil, fmt.Errorf("restore disk %q: %w", machineName, err)
+		}
+		if err := api.Save(h); err != nil {
+			return nil, fmt.Errorf("save host %q: %w", machineName, err)
+		}
+	}
+
+	if err := copyProfileCerts(filepath.Join(dir, snapshotCertsDir), localpath.Profile(profile)); err != nil {
+		return nil, fmt.Errorf("restore certs: %w", err)
+	}
+	if err := config.SaveProfile(profile, &cc); err != nil {
+		return nil, fmt.Errorf("save profile: %w", err)
+	}
+	klog.Infof("restored snapshot %q of %q from %s", name, profile, dir)
+	return snap, nil
+}
+
+// LoadSnapshot returns the metadata of a saved snapshot
+func LoadSnapshot(profile, name string) (*Snapshot, error) {
+	var snap Snapshot
+	err := readJSON(filepath.Join(localpath.Snapshot(profile, name), snapshotMetaFile), &snap)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%q: %w", name, ErrSnapshotNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+// ListSnapshots returns the snapshots of a profile, oldest first
+func ListSnapshots(profile string) ([]*Snapshot, error) {
+	items, err := os.ReadDir(localpath.Snapshots(profile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snaps := []*Snapshot{}
+	for _, item := range items {
+		if !item.IsDir() || strings.HasSuffix(item.Name(), ".tmp") {
+			continue
+		}
+		snap, err := LoadSnapshot(profile, item.Name())
+		if err != nil {
+			klog.Warningf("skipping unreadable snapshot %q: %v", item.Name(), err)
+			continue
+		}
+		snaps = append(snaps, snap)
+	}
+	sort.Slice(snaps, func(i, j int) bool {
+		return snaps[i].CreationTime.Before(snaps[j].CreationTime)
+	})
+	return snaps, nil
+}
+
+// DeleteSnapshots removes all the snapshots of a profile
+func DeleteSnapshots(profile string) error {
+	dir := localpath.Snapshots(profile)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	klog.Infof("removing snapshots of %q in %s", profile, dir)
+	return os.RemoveAll(dir)
+}
+
+// checkSnapshotCompatible refuses snapshots that would move the existing profile to another driver or Kubernetes minor version
+func checkSnapshotCompatible(snap *Snapshot, existing *config.ClusterConfig) error {
+	sv, err := util.ParseKubernetesVersion(snap.KubernetesVersion)
+	if err != nil {
+		return fmt.Errorf("parse snapshot kubernetes version: %w", err)
+	}
+	oldest, err := util.ParseKubernetesVersion(constants.OldestKubernetesVersion)
+	if err != nil {
+		return err
+	}
+	if sv.LT(oldest) {
+		return fmt.Errorf("%w: kubernetes %s is older than the oldest supported version %s", ErrSnapshotIncompatible, snap.KubernetesVersion, constants.OldestKubernetesVersion)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if existing.Driver != snap.Driver {
+		return fmt.Errorf("%w: snapshot uses the %s driver, profile uses %s", ErrSnapshotIncompatible, snap.Driver, existing.Driver)
+	}
+	ev, err := util.ParseKubernetesVersion(existing.KubernetesConfig.KubernetesVersion)
+	if err != nil {
+		return fmt.Errorf("parse profile kubernetes version: %w", err)
+	}
+	if ev.Major != sv.Major || ev.Minor != sv.Minor {
+		return fmt.Errorf("%w: snapshot runs kubernetes %s, profile runs %s", ErrSnapshotIncompatible, snap.KubernetesVersion, existing.KubernetesConfig.KubernetesVersion)
+	}
+	return nil
+}
+
+// removeExtraMachines ensures the existing cluster is stopped and deletes nodes which are not part of the snapshot
+func removeExtraMachines(api libmachine.API, cc config.ClusterConfig, snap *Snapshot) error {
+	for _, n := range cc.Nodes {
+		machineName := config.MachineName(cc, n)
+		h, err := api.Load(machineName)
+		if err != nil {
+			var notExist mcnerror.ErrHostDoesNotExist
+			if errors.As(err, &notExist) {
+				continue
+			}
+			return fmt.Errorf("load %q: %w", machineName, err)
+		}
+		s, err := h.Driver.GetState()
+		if err == nil && s != state.Stopped && s != state.None {
+			return fmt.Errorf("node %q is %s, stop the cluster before restoring a snapshot", machineName, s)
+		}
+
+		if !slices.Contains(snap.Machines, machineName) {
+			klog.Infof("deleting %q which is not part of snapshot %q", machineName, snap.Name)
+			if err := DeleteHost(api, machineName); err != nil {
+				return fmt.Errorf("delete %q: %w", machineName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// saveDisk copies the persistent disk of a node into dst, for kic nodes only the /var volume is saved
+func saveDisk(cc config.ClusterConfig, h *host.Host, dst string) error {
+	switch {
+	case driver.IsMock(h.DriverName):
+		return nil
+	case driver.IsKIC(h.DriverName):
+		if err := os.MkdirAll(dst, 0700); err != nil {
+			return err
+		}
+		return oci.ArchiveVolumeToTarball(h.DriverName, h.Name, filepath.Join(dst, kicVolumeArchive), cc.KicBaseImage)
+	case driver.IsVM(h.DriverName):
+		return copy.Copy(localpath.MachinePath(h.Name), dst, copy.Options{Skip: skipMachineFile})
+	default:
+		return fmt.Errorf("the %s driver does not support snapshots", h.DriverName)
+	}
+}
+
+// restoreDisk replaces the persistent disk of a node with the one saved in src
+func restoreDisk(cc config.ClusterConfig, h *host.Host, src string) error {
+	switch {
+	case driver.IsMock(h.DriverName):
+		return nil
+	case driver.IsKIC(h.DriverName):
+		return oci.RestoreVolumeFromTarball(h.DriverName, filepath.Join(src, kicVolumeArchive), h.Name, cc.KicBaseImage)
+	case driver.IsVM(h.DriverName):
+		return copy.Copy(src, localpath.MachinePath(h.Name))
+	default:
+		return fmt.Errorf("the %s driver does not support snapshots", h.DriverName)
+	}
+}
+
+// skipMachineFile skips the host record, which is saved separately, and the boot ISO, which never changes
+func skipMachineFile(info os.FileInfo, src, _ string) (bool, error) {
+	if info.IsDir() {
+		return false, nil
+	}
+	return info.Name() == "config.json" || filepath.Ext(src) == ".iso", nil
+}
+
+// copyProfileCerts copies the certificates and keys of a profile from src to dst
+func copyProfileCerts(src, dst string) error {
+	items, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0700); err != nil {
+		return err
+	}
+	for _, item := range items {
+		switch filepath.Ext(item.Name()) {
+		case ".crt", ".key", ".pem":
+		default:
+			continue
+		}
+		if err := copy.Copy(filepath.Join(src, item.Name()), filepath.Join(dst, item.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return lock.WriteFile(path, data, 0600)
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/minikube/pkg/libmachine/auth"
+	"k8s.io/minikube/pkg/libmachine/host"
+	"k8s.io/minikube/pkg/libmachine/state"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+func snapshotCluster(t *testing.T, api *tests.MockAPI, s state.State) config.ClusterConfig {
+	t.Helper()
+	cc := config.ClusterConfig{
+		Name:   "snap",
+		Driver: driver.Mock,
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion: constants.DefaultKubernetesVersion,
+			ContainerRuntime:  constants.Docker,
+		},
+		Nodes: []config.Node{{Name: "", ControlPlane: true, Worker: true}},
+	}
+	if err := config.SaveProfile(cc.Name, &cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localpath.Profile(cc.Name), "client.crt"), []byte("original"), 0600); err != nil {
+		t.Fatalf("write cert: %v", err)
+	}
+	if err := api.Save(&host.Host{
+		Name:        cc.Name,
+		DriverName:  driver.Mock,
+		Driver:      &tests.MockDriver{CurrentState: s},
+		HostOptions: &host.Options{AuthOptions: &auth.Options{}},
+	}); err != nil {
+		t.Fatalf("save host: %v", err)
+	}
+	return cc
+}
+
+func TestSaveSnapshotRequiresStopped(t *testing.T) {
+	tests.MakeTempDir(t)
+	RegisterMockDriver(t)
+	api := tests.NewMockAPI(t)
+	cc := snapshotCluster(t, api, state.Running)
+
+	if _, err := SaveSnapshot(api, cc, "baseline"); err == nil {
+		t.Fatal("expected an error snapshotting a running cluster")
+	}
+	if _, err := os.Stat(localpath.Snapshot(cc.Name, "baseline")); !os.IsNotExist(err) {
+		t.Errorf("snapshot dir should not exist, got: %v", err)
+	}
+}
+
+func TestSaveAndRestoreSnapshot(t *testing.T) {
+	tests.MakeTempDir(t)
+	RegisterMockDriver(t)
+	api := tests.NewMockAPI(t)
+	cc := snapshotCluster(t, api, state.Stopped)
+
+	snap, err := SaveSnapshot(api, cc, "baseline")
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if len(snap.Machines) != 1 || snap.Machines[0] != cc.Name {
+		t.Errorf("machines = %v, want [%s]", snap.Machines, cc.Name)
+	}
+	if _, err := SaveSnapshot(api, cc, "baseline"); !errors.Is(err, ErrSnapshotExists) {
+		t.Errorf("second save: got %v, want %v", err, ErrSnapshotExists)
+	}
+
+	// diverge from the snapshot, then restore it
+	cc.Memory = 4096
+	if err := config.SaveProfile(cc.Name, &cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localpath.Profile(cc.Name), "client.crt"), []byte("changed"), 0600); err != nil {
+		t.Fatalf("write cert: %v", err)
+	}
+	if err := api.Remove(cc.Name); err != nil {
+		t.Fatalf("remove host: %v", err)
+	}
+
+	if _, err := RestoreSnapshot(api, cc.Name, "baseline"); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	got, err := config.Load(cc.Name)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got.Memory != 0 {
+		t.Errorf("memory = %d, want 0", got.Memory)
+	}
+	crt, err := os.ReadFile(filepath.Join(localpath.Profile(cc.Name), "client.crt"))
+	if err != nil {
+		t.Fatalf("read cert: %v", err)
+	}
+	if string(crt) != "original" {
+		t.Errorf("cert = %q, want %q", crt, "original")
+	}
+	if exists, _ := api.Exists(cc.Name); !exists {
+		t.Errorf("host %q was not restored", cc.Name)
+	}
+
+	snaps, err := ListSnapshots(cc.Name)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(snaps) != 1 || snaps[0].Name != "baseline" {
+		t.Errorf("list = %v, want [baseline]", snaps)
+	}
+}
+
+func TestDeleteSnapshots(t *testing.T) {
+	tests.MakeTempDir(t)
+	RegisterMockDriver(t)
+	api := tests.NewMockAPI(t)
+	cc := snapshotCluster(t, api, state.Stopped)
+
+	if _, err := SaveSnapshot(api, cc, "baseline"); err != nil {
+		t.Fatalf("SaveSnapshot: %v", err)
+	}
+	if err := DeleteSnapshots(cc.Name); err != nil {
+		t.Fatalf("DeleteSnapshots: %v", err)
+	}
+	snaps, err := ListSnapshots(cc.Name)
+	if err != nil {
+		t.Fatalf("ListSnapshots: %v", err)
+	}
+	if len(snaps) != 0 {
+		t.Errorf("got %d snapshots after DeleteSnapshots, want none", len(snaps))
+	}
+	// deleting the snapshots of a profile without any is not an error
+	if err := DeleteSnapshots(cc.Name); err != nil {
+		t.Errorf("DeleteSnapshots without snapshots: %v", err)
+	}
+}
+
+func TestRestoreSnapshotIncompatibleVersion(t *testing.T) {
+	tests.MakeTempDir(t)
+	RegisterMockDriver(t)
+	api := tests.NewMockAPI(t)
+	cc := snapshotCluster(t, api, state.Stopped)
+
+	if _, err := SaveSnapshot(api, cc, "baseline"); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	cc.KubernetesConfig.KubernetesVersion = constants.OldestKubernetesVersion
+	if err := config.SaveProfile(cc.Name, &cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	if _, err := RestoreSnapshot(api, cc.Name, "baseline"); !errors.Is(err, ErrSnapshotIncompatible) {
+		t.Errorf("restore: got %v, want %v", err, ErrSnapshotIncompatible)
+	}
+	if _, err := RestoreSnapshot(api, cc.Name, "missing"); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("restore missing: got %v, want %v", err, ErrSnapshotNotFound)
+	}
+}
@@ -127,15 +127,17 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 		klog.Infof("duration metric: took %s to createHost", time.Since(start))
 	}()
 
+	// nodes may have their own CPUs, memory and disk size
+	ncfg := config.WithNodeResources(*cfg, *n)
 	if cfg.Driver != driver.SSH {
-		showHostInfo(nil, *cfg)
+		showHostInfo(nil, ncfg)
 	}
 
 	def := registry.Driver(cfg.Driver)
 	if def.Empty() {
 		return nil, fmt.Errorf("unsupported/missing driver: %s", cfg.Driver)
 	}
-	dd, err := def.Config(*cfg, *n)
+	dd, err := def.Config(ncfg, *n)
 	if err != nil {
 		return nil, fmt.Errorf("config: %w", err)
 	}
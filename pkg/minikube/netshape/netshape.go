@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package netshape emulates network conditions on the nodes of a cluster with tc qdiscs
+package netshape
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// rateRe matches the rates tc understands, in bits or bytes per second
+var rateRe = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)(bit|kbit|mbit|gbit|tbit|bps|kbps|mbps|gbps|tbps)$`)
+
+// rateUnits are the bits per second of each rate unit
+var rateUnits = map[string]float64{
+	"bit": 1, "kbit": 1e3, "mbit": 1e6, "gbit": 1e9, "tbit": 1e12,
+	"bps": 8, "kbps": 8e3, "mbps": 8e6, "gbps": 8e9, "tbps": 8e12,
+}
+
+// minBurst is the smallest burst of the token bucket in bytes, which must hold at least a full frame
+const minBurst = 1600
+
+// qdiscModules are the kernel modules of the qdiscs a shape is made of
+var qdiscModules = map[string]string{"netem": "sch_netem", "tbf": "sch_tbf"}
+
+// unknownQdisc matches what tc prints when the kernel has no module for the kind of a qdisc,
+// with and without the extended acks of newer kernels
+var unknownQdisc = regexp.MustCompile(`qdisc kind is unknown|RTNETLINK answers: No such file or directory`)
+
+// MissingModuleError is returned when the kernel of a node lacks the module of a qdisc of the shape
+type MissingModuleError struct {
+	Module string
+}
+
+func (e *MissingModuleError) Error() string {
+	return fmt.Sprintf("the kernel has no %s module", e.Module)
+}
+
+// Validate checks that the shape can be applied
+func Validate(s config.NetworkShape) error {
+	if s.Latency < 0 || s.Jitter < 0 {
+		return fmt.Errorf("latency and jitter must not be negative")
+	}
+	if s.Jitter > 0 && s.Latency == 0 {
+		return fmt.Errorf("jitter needs a latency")
+	}
+	if s.Loss < 0 || s.Loss > 100 {
+		return fmt.Errorf("loss must be between 0 and 100%%, got %g%%", s.Loss)
+	}
+	if s.Rate != "" {
+		if _, err := bitsPerSecond(s.Rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseLoss parses a packet loss percentage, such as 1% or 0.5
+func ParseLoss(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	loss, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid loss %q: %w", s, err)
+	}
+	return loss, nil
+}
+
+// bitsPerSecond parses a rate in the tc syntax
+func bitsPerSecond(rate string) (float64, error) {
+	m := rateRe.FindStringSubmatch(strings.ToLower(rate))
+	if m == nil {
+		return 0, fmt.Errorf("invalid rate %q, expected a number followed by one of bit, kbit, mbit, gbit, bps, kbps, mbps or gbps", rate)
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate %q: %w", rate, err)
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("rate must be positive, got %q", rate)
+	}
+	return n * rateUnits[m[2]], nil
+}
+
+// micros formats a duration for tc, which does not understand the minutes and hours of time.Duration.String
+func micros(d time.Duration) string {
+	return fmt.Sprintf("%dus", d.Microseconds())
+}
+
+// netemArgs returns the tc arguments of the netem qdisc emulating the latency and loss of the shape
+func netemArgs(s config.NetworkShape) []string {
+	args := []string{"netem"}
+	if s.Latency > 0 {
+		args = append(args, "delay", micros(s.Latency))
+		if s.Jitter > 0 {
+			args = append(args, micros(s.Jitter))
+		}
+	}
+	if s.Loss > 0 {
+		args = append(args, "loss", strconv.FormatFloat(s.Loss, 'f', -1, 64)+"%")
+	}
+	return args
+}
+
+// tbfArgs returns the tc arguments of the token bucket limiting the bandwidth to the rate of the shape
+func tbfArgs(s config.NetworkShape) ([]string, error) {
+	bps, err := bitsPerSecond(s.Rate)
+	if err != nil {
+		return nil, err
+	}
+	// the bucket must hold what is sent in a timer tick, 10ms at the lowest kernel frequency
+	burst := max(int64(bps/8/100), minBurst)
+	return []string{"tbf", "rate", s.Rate, "burst", strconv.FormatInt(burst, 10), "latency", "400ms"}, nil
+}
+
+// Interface returns the network interface holding the IP of the node
+func Interface(r command.Runner, ip string) (string, error) {
+	rr, err := r.RunCmd(exec.Command("ip", "-o", "-4", "addr", "show"))
+	if err != nil {
+		return "", fmt.Errorf("list addresses: %w", err)
+	}
+	for _, line := range strings.Split(rr.Stdout.String(), "\n") {
+		// 2: eth0    inet 192.168.49.2/24 brd 192.168.49.255 scope global eth0
+		fields := strings.Fields(line)
+		if len(fields) >= 4 && fields[2] == "inet" && strings.HasPrefix(fields[3], ip+"/") {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("no network interface has the IP %s", ip)
+}
+
+func tc(r command.Runner, args ...string) error {
+	if _, err := r.RunCmd(exec.Command("sudo", append([]string{"tc", "qdisc"}, args...)...)); err != nil {
+		return fmt.Errorf("tc: %w", err)
+	}
+	return nil
+}
+
+// add adds the qdisc to the interface, where qdisc starts with its kind
+func add(r command.Runner, where []string, qdisc []string) error {
+	rr, err := r.RunCmd(exec.Command("sudo", append(append([]string{"tc", "qdisc", "add"}, where...), qdisc...)...))
+	if err == nil {
+		return nil
+	}
+	if rr != nil && unknownQdisc.MatchString(rr.Stderr.String()) {
+		return &MissingModuleError{Module: qdiscModules[qdisc[0]]}
+	}
+	return fmt.Errorf("tc: %w", err)
+}
+
+// Apply replaces the shaping of the interface with the shape: a netem qdisc for the latency and loss,
+// with a token bucket for the rate under it.
+func Apply(r command.Runner, iface string, s config.NetworkShape) error {
+	if err := Validate(s); err != nil {
+		return err
+	}
+	if err := Clear(r, iface); err != nil {
+		return err
+	}
+	if err := add(r, []string{"dev", iface, "root", "handle", "1:"}, netemArgs(s)); err != nil {
+		return err
+	}
+	if s.Rate == "" {
+		return nil
+	}
+	args, err := tbfArgs(s)
+	if err != nil {
+		return err
+	}
+	if err := add(r, []string{"dev", iface, "parent", "1:1", "handle", "10:"}, args); err != nil {
+		// do not leave the node half shaped
+		if cerr := Clear(r, iface); cerr != nil {
+			klog.Warningf("unable to clear the shaping of %s: %v", iface, cerr)
+		}
+		return err
+	}
+	return nil
+}
+
+// Clear removes the shaping of the interface, if any
+func Clear(r command.Runner, iface string) error {
+	rr, err := r.RunCmd(exec.Command("tc", "qdisc", "show", "dev", iface))
+	if err != nil {
+		return fmt.Errorf("show qdiscs: %w", err)
+	}
+	if !strings.Contains(rr.Stdout.String(), "qdisc netem 1:") {
+		klog.Infof("%s is not shaped", iface)
+		return nil
+	}
+	return tc(r, "del", "dev", iface, "root")
+}
+
+// ApplyNode applies the shape of the node, or clears its shaping if it has none
+func ApplyNode(r command.Runner, n config.Node) error {
+	iface, err := Interface(r, n.IP)
+	if err != nil {
+		return err
+	}
+	if n.NetworkShape == nil {
+		return Clear(r, iface)
+	}
+	return Apply(r, iface, *n.NetworkShape)
+}
+
+// String describes the shape, such as "latency 100ms ± 10ms, loss 1%, rate 10mbit"
+func String(s config.NetworkShape) string {
+	var parts []string
+	if s.Latency > 0 {
+		l := "latency " + s.Latency.String()
+		if s.Jitter > 0 {
+			l += " ± " + s.Jitter.String()
+		}
+		parts = append(parts, l)
+	}
+	if s.Loss > 0 {
+		parts = append(parts, "loss "+strconv.FormatFloat(s.Loss, 'f', -1, 64)+"%")
+	}
+	if s.Rate != "" {
+		parts = append(parts, "rate "+s.Rate)
+	}
+	if len(parts) == 0 {
+		return "unshaped"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netshape
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestValidate(t *testing.T) {
+	tcs := []struct {
+		shape config.NetworkShape
+		valid bool
+	}{
+		{config.NetworkShape{Latency: 100 * time.Millisecond, Jitter: 10 * time.Millisecond, Loss: 1, Rate: "10mbit"}, true},
+		{config.NetworkShape{Rate: "1.5gbit"}, true},
+		{config.NetworkShape{Jitter: 10 * time.Millisecond}, false},
+		{config.NetworkShape{Latency: -time.Millisecond}, false},
+		{config.NetworkShape{Loss: 101}, false},
+		{config.NetworkShape{Rate: "10"}, false},
+		{config.NetworkShape{Rate: "0mbit"}, false},
+		{config.NetworkShape{Rate: "fast"}, false},
+	}
+	for _, tc := range tcs {
+		if err := Validate(tc.shape); (err == nil) != tc.valid {
+			t.Errorf("Validate(%+v) = %v, want valid: %t", tc.shape, err, tc.valid)
+		}
+	}
+}
+
+func TestParseLoss(t *testing.T) {
+	for in, want := range map[string]float64{"": 0, "1%": 1, "0.5": 0.5} {
+		got, err := ParseLoss(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLoss(%q) = %g, %v, want %g", in, got, err, want)
+		}
+	}
+	if _, err := ParseLoss("lots"); err == nil {
+		t.Error("expected an error parsing an invalid loss")
+	}
+}
+
+func TestArgs(t *testing.T) {
+	s := config.NetworkShape{Latency: 100 * time.Millisecond, Jitter: 1500 * time.Microsecond, Loss: 0.5, Rate: "10mbit"}
+	if got, want := netemArgs(s), []string{"netem", "delay", "100000us", "1500us", "loss", "0.5%"}; !slices.Equal(got, want) {
+		t.Errorf("netemArgs() = %v, want %v", got, want)
+	}
+	if got, want := netemArgs(config.NetworkShape{Rate: "1mbit"}), []string{"netem"}; !slices.Equal(got, want) {
+		t.Errorf("netemArgs() = %v, want %v", got, want)
+	}
+
+	got, err := tbfArgs(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"tbf", "rate", "10mbit", "burst", "12500", "latency", "400ms"}; !slices.Equal(got, want) {
+		t.Errorf("tbfArgs() = %v, want %v", got, want)
+	}
+	got, err = tbfArgs(config.NetworkShape{Rate: "100kbit"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[4] != "1600" {
+		t.Errorf("burst of a slow rate = %s, want the minimum of 1600", got[4])
+	}
+}
+
+const addrs = `1: lo    inet 127.0.0.1/8 scope host lo\       valid_lft forever preferred_lft forever
+2: eth0    inet 192.168.49.3/24 brd 192.168.49.255 scope global eth0\       valid_lft forever preferred_lft forever
+3: docker0    inet 172.17.0.1/16 brd 172.17.255.255 scope global docker0\       valid_lft forever preferred_lft forever
+`
+
+func TestInterface(t *testing.T) {
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{"ip -o -4 addr show": addrs})
+
+	iface, err := Interface(r, "192.168.49.3")
+	if err != nil || iface != "eth0" {
+		t.Errorf("Interface() = %q, %v, want eth0", iface, err)
+	}
+	// a prefix of another address must not match
+	if _, err := Interface(r, "192.168.49.30"); err == nil {
+		t.Error("expected an error looking up an address no interface has")
+	}
+}
+
+func TestApplyAndClear(t *testing.T) {
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{
+		"ip -o -4 addr show":     addrs,
+		"tc qdisc show dev eth0": "qdisc noqueue 0: root refcnt 2",
+		"sudo tc qdisc add dev eth0 root handle 1: netem delay 100000us loss 1%":                     "",
+		"sudo tc qdisc add dev eth0 parent 1:1 handle 10: tbf rate 10mbit burst 12500 latency 400ms": "",
+	})
+	n := config.Node{IP: "192.168.49.3", NetworkShape: &config.NetworkShape{Latency: 100 * time.Millisecond, Loss: 1, Rate: "10mbit"}}
+	if err := ApplyNode(r, n); err != nil {
+		t.Errorf("ApplyNode: %v", err)
+	}
+
+	// an unshaped interface has nothing to clear, so only a shaped one gets its root qdisc deleted
+	n.NetworkShape = nil
+	if err := ApplyNode(r, n); err != nil {
+		t.Errorf("ApplyNode without a shape: %v", err)
+	}
+	r.SetCommandToOutput(map[string]string{
+		"tc qdisc show dev eth0":          "qdisc netem 1: root refcnt 2 limit 1000 delay 100ms loss 1%",
+		"sudo tc qdisc del dev eth0 root": "",
+	})
+	if err := ApplyNode(r, n); err != nil {
+		t.Errorf("ApplyNode clearing the shape: %v", err)
+	}
+}
+
+// stderrRunner fails the commands it has stderr for, the way tc fails
+type stderrRunner struct {
+	*command.FakeCommandRunner
+	stderr map[string]string
+}
+
+func (r *stderrRunner) RunCmd(cmd *exec.Cmd) (*command.RunResult, error) {
+	rr := &command.RunResult{Args: cmd.Args}
+	if stderr, ok := r.stderr[rr.Command()]; ok {
+		rr.Stderr.WriteString(stderr)
+		return rr, fmt.Errorf("exit status 2")
+	}
+	return r.FakeCommandRunner.RunCmd(cmd)
+}
+
+func TestMissingModule(t *testing.T) {
+	shape := config.NetworkShape{Latency: 100 * time.Millisecond, Rate: "10mbit"}
+	tcs := []struct {
+		stderr map[string]string
+		module string
+	}{
+		{map[string]string{"sudo tc qdisc add dev eth0 root handle 1: netem delay 100000us": "Error: Specified qdisc kind is unknown.\n"}, "sch_netem"},
+		{map[string]string{"sudo tc qdisc add dev eth0 parent 1:1 handle 10: tbf rate 10mbit burst 12500 latency 400ms": "RTNETLINK answers: No such file or directory\n"}, "sch_tbf"},
+		{map[string]string{"sudo tc qdisc add dev eth0 root handle 1: netem delay 100000us": "Error: Exclusivity flag on, cannot modify.\n"}, ""},
+	}
+	for _, tc := range tcs {
+		r := &stderrRunner{FakeCommandRunner: command.NewFakeCommandRunner(), stderr: tc.stderr}
+		r.SetCommandToOutput(map[string]string{
+			"tc qdisc show dev eth0": "qdisc noqueue 0: root refcnt 2",
+			"sudo tc qdisc add dev eth0 root handle 1: netem delay 100000us": "",
+		})
+		err := Apply(r, "eth0", shape)
+		var me *MissingModuleError
+		if !errors.As(err, &me) {
+			if tc.module != "" || err == nil {
+				t.Errorf("Apply() = %v, want a missing %q module", err, tc.module)
+			}
+			continue
+		}
+		if me.Module != tc.module {
+			t.Errorf("Apply() = %v, want a missing %q module", err, tc.module)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	s := config.NetworkShape{Latency: 100 * time.Millisecond, Jitter: 10 * time.Millisecond, Loss: 1, Rate: "10mbit"}
+	if got, want := String(s), "latency 100ms ± 10ms, loss 1%, rate 10mbit"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := String(config.NetworkShape{}); got != "unshaped" {
+		t.Errorf("String() = %q, want unshaped", got)
+	}
+}
@@ -17,12 +17,11 @@ limitations under the License.
 package node
 
 import (
+	"context"
 	"fmt"
-	"maps"
 	"os"
 	"path"
 	"runtime"
-	"slices"
 	"strings"
 
 	"k8s.io/minikube/pkg/minikube/detect"
@@ -40,21 +39,20 @@ import (
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/image"
-	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/trace"
 )
 
 const (
-	cacheImages         = "cache-images"
-	cacheImageConfigKey = "cache"
+	cacheImages = "cache-images"
 )
 
 // BeginCacheKubernetesImages caches images required for Kubernetes version in the background
-func beginCacheKubernetesImages(g *errgroup.Group, imageRepository string, k8sVersion string, cRuntime string, driverName string) {
+func beginCacheKubernetesImages(ctx context.Context, g *errgroup.Group, imageRepository string, k8sVersion string, cRuntime string, driverName string) {
 	// Skip all caching operations in --no-kubernetes mode
 	if viper.GetBool("no-kubernetes") {
 		klog.Infof("Skipping Kubernetes image caching due to --no-kubernetes flag")
@@ -64,7 +62,10 @@ func beginCacheKubernetesImages(g *errgroup.Group, imageRepository string, k8sVe
 	// TODO: remove imageRepository check once #7695 is fixed
 	if imageRepository == "" && download.PreloadExists(k8sVersion, cRuntime, driverName) {
 		klog.Info("Caching tarball of preloaded images")
+		_, span := trace.Start(ctx, "download.Preload", trace.String("runtime", cRuntime), trace.String("kubernetes.version", k8sVersion))
 		err := download.Preload(k8sVersion, cRuntime, driverName)
+		span.RecordError(err)
+		span.End()
 		if err == nil {
 			klog.Infof("Finished verifying existence of preloaded tar for %s on %s", k8sVersion, cRuntime)
 			return // don't cache individual images if preload is successful.
@@ -254,7 +255,7 @@ func waitCacheRequiredImages(g *errgroup.Group) {
 // saveImagesToTarFromConfig saves images to tar in cache which specified in config file.
 // currently only used by download-only option
 func saveImagesToTarFromConfig() error {
-	images, err := imagesInConfigFile()
+	images, err := machine.ImagesInConfigFile()
 	if err != nil {
 		return err
 	}
@@ -267,7 +268,7 @@ func saveImagesToTarFromConfig() error {
 // CacheAndLoadImagesInConfig loads the images currently in the config file
 // called by 'start' and 'cache reload' commands.
 func CacheAndLoadImagesInConfig(profiles []*config.Profile, options *run.CommandOptions) error {
-	images, err := imagesInConfigFile()
+	images, err := machine.ImagesInConfigFile()
 	if err != nil {
 		return fmt.Errorf("images: %w", err)
 	}
@@ -277,22 +278,6 @@ func CacheAndLoadImagesInConfig(profiles []*config.Profile, options *run.Command
 	return machine.CacheAndLoadImages(images, profiles, false, options)
 }
 
-func imagesInConfigFile() ([]string, error) {
-	configFile, err := config.ReadConfig(localpath.ConfigFile())
-	if err != nil {
-		return nil, fmt.Errorf("read: %w", err)
-	}
-	if values, ok := configFile[cacheImageConfigKey]; ok {
-		// Type assertion needed because config values are stored as interface{}
-		if m, ok := values.(map[string]interface{}); ok {
-			images := slices.Collect(maps.Keys(m))
-			return images, nil
-		}
-		return nil, fmt.Errorf("cache config value has unexpected type %T", values)
-	}
-	return []string{}, nil
-}
-
 func updateKicImageRepo(imgName string, repo string) string {
 	imageName := strings.TrimPrefix(imgName, "gcr.io/")
 	if repo == constants.AliyunMirror {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
@@ -69,11 +70,24 @@ func showNoK8sVersionInfo(cr cruntime.Manager) {
 }
 
 // configureMounts configures any requested filesystem mounts
-func configureMounts(wg *sync.WaitGroup, cc config.ClusterConfig) {
+func configureMounts(wg *sync.WaitGroup, cc config.ClusterConfig, n config.Node) {
 	wg.Add(1)
 	defer wg.Done()
 
-	if cc.MountString == "" || driver.IsKIC(cc.Driver) || driver.SupportsVirtiofsMounts(cc.Driver) {
+	if config.IsPrimaryControlPlane(cc, n) {
+		for _, m := range cc.Mounts {
+			if !m.Persistent {
+				continue
+			}
+			out.Step(style.Mounting, "Creating mount {{.name}} ...", out.V{"name": m.HostPath + ":" + m.NodePath})
+			if _, err := StartMount(viper.GetString("profile"), m); err != nil {
+				out.FailureT("Failed to mount {{.name}}: {{.error}}", out.V{"name": m.HostPath + ":" + m.NodePath, "error": err})
+			}
+		}
+	}
+
+	// containers and virtiofs devices share the mount string from the start of the machine
+	if cc.MountString == "" || driver.IsKIC(cc.Driver) || driver.SupportsVirtiofsMounts(cc.Driver) || cc.MountType == cluster.MountTypeVirtiofs {
 		return
 	}
 
@@ -96,6 +110,52 @@ func configureMounts(wg *sync.WaitGroup, cc config.ClusterConfig) {
 	}
 }
 
+// StartMount runs minikube mount to mount m into the primary control-plane node, and returns the pid of the
+// process serving 9p and nfs mounts in the background. The other mounts are done when StartMount returns, with a 0 pid.
+func StartMount(profile string, m config.Mount) (int, error) {
+	mountCmd := exec.Command(os.Args[0], mountArgs(profile, m)...)
+	mountCmd.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if m.Type == cluster.MountTypeBind || m.Type == cluster.MountTypeVirtiofs {
+		if output, err := mountCmd.CombinedOutput(); err != nil {
+			return 0, fmt.Errorf("%v: %s", err, output)
+		}
+		return 0, nil
+	}
+	if klog.V(8).Enabled() {
+		mountCmd.Stdout = os.Stdout
+		mountCmd.Stderr = os.Stderr
+	}
+	if err := mountCmd.Start(); err != nil {
+		return 0, err
+	}
+	pid := mountCmd.Process.Pid
+	return pid, lock.AppendToFile(filepath.Join(localpath.Profile(profile), constants.MountProcessFileName), []byte(fmt.Sprintf(" %s", strconv.Itoa(pid))), 0o644)
+}
+
+// mountArgs returns the arguments of the minikube mount process serving m
+func mountArgs(profile string, m config.Mount) []string {
+	mountDebugVal := 0
+	if klog.V(8).Enabled() {
+		mountDebugVal = 1
+	}
+
+	args := []string{"mount", m.HostPath + ":" + m.NodePath,
+		"--profile", profile,
+		"--v", fmt.Sprintf("%d", mountDebugVal),
+		fmt.Sprintf("--%s", constants.MountTypeFlag), m.Type,
+	}
+	if m.UID != "" {
+		args = append(args, fmt.Sprintf("--%s", constants.MountUIDFlag), m.UID)
+	}
+	if m.GID != "" {
+		args = append(args, fmt.Sprintf("--%s", constants.MountGIDFlag), m.GID)
+	}
+	for _, option := range m.Options {
+		args = append(args, fmt.Sprintf("--%s", constants.MountOptionsFlag), option)
+	}
+	return args
+}
+
 func generateMountArgs(profile string, cc config.ClusterConfig) []string {
 	mountDebugVal := 0
 	if klog.V(8).Enabled() {
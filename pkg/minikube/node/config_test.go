@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestMountArgs(t *testing.T) {
+	var tests = []struct {
+		name  string
+		mount config.Mount
+		want  []string
+	}{
+		{
+			name:  "defaults",
+			mount: config.Mount{HostPath: "/home/me/src", NodePath: "/src", Type: "9p"},
+			want:  []string{"mount", "/home/me/src:/src", "--profile", "p1", "--v", "0", "--type", "9p"},
+		},
+		{
+			name:  "everything",
+			mount: config.Mount{HostPath: "/home/me/data", NodePath: "/data", Type: "nfs", UID: "1000", GID: "1000", Options: []string{"ro", "vers=4"}, Persistent: true},
+			want: []string{"mount", "/home/me/data:/data", "--profile", "p1", "--v", "0", "--type", "nfs",
+				"--uid", "1000", "--gid", "1000", "--options", "ro", "--options", "vers=4"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, mountArgs("p1", tc.mount)); diff != "" {
+				t.Errorf("mountArgs diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
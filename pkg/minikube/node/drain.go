@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
+)
+
+// Cordon marks the node unschedulable
+func Cordon(ctx context.Context, cs kubernetes.Interface, name string) error {
+	return setUnschedulable(ctx, cs, name, true)
+}
+
+// Uncordon marks the node schedulable again
+func Uncordon(ctx context.Context, cs kubernetes.Interface, name string) error {
+	return setUnschedulable(ctx, cs, name, false)
+}
+
+func setUnschedulable(ctx context.Context, cs kubernetes.Interface, name string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	if _, err := cs.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), meta.PatchOptions{}); err != nil {
+		return fmt.Errorf("patch node %q: %w", name, err)
+	}
+	return nil
+}
+
+// evictable returns whether pod has to be evicted to drain its node, as kubectl drain does it skips
+// mirror and DaemonSet pods, and pods not managed by a controller are left alone as nothing would recreate them.
+func evictable(pod core.Pod) bool {
+	if pod.Status.Phase == core.PodSucceeded || pod.Status.Phase == core.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[core.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	ctrl := meta.GetControllerOf(&pod)
+	if ctrl == nil {
+		klog.Infof("leaving pod %s/%s in place, it is not managed by a controller", pod.Namespace, pod.Name)
+		return false
+	}
+	return ctrl.Kind != "DaemonSet"
+}
+
+// Drain evicts the pods running on the node, respecting their PodDisruptionBudgets, and waits for them to be gone
+func Drain(ctx context.Context, cs kubernetes.Interface, name string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	list, err := cs.CoreV1().Pods("").List(ctx, meta.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String()})
+	if err != nil {
+		return fmt.Errorf("list pods on node %q: %w", name, err)
+	}
+
+	pending := map[types.UID]core.Pod{}
+	for _, pod := range list.Items {
+		if pod.Spec.NodeName == name && evictable(pod) {
+			pending[pod.UID] = pod
+		}
+	}
+	klog.Infof("draining node %q: evicting %d pods", name, len(pending))
+
+	evicted := map[types.UID]bool{}
+	drained := func(ctx context.Context) (bool, error) {
+		for uid, pod := range pending {
+			if !evicted[uid] {
+				err := cs.CoreV1().Pods(pod.Namespace).EvictV1(ctx, &policy.Eviction{ObjectMeta: meta.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}})
+				switch {
+				case err == nil:
+					evicted[uid] = true
+				case apierrors.IsNotFound(err):
+					delete(pending, uid)
+					continue
+				case apierrors.IsTooManyRequests(err):
+					// blocked by a PodDisruptionBudget, try again later
+					klog.Infof("eviction of pod %s/%s is blocked (will retry): %v", pod.Namespace, pod.Name, err)
+					continue
+				default:
+					return false, fmt.Errorf("evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+				}
+			}
+
+			p, err := cs.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, meta.GetOptions{})
+			if apierrors.IsNotFound(err) || (err == nil && p.UID != uid) {
+				delete(pending, uid)
+			}
+		}
+		return len(pending) == 0, nil
+	}
+	if err := wait.PollUntilContextCancel(ctx, kconst.APICallRetryInterval, true, drained); err != nil {
+		return fmt.Errorf("drain node %q (%d pods left): %w", name, len(pending), err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testPod(name, owner string) *core.Pod {
+	pod := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name)},
+		Spec:       core.PodSpec{NodeName: "m02"},
+		Status:     core.PodStatus{Phase: core.PodRunning},
+	}
+	if owner != "" {
+		isController := true
+		pod.OwnerReferences = []meta.OwnerReference{{Kind: owner, Name: name, Controller: &isController}}
+	}
+	return pod
+}
+
+func TestEvictable(t *testing.T) {
+	mirror := testPod("kube-apiserver", "Node")
+	mirror.Annotations = map[string]string{core.MirrorPodAnnotationKey: "hash"}
+	done := testPod("job", "Job")
+	done.Status.Phase = core.PodSucceeded
+
+	tests := []struct {
+		pod  *core.Pod
+		want bool
+	}{
+		{testPod("web", "ReplicaSet"), true},
+		{testPod("db", "StatefulSet"), true},
+		{testPod("kube-proxy", "DaemonSet"), false},
+		{testPod("storage-provisioner", ""), false},
+		{mirror, false},
+		{done, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pod.Name, func(t *testing.T) {
+			if got := evictable(*tc.pod); got != tc.want {
+				t.Errorf("evictable(%s) = %v, want %v", tc.pod.Name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCordon(t *testing.T) {
+	cs := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "m02"}})
+	ctx := context.Background()
+
+	if err := Cordon(ctx, cs, "m02"); err != nil {
+		t.Fatalf("Cordon: %v", err)
+	}
+	node, err := cs.CoreV1().Nodes().Get(ctx, "m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if !node.Spec.Unschedulable {
+		t.Errorf("node is schedulable after Cordon")
+	}
+
+	if err := Uncordon(ctx, cs, "m02"); err != nil {
+		t.Fatalf("Uncordon: %v", err)
+	}
+	node, err = cs.CoreV1().Nodes().Get(ctx, "m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if node.Spec.Unschedulable {
+		t.Errorf("node is unschedulable after Uncordon")
+	}
+}
+
+func TestDrain(t *testing.T) {
+	cs := fake.NewSimpleClientset(testPod("web", "ReplicaSet"), testPod("kube-proxy", "DaemonSet"), testPod("storage-provisioner", ""))
+
+	// the first eviction is blocked, as if by a PodDisruptionBudget
+	blocked := true
+	cs.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		if blocked {
+			blocked = false
+			return true, nil, apierrors.NewTooManyRequests("disruption budget", 1)
+		}
+		name := action.(k8stesting.CreateAction).GetObject().(interface{ GetName() string }).GetName()
+		return true, nil, cs.Tracker().Delete(action.GetResource(), action.GetNamespace(), name)
+	})
+
+	if err := Drain(context.Background(), cs, "m02", 30*time.Second); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	pods, err := cs.CoreV1().Pods("").List(context.Background(), meta.ListOptions{})
+	if err != nil {
+		t.Fatalf("list pods: %v", err)
+	}
+	var left []string
+	for _, p := range pods.Items {
+		left = append(left, p.Name)
+	}
+	if len(left) != 2 || left[0] != "kube-proxy" || left[1] != "storage-provisioner" {
+		t.Errorf("pods left after Drain = %v, want [kube-proxy storage-provisioner]", left)
+	}
+}
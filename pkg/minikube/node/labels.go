@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidateLabels checks that labels can be applied to a Kubernetes node
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %q: %s", v, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// ValidateTaints checks that taints, in the key[=value]:effect form used by kubectl taint, can be applied to a Kubernetes node
+func ValidateTaints(taints []string) error {
+	for _, t := range taints {
+		if _, err := ParseTaint(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseTaint parses a taint in the key[=value]:effect form used by kubectl taint
+func ParseTaint(t string) (core.Taint, error) {
+	kv, effect, ok := strings.Cut(t, ":")
+	if !ok {
+		return core.Taint{}, fmt.Errorf("invalid taint %q: expected key[=value]:effect", t)
+	}
+	switch core.TaintEffect(effect) {
+	case core.TaintEffectNoSchedule, core.TaintEffectPreferNoSchedule, core.TaintEffectNoExecute:
+	default:
+		return core.Taint{}, fmt.Errorf("invalid taint %q: effect must be one of %s, %s or %s", t, core.TaintEffectNoSchedule, core.TaintEffectPreferNoSchedule, core.TaintEffectNoExecute)
+	}
+	k, v, _ := strings.Cut(kv, "=")
+	if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+		return core.Taint{}, fmt.Errorf("invalid taint key %q: %s", k, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+		return core.Taint{}, fmt.Errorf("invalid taint value %q: %s", v, strings.Join(errs, "; "))
+	}
+	return core.Taint{Key: k, Value: v, Effect: core.TaintEffect(effect)}, nil
+}
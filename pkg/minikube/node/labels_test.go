@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import "testing"
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		labels  map[string]string
+		wantErr bool
+	}{
+		{map[string]string{"accelerator": "gpu", "example.com/size": "large"}, false},
+		{map[string]string{"size": ""}, false},
+		{map[string]string{"bad key": "x"}, true},
+		{map[string]string{"size": "too large"}, true},
+	}
+	for _, tc := range tests {
+		if err := ValidateLabels(tc.labels); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateLabels(%v) = %v, want error: %v", tc.labels, err, tc.wantErr)
+		}
+	}
+}
+
+func TestValidateTaints(t *testing.T) {
+	tests := []struct {
+		taint   string
+		wantErr bool
+	}{
+		{"accelerator=gpu:NoSchedule", false},
+		{"dedicated:PreferNoSchedule", false},
+		{"example.com/maintenance=true:NoExecute", false},
+		{"accelerator=gpu", true},
+		{"accelerator=gpu:Never", true},
+		{"bad key=gpu:NoSchedule", true},
+	}
+	for _, tc := range tests {
+		if err := ValidateTaints([]string{tc.taint}); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateTaints(%q) = %v, want error: %v", tc.taint, err, tc.wantErr)
+		}
+	}
+}
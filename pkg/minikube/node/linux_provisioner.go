@@ -17,6 +17,7 @@ limitations under the License.
 package node
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"time"
@@ -39,7 +40,7 @@ type linuxProvisioner struct {
 // Compile-time assertion that linuxProvisioner implements the Provisioner interface.
 var _ Provisioner = (*linuxProvisioner)(nil)
 
-func (p *linuxProvisioner) Join() error {
+func (p *linuxProvisioner) Join(ctx context.Context) error {
 	joinCmd, err := p.controlplane.GenerateToken(*p.starter.Cfg)
 	if err != nil {
 		return fmt.Errorf("error generating join token: %w", err)
@@ -47,7 +48,7 @@ func (p *linuxProvisioner) Join() error {
 
 	join := func() error {
 		klog.Infof("trying to join %s node %q to cluster: %+v", p.starter.Node.Role(), p.starter.Node.Name, p.starter.Node)
-		if err := p.worker.JoinCluster(*p.starter.Cfg, *p.starter.Node, joinCmd); err != nil {
+		if err := p.worker.JoinCluster(ctx, *p.starter.Cfg, *p.starter.Node, joinCmd); err != nil {
 			klog.Errorf("%s node failed to join cluster, will retry: %v", p.starter.Node.Role(), err)
 
 			klog.Infof("resetting %s node %q before attempting to rejoin cluster...", p.starter.Node.Role(), p.starter.Node.Name)
@@ -90,8 +90,9 @@ func Add(cc *config.ClusterConfig, n config.Node, delOnFail bool, options *run.C
 }
 
 // teardown drains, then resets and finally deletes node from cluster.
+// The forced drain is skipped when drain is false, for nodes the caller already drained.
 // ref: https://kubernetes.io/docs/setup/production-environment/tools/kubeadm/create-cluster-kubeadm/#tear-down
-func teardown(cc config.ClusterConfig, name string, options *run.CommandOptions) (*config.Node, error) {
+func teardown(cc config.ClusterConfig, name string, drain bool, options *run.CommandOptions) (*config.Node, error) {
 	// get runner for named node - has to be done before node is drained
 	n, _, err := Retrieve(cc, name)
 	if err != nil {
@@ -114,20 +115,22 @@ func teardown(cc config.ClusterConfig, name string, options *run.CommandOptions)
 		return n, fmt.Errorf("get command runner: %w", err)
 	}
 
-	// get runner for healthy control-plane node
-	cpr := mustload.Healthy(cc.Name, options).CP.Runner
-
-	kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
-
-	// kubectl drain node with extra options to prevent ending up stuck in the process
-	// ref: https://kubernetes.io/docs/reference/generated/kubectl/kubectl-commands#drain
-	// ref: https://github.com/kubernetes/kubernetes/pull/95076
-	cmd := exec.Command("sudo", "KUBECONFIG=/var/lib/minikube/kubeconfig", kubectl, "drain", m,
-		"--force", "--grace-period=1", "--skip-wait-for-delete-timeout=1", "--disable-eviction", "--ignore-daemonsets", "--delete-emptydir-data")
-	if _, err := cpr.RunCmd(cmd); err != nil {
-		klog.Warningf("kubectl drain node %q failed (will continue): %v", m, err)
-	} else {
-		klog.Infof("successfully drained node %q", m)
+	if drain {
+		// get runner for healthy control-plane node
+		cpr := mustload.Healthy(cc.Name, options).CP.Runner
+
+		kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
+
+		// kubectl drain node with extra options to prevent ending up stuck in the process
+		// ref: https://kubernetes.io/docs/reference/generated/kubectl/kubectl-commands#drain
+		// ref: https://github.com/kubernetes/kubernetes/pull/95076
+		cmd := exec.Command("sudo", "KUBECONFIG=/var/lib/minikube/kubeconfig", kubectl, "drain", m,
+			"--force", "--grace-period=1", "--skip-wait-for-delete-timeout=1", "--disable-eviction", "--ignore-daemonsets", "--delete-emptydir-data")
+		if _, err := cpr.RunCmd(cmd); err != nil {
+			klog.Warningf("kubectl drain node %q failed (will continue): %v", m, err)
+		} else {
+			klog.Infof("successfully drained node %q", m)
+		}
 	}
 
 	// kubeadm reset node to revert any changes made by previous kubeadm init/join
@@ -186,29 +189,35 @@ func teardown(cc config.ClusterConfig, name string, options *run.CommandOptions)
 
 // Delete calls teardownNode to remove node from cluster and deletes the host.
 func Delete(cc config.ClusterConfig, name string, options *run.CommandOptions) (*config.Node, error) {
-	n, err := teardown(cc, name, options)
+	n, err := deleteHost(cc, name, true, options)
 	if err != nil {
 		return n, err
 	}
 
-	m := config.MachineName(cc, *n)
-	api, err := machine.NewAPIClient(options)
+	_, index, err := Retrieve(cc, name)
 	if err != nil {
-		return n, err
+		return n, fmt.Errorf("retrieve: %w", err)
 	}
 
-	err = machine.DeleteHost(api, m)
+	cc.Nodes = append(cc.Nodes[:index], cc.Nodes[index+1:]...)
+	return n, config.SaveProfile(viper.GetString(config.ProfileName), &cc)
+}
+
+// deleteHost removes the node from the cluster and deletes its host, leaving the cluster config untouched.
+// drain is passed on to teardown.
+func deleteHost(cc config.ClusterConfig, name string, drain bool, options *run.CommandOptions) (*config.Node, error) {
+	n, err := teardown(cc, name, drain, options)
 	if err != nil {
 		return n, err
 	}
 
-	_, index, err := Retrieve(cc, name)
+	m := config.MachineName(cc, *n)
+	api, err := machine.NewAPIClient(options)
 	if err != nil {
-		return n, fmt.Errorf("retrieve: %w", err)
+		return n, err
 	}
 
-	cc.Nodes = append(cc.Nodes[:index], cc.Nodes[index+1:]...)
-	return n, config.SaveProfile(viper.GetString(config.ProfileName), &cc)
+	return n, machine.DeleteHost(api, m)
 }
 
 // Retrieve finds the node by name in the given cluster
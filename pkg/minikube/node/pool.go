@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/delete"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/run"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// PoolLabel is set on the nodes of a node pool to the name of the pool
+const PoolLabel = "minikube.k8s.io/nodepool"
+
+// addNode and deleteNodeHost are replaced in tests
+var (
+	addNode        = Add
+	deleteNodeHost = deleteHost
+)
+
+// ValidatePoolName checks that name can be used as a node pool name, which is also the value of PoolLabel
+func ValidatePoolName(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid node pool name %q: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// FindPool returns the node pool with the given name
+func FindPool(cc config.ClusterConfig, name string) (*config.NodePool, error) {
+	for _, p := range cc.NodePools {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("node pool %q not found", name)
+}
+
+// PoolNodes returns the nodes of the pool, in the order they were added
+func PoolNodes(cc config.ClusterConfig, pool string) []config.Node {
+	var nodes []config.Node
+	for _, n := range cc.Nodes {
+		if n.Pool == pool {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// newPoolNodes returns the configs of count new nodes of the pool, numbered after the last node of the cluster
+func newPoolNodes(cc config.ClusterConfig, pool config.NodePool, count int) []config.Node {
+	lastID, err := ID(cc.Nodes[len(cc.Nodes)-1].Name)
+	if err != nil {
+		lastID = len(cc.Nodes)
+		klog.Warningf("determining last node index (will assume %d): %v", lastID, err)
+	}
+
+	labels := maps.Clone(pool.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[PoolLabel] = pool.Name
+
+	var nodes []config.Node
+	for i := 1; i <= count; i++ {
+		nodes = append(nodes, config.Node{
+			Name:              Name(lastID + i),
+			Worker:            true,
+			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			CPUs:              pool.CPUs,
+			Memory:            pool.Memory,
+			DiskSize:          pool.DiskSize,
+			Labels:            maps.Clone(labels),
+			Taints:            slices.Clone(pool.Taints),
+			Pool:              pool.Name,
+		})
+	}
+	return nodes
+}
+
+// ScalePool adds or removes nodes of the pool, in parallel, until it has count nodes.
+// Nodes are removed newest first, and only once their pods could be evicted within drainTimeout.
+func ScalePool(cc *config.ClusterConfig, pool config.NodePool, count int, drainTimeout time.Duration, options *run.CommandOptions) error {
+	nodes := PoolNodes(*cc, pool.Name)
+	switch {
+	case count > len(nodes):
+		return addPoolNodes(cc, pool, count-len(nodes), options)
+	case count < len(nodes):
+		return DeletePoolNodes(cc, nodes[count:], drainTimeout, options)
+	}
+	return nil
+}
+
+// DeletePoolNodes drains and deletes the given nodes in parallel, nodes which cannot be drained within drainTimeout are kept
+func DeletePoolNodes(cc *config.ClusterConfig, nodes []config.Node, drainTimeout time.Duration, options *run.CommandOptions) error {
+	cs, err := kapi.Client(cc.Name)
+	if err != nil {
+		return fmt.Errorf("k8s client: %w", err)
+	}
+	return removePoolNodes(cc, cs, nodes, drainTimeout, options)
+}
+
+// addPoolNodes starts count new nodes of the pool in parallel
+func addPoolNodes(cc *config.ClusterConfig, pool config.NodePool, count int, options *run.CommandOptions) error {
+	nodes := newPoolNodes(*cc, pool, count)
+
+	// register all the nodes up front, so that the parallel starts only ever update their own entry
+	cc.Nodes = append(cc.Nodes, nodes...)
+	if err := config.SaveProfile(viper.GetString(config.ProfileName), cc); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	// every start gets its own copy of the config, as starting a node updates it while reading the other nodes
+	copies := make([]*config.ClusterConfig, len(nodes))
+	for i := range nodes {
+		c, err := copyConfig(cc)
+		if err != nil {
+			return fmt.Errorf("copy config: %w", err)
+		}
+		copies[i] = c
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	failed := map[string]bool{}
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Go(func() {
+			err := addNode(copies[i], n, false, options)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("node %s: %w", n.Name, err))
+				failed[n.Name] = true
+				return
+			}
+			// merge the started node back, leaving the entries of the other nodes as they are
+			if err := mergeNode(cc, copies[i], n.Name); err != nil {
+				errs = append(errs, fmt.Errorf("node %s: %w", n.Name, err))
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	// do not leave half started nodes behind, so that the pool size stays accurate
+	api, err := machine.NewAPIClient(options)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("api client: %w", err))
+	} else {
+		for _, n := range nodes {
+			if !failed[n.Name] {
+				continue
+			}
+			if err := machine.DeleteHost(api, config.MachineName(*cc, n)); err != nil {
+				klog.Warningf("delete host of failed node %s: %v", n.Name, err)
+			}
+		}
+	}
+	cc.Nodes = slices.DeleteFunc(cc.Nodes, func(n config.Node) bool { return failed[n.Name] })
+	if err := config.SaveProfile(viper.GetString(config.ProfileName), cc); err != nil {
+		errs = append(errs, fmt.Errorf("save config: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// copyConfig returns a deep copy of cc
+func copyConfig(cc *config.ClusterConfig) (*config.ClusterConfig, error) {
+	data, err := json.Marshal(cc)
+	if err != nil {
+		return nil, err
+	}
+	c := &config.ClusterConfig{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// mergeNode copies the entry of the named node from started into cc, and saves cc
+func mergeNode(cc *config.ClusterConfig, started *config.ClusterConfig, name string) error {
+	n, _, err := Retrieve(*started, name)
+	if err != nil {
+		return err
+	}
+	for i := range cc.Nodes {
+		if cc.Nodes[i].Name == name {
+			cc.Nodes[i] = *n
+		}
+	}
+	return config.SaveProfile(viper.GetString(config.ProfileName), cc)
+}
+
+// removePoolNodes drains and deletes the nodes in parallel, nodes which cannot be drained are kept
+func removePoolNodes(cc *config.ClusterConfig, cs kubernetes.Interface, nodes []config.Node, drainTimeout time.Duration, options *run.CommandOptions) error {
+	var mu sync.Mutex
+	var errs []error
+	removed := map[string]bool{}
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Go(func() {
+			err := removePoolNode(*cc, cs, n, drainTimeout, options)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("node %s: %w", n.Name, err))
+				return
+			}
+			removed[n.Name] = true
+		})
+	}
+	wg.Wait()
+
+	cc.Nodes = slices.DeleteFunc(cc.Nodes, func(n config.Node) bool { return removed[n.Name] })
+	if err := config.SaveProfile(viper.GetString(config.ProfileName), cc); err != nil {
+		errs = append(errs, fmt.Errorf("save config: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// removePoolNode evicts the pods of the node, respecting their PodDisruptionBudgets, then deletes it
+func removePoolNode(cc config.ClusterConfig, cs kubernetes.Interface, n config.Node, drainTimeout time.Duration, options *run.CommandOptions) error {
+	ctx := context.Background()
+	name := config.MachineName(cc, n)
+
+	out.Styled(style.Stopping, "Draining node {{.node}} ...", out.V{"node": name})
+	err := Cordon(ctx, cs, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		klog.Infof("node %q never joined the cluster, nothing to drain", name)
+	case err != nil:
+		return err
+	default:
+		if err := Drain(ctx, cs, name, drainTimeout); err != nil {
+			// put the node back in service, the pods which could not be evicted keep running there
+			if uerr := Uncordon(ctx, cs, name); uerr != nil {
+				klog.Warningf("uncordon node %q: %v", name, uerr)
+			}
+			return err
+		}
+	}
+
+	out.Styled(style.DeletingHost, "Deleting node {{.node}} ...", out.V{"node": name})
+	// the node is drained already, respecting the PodDisruptionBudgets, do not force a second drain
+	if _, err := deleteNodeHost(cc, n.Name, false, options); err != nil {
+		return err
+	}
+	if driver.IsKIC(cc.Driver) {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+		delete.PossibleLeftOvers(ctx, name, cc.Driver)
+	}
+	return nil
+}
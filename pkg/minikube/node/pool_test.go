@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/run"
+)
+
+func TestValidatePoolName(t *testing.T) {
+	for _, name := range []string{"workers", "gpu-1"} {
+		if err := ValidatePoolName(name); err != nil {
+			t.Errorf("ValidatePoolName(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"", "Workers", "web_tier", "-workers"} {
+		if err := ValidatePoolName(name); err == nil {
+			t.Errorf("ValidatePoolName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestNewPoolNodes(t *testing.T) {
+	cc := config.ClusterConfig{
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.34.0"},
+		Nodes:            []config.Node{{Name: "", ControlPlane: true}, {Name: "m03", Worker: true}},
+	}
+	pool := config.NodePool{Name: "workers", CPUs: 4, Labels: map[string]string{"tier": "web"}, Taints: []string{"dedicated=web:NoSchedule"}}
+
+	nodes := newPoolNodes(cc, pool, 2)
+	if len(nodes) != 2 || nodes[0].Name != "m04" || nodes[1].Name != "m05" {
+		t.Fatalf("newPoolNodes() = %+v, want nodes m04 and m05", nodes)
+	}
+	for _, n := range nodes {
+		if !n.Worker || n.ControlPlane || n.Pool != "workers" || n.CPUs != 4 || n.KubernetesVersion != "v1.34.0" {
+			t.Errorf("node %s = %+v, want a v1.34.0 worker of pool workers with 4 CPUs", n.Name, n)
+		}
+		if n.Labels["tier"] != "web" || n.Labels[PoolLabel] != "workers" {
+			t.Errorf("node %s labels = %v, want tier=web and %s=workers", n.Name, n.Labels, PoolLabel)
+		}
+		if len(n.Taints) != 1 {
+			t.Errorf("node %s taints = %v, want the taints of the pool", n.Name, n.Taints)
+		}
+	}
+	if _, ok := pool.Labels[PoolLabel]; ok {
+		t.Errorf("newPoolNodes() modified the labels of the pool: %v", pool.Labels)
+	}
+}
+
+func TestAddPoolNodes(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	viper.Set(config.ProfileName, "p")
+	defer viper.Set(config.ProfileName, "")
+
+	// like Add, update the own node of the config while reading the others, run with -race
+	addNode = func(cc *config.ClusterConfig, n config.Node, _ bool, _ *run.CommandOptions) error {
+		if len(config.ControlPlanes(*cc)) != 1 {
+			return fmt.Errorf("want one control-plane, got %+v", cc.Nodes)
+		}
+		n.IP = "192.168.49." + strings.TrimPrefix(n.Name, "m")
+		return config.SaveNode(cc, &n)
+	}
+	defer func() { addNode = Add }()
+
+	cc := &config.ClusterConfig{
+		Name:             "p",
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.34.0"},
+		Nodes:            []config.Node{{Name: "", IP: "192.168.49.2", ControlPlane: true}},
+		NodePools:        []config.NodePool{{Name: "workers"}},
+	}
+	if err := addPoolNodes(cc, cc.NodePools[0], 3, &run.CommandOptions{}); err != nil {
+		t.Fatalf("addPoolNodes() = %v", err)
+	}
+
+	saved, err := config.Load("p")
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	for _, c := range []*config.ClusterConfig{cc, saved} {
+		nodes := PoolNodes(*c, "workers")
+		if len(nodes) != 3 {
+			t.Fatalf("pool nodes = %+v, want 3", nodes)
+		}
+		for _, n := range nodes {
+			if want := "192.168.49." + strings.TrimPrefix(n.Name, "m"); n.IP != want {
+				t.Errorf("node %s IP = %q, want %q", n.Name, n.IP, want)
+			}
+		}
+	}
+}
+
+func TestRemovePoolNodes(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	viper.Set(config.ProfileName, "p")
+	defer viper.Set(config.ProfileName, "")
+
+	var deleted []string
+	deleteNodeHost = func(_ config.ClusterConfig, name string, _ bool, _ *run.CommandOptions) (*config.Node, error) {
+		deleted = append(deleted, name)
+		return nil, nil
+	}
+	defer func() { deleteNodeHost = deleteHost }()
+
+	cc := &config.ClusterConfig{
+		Name: "p",
+		Nodes: []config.Node{
+			{Name: "", ControlPlane: true},
+			{Name: "m02", Worker: true, Pool: "workers"},
+			{Name: "m03", Worker: true, Pool: "workers"},
+		},
+		NodePools: []config.NodePool{{Name: "workers"}},
+	}
+	guarded := testPod("db", "StatefulSet")
+	guarded.Spec.NodeName = "p-m03"
+	cs := fake.NewSimpleClientset(
+		&core.Node{ObjectMeta: meta.ObjectMeta{Name: "p-m02"}},
+		&core.Node{ObjectMeta: meta.ObjectMeta{Name: "p-m03"}},
+		guarded,
+	)
+	// the pod on m03 is protected by a PodDisruptionBudget which never allows its eviction
+	cs.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewTooManyRequests("disruption budget", 1)
+	})
+
+	if err := removePoolNodes(cc, cs, PoolNodes(*cc, "workers"), 2*time.Second, &run.CommandOptions{}); err == nil {
+		t.Errorf("removePoolNodes() = nil, want the drain of m03 to fail")
+	}
+
+	if len(deleted) != 1 || deleted[0] != "m02" {
+		t.Errorf("deleted hosts = %v, want [m02]", deleted)
+	}
+	if got := PoolNodes(*cc, "workers"); len(got) != 1 || got[0].Name != "m03" {
+		t.Errorf("pool nodes = %+v, want [m03]", got)
+	}
+	n, err := cs.CoreV1().Nodes().Get(context.Background(), "p-m03", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if n.Spec.Unschedulable {
+		t.Errorf("node p-m03 is left cordoned after its drain failed")
+	}
+
+	saved, err := config.Load("p")
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	if len(saved.Nodes) != 2 {
+		t.Errorf("saved nodes = %+v, want the primary and m03", saved.Nodes)
+	}
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package node
 
+import "context"
+
 // Provisioner encapsulates OS-specific node lifecycle operations using a strategy pattern.
 // Different OS implementations (Linux, Windows) use the same interface but with different
 // join mechanisms and readiness verification strategies.
@@ -36,7 +38,7 @@ type Provisioner interface {
 	//
 	// Windows: Executes kubeadm join similarly but does not perform kubeadm reset on failure.
 	// Windows nodes require additional API server registration time (handled by retry in LabelAndUntaint).
-	Join() error
+	Join(ctx context.Context) error
 
 	// LabelAndUntaint applies labels and removes taints after join is complete.
 	// Preconditions: node registered with apiserver
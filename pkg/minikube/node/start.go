@@ -17,13 +17,16 @@ limitations under the License.
 package node
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"net"
 	"os"
 	"os/exec"
 	"path"
 	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -59,14 +62,18 @@ import (
 	"k8s.io/minikube/pkg/minikube/logs"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/netshape"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/proxy"
 	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/registryauth"
+	"k8s.io/minikube/pkg/minikube/registrycache"
 	"k8s.io/minikube/pkg/minikube/run"
 	"k8s.io/minikube/pkg/minikube/style"
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/network"
+	"k8s.io/minikube/pkg/trace"
 	"k8s.io/minikube/pkg/util"
 	"k8s.io/minikube/pkg/util/retry"
 	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
@@ -77,6 +84,8 @@ const waitTimeout = "wait-timeout"
 var (
 	kicGroup   errgroup.Group
 	cacheGroup errgroup.Group
+	// downloadMu guards kicGroup and cacheGroup while the nodes of a pool are started in parallel
+	downloadMu sync.Mutex
 )
 
 // Starter is a struct with all the necessary information to start a node
@@ -93,6 +102,9 @@ type Starter struct {
 
 // Start spins up a guest and starts the Kubernetes node.
 func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings, error) { // nolint:gocyclo
+	ctx, span := trace.Start(context.Background(), "node.Start", traceAttributes(*starter.Cfg, *starter.Node)...)
+	defer span.End()
+
 	var wg sync.WaitGroup
 	stopk8s, err := handleNoKubernetes(starter)
 	if err != nil {
@@ -100,16 +112,18 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 	}
 	if stopk8s {
 		nv := semver.Version{Major: 0, Minor: 0, Patch: 0}
-		cr := configureRuntimes(starter.Runner, *starter.Cfg, nv)
+		cr := configureRuntimes(starter.Runner, starter.Host, *starter.Cfg, nv)
 
 		showNoK8sVersionInfo(cr)
 
-		configureMounts(&wg, *starter.Cfg)
+		configureMounts(&wg, *starter.Cfg, *starter.Node)
 		return nil, config.Write(viper.GetString(config.ProfileName), starter.Cfg)
 	}
 
 	// wait for preloaded tarball to finish downloading before configuring runtimes
+	downloadMu.Lock()
 	waitCacheRequiredImages(&cacheGroup)
+	downloadMu.Unlock()
 
 	sv, err := util.ParseKubernetesVersion(starter.Node.KubernetesVersion)
 	if err != nil {
@@ -117,7 +131,7 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 	}
 
 	// configure the runtime (docker, containerd, crio)
-	cr := configureRuntimes(starter.Runner, *starter.Cfg, sv)
+	cr := configureRuntimes(starter.Runner, starter.Host, *starter.Cfg, sv)
 
 	// check if installed runtime is compatible with current minikube code
 	if err = cruntime.CheckCompatibility(cr); err != nil {
@@ -134,11 +148,20 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 		klog.Errorf("Unable to add minikube host alias: %v", err)
 	}
 
+	// hand the registry credentials of the host over to the kubelet before it pulls any image (intentionally non-fatal)
+	if starter.Cfg.HostCredentials {
+		if n, err := registryauth.CopyHostCredentials(starter.Runner); err != nil {
+			out.WarningT("Unable to copy the registry credentials of the host: {{.error}}", out.V{"error": err})
+		} else {
+			klog.Infof("copied the credentials of %d registries to the kubelet", n)
+		}
+	}
+
 	var kcs *kubeconfig.Settings
 	var bs bootstrapper.Bootstrapper
 	if config.IsPrimaryControlPlane(*starter.Cfg, *starter.Node) {
 		// [re]start primary control-plane node
-		kcs, bs, err = startPrimaryControlPlane(starter, cr, options)
+		kcs, bs, err = startPrimaryControlPlane(ctx, starter, cr, options)
 		if err != nil {
 			return nil, err
 		}
@@ -168,7 +191,7 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 
 		// for ha (multi-control plane) cluster, use already running control-plane node to copy over certs to this secondary control-plane node
 		cpr := mustload.Running(starter.Cfg.Name, options).CP.Runner
-		if err = bs.SetupCerts(*starter.Cfg, *starter.Node, cpr); err != nil {
+		if err = bs.SetupCerts(ctx, *starter.Cfg, *starter.Node, cpr); err != nil {
 			return nil, fmt.Errorf("setting up certs: %w", err)
 		}
 
@@ -184,13 +207,13 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 			if err != nil {
 				return nil, fmt.Errorf("get primary control-plane bootstrapper: %w", err)
 			}
-			if err := joinCluster(starter, pcpBs, bs, options); err != nil {
+			if err := joinCluster(ctx, starter, pcpBs, bs, options); err != nil {
 				return nil, fmt.Errorf("join node to cluster: %w", err)
 			}
 		}
 	}
 
-	go configureMounts(&wg, *starter.Cfg)
+	go configureMounts(&wg, *starter.Cfg, *starter.Node)
 
 	wg.Go(func() {
 		profile, err := config.LoadProfile(starter.Cfg.Name)
@@ -211,7 +234,7 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 		}
 		list := addons.ToEnable(starter.Cfg, starter.ExistingAddons, addonList)
 		wg.Add(1)
-		go addons.Enable(&wg, starter.Cfg, list, enabledAddons, options)
+		go addons.Enable(ctx, &wg, starter.Cfg, list, enabledAddons, options)
 	}
 
 	// special ops for "none" driver on control-plane node, like change minikube directory
@@ -224,11 +247,18 @@ func Start(starter Starter, options *run.CommandOptions) (*kubeconfig.Settings,
 		klog.Infof("HA (multi-control plane) cluster: will skip waiting for primary control-plane node %+v", starter.Node)
 	} else {
 		klog.Infof("Will wait %s for node %+v", viper.GetDuration(waitTimeout), starter.Node)
-		if err := bs.WaitForNode(*starter.Cfg, *starter.Node, viper.GetDuration(waitTimeout)); err != nil {
+		if err := bs.WaitForNode(ctx, *starter.Cfg, *starter.Node, viper.GetDuration(waitTimeout)); err != nil {
 			return nil, fmt.Errorf("wait %s for node: %w", viper.GetDuration(waitTimeout), err)
 		}
 	}
 
+	// shape the network once the node is up, so that it does not slow down the start itself
+	if starter.Node.NetworkShape != nil {
+		if err := netshape.ApplyNode(starter.Runner, *starter.Node); err != nil {
+			out.WarningT("Unable to shape the network of node {{.name}}: {{.error}}", out.V{"name": config.MachineName(*starter.Cfg, *starter.Node), "error": err})
+		}
+	}
+
 	klog.Infof("waiting for startup goroutines ...")
 	wg.Wait()
 
@@ -265,7 +295,7 @@ func handleNoKubernetes(starter Starter) (bool, error) {
 }
 
 // startPrimaryControlPlane starts control-plane node.
-func startPrimaryControlPlane(starter Starter, cr cruntime.Manager, options *run.CommandOptions) (*kubeconfig.Settings, bootstrapper.Bootstrapper, error) {
+func startPrimaryControlPlane(ctx context.Context, starter Starter, cr cruntime.Manager, options *run.CommandOptions) (*kubeconfig.Settings, bootstrapper.Bootstrapper, error) {
 	if !config.IsPrimaryControlPlane(*starter.Cfg, *starter.Node) {
 		return nil, nil, errors.New("node not marked as primary control-plane")
 	}
@@ -283,12 +313,12 @@ func startPrimaryControlPlane(starter Starter, cr cruntime.Manager, options *run
 	kcs := setupKubeconfig(*starter.Host, *starter.Cfg, *starter.Node, starter.Cfg.Name)
 
 	// setup kubeadm (must come after setupKubeconfig)
-	bs, err := setupKubeadm(starter.MachineAPI, *starter.Cfg, *starter.Node, starter.Runner)
+	bs, err := setupKubeadm(ctx, starter.MachineAPI, *starter.Cfg, *starter.Node, starter.Runner)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed to setup kubeadm: %w", err)
 	}
 
-	if err := bs.StartCluster(*starter.Cfg, options); err != nil {
+	if err := bs.StartCluster(ctx, *starter.Cfg, options); err != nil {
 		ExitIfFatal(err, false)
 		out.LogEntries("Error starting cluster", err, logs.FindProblems(cr, bs, *starter.Cfg, starter.Runner))
 		return nil, bs, err
@@ -303,7 +333,7 @@ func startPrimaryControlPlane(starter Starter, cr cruntime.Manager, options *run
 }
 
 // joinCluster adds new or prepares and then adds existing node to the cluster.
-func joinCluster(starter Starter, cpBs bootstrapper.Bootstrapper, bs bootstrapper.Bootstrapper, options *run.CommandOptions) error {
+func joinCluster(ctx context.Context, starter Starter, cpBs bootstrapper.Bootstrapper, bs bootstrapper.Bootstrapper, options *run.CommandOptions) error {
 	start := time.Now()
 	klog.Infof("joinCluster: %+v", starter.Cfg)
 	defer func() {
@@ -314,7 +344,7 @@ func joinCluster(starter Starter, cpBs bootstrapper.Bootstrapper, bs bootstrappe
 	// You must delete the existing Node or change the name of this new joining Node"
 	if starter.PreExists {
 		klog.Infof("removing existing %s node %q before attempting to rejoin cluster: %+v", starter.Node.Role(), starter.Node.Name, starter.Node)
-		if _, err := teardown(*starter.Cfg, starter.Node.Name, options); err != nil {
+		if _, err := teardown(*starter.Cfg, starter.Node.Name, true, options); err != nil {
 			klog.Errorf("error removing existing %s node %q before rejoining cluster, will continue anyway: %v", starter.Node.Role(), starter.Node.Name, err)
 		}
 		klog.Infof("successfully removed existing %s node %q from cluster: %+v", starter.Node.Role(), starter.Node.Name, starter.Node)
@@ -322,7 +352,7 @@ func joinCluster(starter Starter, cpBs bootstrapper.Bootstrapper, bs bootstrappe
 
 	p := &linuxProvisioner{starter: starter, controlplane: cpBs, worker: bs}
 
-	if err := p.Join(); err != nil {
+	if err := p.Join(ctx); err != nil {
 		return err
 	}
 	if err := p.LabelAndUntaint(); err != nil {
@@ -336,6 +366,8 @@ func joinCluster(starter Starter, cpBs bootstrapper.Bootstrapper, bs bootstrappe
 func Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool, options *run.CommandOptions) (command.Runner, bool, libmachine.API, *host.Host, error) {
 	register.Reg.SetStep(register.StartingNode)
 	name := config.MachineName(*cc, *n)
+	ctx, span := trace.Start(context.Background(), "node.Provision", traceAttributes(*cc, *n)...)
+	defer span.End()
 
 	// Be explicit with each case for the sake of translations
 	if cc.KubernetesConfig.KubernetesVersion == constants.NoKubernetesVersion {
@@ -351,17 +383,19 @@ func Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool, options
 		out.Step(style.ThumbsUp, "Starting \"{{.node}}\" {{.role}} node in \"{{.cluster}}\" cluster", out.V{"node": name, "role": role, "cluster": cc.Name})
 	}
 
+	downloadMu.Lock()
 	if driver.IsKIC(cc.Driver) {
 		beginDownloadKicBaseImage(&kicGroup, cc, options.DownloadOnly)
 	}
 
 	if !driver.BareMetal(cc.Driver) {
-		beginCacheKubernetesImages(&cacheGroup, cc.KubernetesConfig.ImageRepository, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
+		beginCacheKubernetesImages(ctx, &cacheGroup, cc.KubernetesConfig.ImageRepository, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
 	}
 
 	// Abstraction leakage alert: startHost requires the config to be saved, to satisfy pkg/provision/buildroot.
 	// Hence, SaveProfile must be called before startHost, and again afterwards when we know the IP.
 	if err := config.SaveProfile(viper.GetString(config.ProfileName), cc); err != nil {
+		downloadMu.Unlock()
 		return nil, false, nil, nil, fmt.Errorf("Failed to save config: %w", err)
 	}
 
@@ -369,12 +403,53 @@ func Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool, options
 	if driver.IsKIC(cc.Driver) {
 		waitDownloadKicBaseImage(&kicGroup)
 	}
+	downloadMu.Unlock()
+
+	return startMachine(ctx, cc, n, delOnFail, options)
+}
 
-	return startMachine(cc, n, delOnFail, options)
+// registryCacheMirrors starts the registry caches of the cluster on the host, where the node reaches the host
+func registryCacheMirrors(cc config.ClusterConfig, h *host.Host) []cruntime.Mirror {
+	registries := cc.RegistryCache
+	if cc.KubernetesConfig.ContainerRuntime == constants.Docker {
+		// docker only mirrors docker.io, so the caches of the other registries would never be used
+		others := slices.DeleteFunc(slices.Clone(registries), func(r string) bool { return r == "docker.io" })
+		if len(others) > 0 {
+			out.WarningT("Not using the registry cache of {{.registries}}, as the docker runtime only mirrors docker.io", out.V{"registries": strings.Join(others, ", ")})
+		}
+		registries = slices.DeleteFunc(slices.Clone(registries), func(r string) bool { return r != "docker.io" })
+		if len(registries) > 0 && len(cc.RegistryMirror) > 0 {
+			// dockerd refuses to start when registry mirrors are set both by flag and in daemon.json
+			out.WarningT("Not using the registry cache of docker.io, as --registry-mirror is set")
+			registries = nil
+		}
+	}
+	if len(registries) == 0 {
+		return nil
+	}
+	ociBin, err := registrycache.OCIBin(cc.Driver)
+	if err != nil {
+		out.WarningT("Not using the registry cache: {{.error}}", out.V{"error": err})
+		return nil
+	}
+	ip, err := cluster.ListenIP(h, cc.Name)
+	if err != nil {
+		out.WarningT("Not using the registry cache: {{.error}}", out.V{"error": err})
+		return nil
+	}
+	urls, err := registrycache.Mirrors(ociBin, ip.String(), registries)
+	if err != nil {
+		out.WarningT("Failed to start the registry cache, pulling from the registries instead: {{.error}}", out.V{"error": err})
+	}
+	var mirrors []cruntime.Mirror
+	for _, registry := range slices.Sorted(maps.Keys(urls)) {
+		mirrors = append(mirrors, cruntime.Mirror{Registry: registry, Upstream: registrycache.Upstream(registry), URL: urls[registry]})
+	}
+	return mirrors
 }
 
 // ConfigureRuntimes does what needs to happen to get a runtime going.
-func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, kv semver.Version) cruntime.Manager {
+func configureRuntimes(runner cruntime.CommandRunner, h *host.Host, cc config.ClusterConfig, kv semver.Version) cruntime.Manager {
 	co := cruntime.Config{
 		Type:              cc.KubernetesConfig.ContainerRuntime,
 		Socket:            cc.KubernetesConfig.CRISocket,
@@ -383,6 +458,8 @@ func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, k
 		ImageRepository:   cc.KubernetesConfig.ImageRepository,
 		KubernetesVersion: kv,
 		InsecureRegistry:  cc.InsecureRegistry,
+		Mirrors:           registryCacheMirrors(cc, h),
+		ConfigPatch:       cc.RuntimeConfigPatch,
 	}
 	if cc.GPUs != "" {
 		co.GPUs = cc.GPUs
@@ -420,7 +497,8 @@ func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, k
 			Runner:            co.Runner,
 			ImageRepository:   co.ImageRepository,
 			KubernetesVersion: co.KubernetesVersion,
-			InsecureRegistry:  co.InsecureRegistry})
+			InsecureRegistry:  co.InsecureRegistry,
+			Mirrors:           co.Mirrors})
 		if err == nil {
 			err = containerd.Enable(false, cgroupDriver(cc), inUserNamespace) // do not disableOthers, as it's not primary cr
 		}
@@ -430,18 +508,23 @@ func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, k
 	}
 
 	disableOthers := !driver.BareMetal(cc.Driver)
+	// a runtime failing with the configuration patch of the user is most likely failing because of it
+	enableReason := reason.RuntimeEnable
+	if cc.RuntimeConfigPatch != "" {
+		enableReason = reason.RuntimeConfigPatch
+	}
 	if err = cr.Enable(disableOthers, cgroupDriver(cc), inUserNamespace); err != nil {
-		exit.Error(reason.RuntimeEnable, "Failed to enable container runtime", err)
+		exit.Error(enableReason, "Failed to enable container runtime", err)
 	}
 
 	// Wait for the CRI to be "live", before returning it
 	if err = waitForCRISocket(runner, cr.SocketPath(), 60, 1); err != nil {
-		exit.Error(reason.RuntimeEnable, "Failed to start container runtime", err)
+		exit.Error(enableReason, "Failed to start container runtime", err)
 	}
 
 	// Wait for the CRI to actually work, before returning
 	if err = waitForCRIVersion(runner, cr.SocketPath(), 60, 10); err != nil {
-		exit.Error(reason.RuntimeEnable, "Failed to start container runtime", err)
+		exit.Error(enableReason, "Failed to start container runtime", err)
 	}
 
 	return cr
@@ -559,7 +642,7 @@ func waitForCRIVersion(runner cruntime.CommandRunner, socket string, wait int, i
 }
 
 // setupKubeadm adds any requested files into the VM before Kubernetes is started.
-func setupKubeadm(mAPI libmachine.API, cfg config.ClusterConfig, n config.Node, r command.Runner) (bootstrapper.Bootstrapper, error) {
+func setupKubeadm(ctx context.Context, mAPI libmachine.API, cfg config.ClusterConfig, n config.Node, r command.Runner) (bootstrapper.Bootstrapper, error) {
 	deleteOnFailure := viper.GetBool("delete-on-failure")
 	bs, err := cluster.Bootstrapper(mAPI, viper.GetString(cmdcfg.Bootstrapper), cfg, r)
 	if err != nil {
@@ -576,7 +659,7 @@ func setupKubeadm(mAPI libmachine.API, cfg config.ClusterConfig, n config.Node,
 	// Loads cached images, generates config files, download binaries
 	// update cluster and set up certs
 
-	if err := bs.UpdateCluster(cfg); err != nil {
+	if err := bs.UpdateCluster(ctx, cfg); err != nil {
 		if !deleteOnFailure {
 			if errors.Is(err, cruntime.ErrContainerRuntimeNotRunning) {
 				exit.Error(reason.KubernetesInstallFailedRuntimeNotRunning, "Failed to update cluster", err)
@@ -587,7 +670,7 @@ func setupKubeadm(mAPI libmachine.API, cfg config.ClusterConfig, n config.Node,
 		return nil, err
 	}
 
-	if err := bs.SetupCerts(cfg, n, r); err != nil {
+	if err := bs.SetupCerts(ctx, cfg, n, r); err != nil {
 		if !deleteOnFailure {
 			exit.Error(reason.GuestCert, "Failed to setup certs", err)
 		}
@@ -630,12 +713,12 @@ func setupKubeconfig(h host.Host, cc config.ClusterConfig, n config.Node, cluste
 }
 
 // StartMachine starts a VM
-func startMachine(cfg *config.ClusterConfig, node *config.Node, delOnFail bool, options *run.CommandOptions) (runner command.Runner, preExists bool, machineAPI libmachine.API, hostInfo *host.Host, err error) {
+func startMachine(ctx context.Context, cfg *config.ClusterConfig, node *config.Node, delOnFail bool, options *run.CommandOptions) (runner command.Runner, preExists bool, machineAPI libmachine.API, hostInfo *host.Host, err error) {
 	m, err := machine.NewAPIClient(options)
 	if err != nil {
 		return runner, preExists, m, hostInfo, fmt.Errorf("Failed to get machine client: %w", err)
 	}
-	hostInfo, preExists, err = startHostInternal(m, cfg, node, delOnFail)
+	hostInfo, preExists, err = startHostInternal(ctx, m, cfg, node, delOnFail)
 	if err != nil {
 		return runner, preExists, m, hostInfo, fmt.Errorf("Failed to start host: %w", err)
 	}
@@ -685,8 +768,8 @@ func getPort() (int, error) {
 }
 
 // startHostInternal starts a new minikube host using a VM or None
-func startHostInternal(api libmachine.API, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (*host.Host, bool, error) {
-	hostInfo, exists, err := machine.StartHost(api, cc, n)
+func startHostInternal(ctx context.Context, api libmachine.API, cc *config.ClusterConfig, n *config.Node, delOnFail bool) (*host.Host, bool, error) {
+	hostInfo, exists, err := startHost(ctx, api, cc, n)
 	if err == nil {
 		return hostInfo, exists, nil
 	}
@@ -719,7 +802,7 @@ func startHostInternal(api libmachine.API, cc *config.ClusterConfig, n *config.N
 		}
 	}
 
-	hostInfo, exists, err = machine.StartHost(api, cc, n)
+	hostInfo, exists, err = startHost(ctx, api, cc, n)
 	if err == nil {
 		return hostInfo, exists, nil
 	}
@@ -730,6 +813,26 @@ func startHostInternal(api libmachine.API, cc *config.ClusterConfig, n *config.N
 	return hostInfo, exists, err
 }
 
+// startHost traces a single attempt of machine.StartHost
+func startHost(ctx context.Context, api libmachine.API, cc *config.ClusterConfig, n *config.Node) (*host.Host, bool, error) {
+	_, span := trace.Start(ctx, "machine.StartHost")
+	defer span.End()
+	hostInfo, exists, err := machine.StartHost(api, cc, n)
+	span.SetAttributes(trace.String("exists", strconv.FormatBool(exists)))
+	span.RecordError(err)
+	return hostInfo, exists, err
+}
+
+// traceAttributes describes the node being started in trace spans
+func traceAttributes(cc config.ClusterConfig, n config.Node) []trace.Attribute {
+	return []trace.Attribute{
+		trace.String("driver", cc.Driver),
+		trace.String("runtime", cc.KubernetesConfig.ContainerRuntime),
+		trace.String("kubernetes.version", n.KubernetesVersion),
+		trace.String("node", config.MachineName(cc, n)),
+	}
+}
+
 // validateNetwork tries to catch network problems as soon as possible
 func validateNetwork(h *host.Host, r command.Runner, imageRepository string) (string, error) {
 	ip, err := h.Driver.GetIP()
@@ -69,3 +69,9 @@ func PrintWarning(warning string) {
 	w := NewWarning(warning)
 	printAndRecordCloudEvent(w, w.data)
 }
+
+// PrintTunnelEvent prints a TunnelEvent type in JSON format
+func PrintTunnelEvent(kind string, data map[string]string) {
+	e := NewTunnelEvent(kind, data)
+	printAsCloudEvent(e, e.data)
+}
@@ -117,3 +117,21 @@ func TestWarning(t *testing.T) {
 
 	tests.CompareJSON(t, actual, []byte(expected))
 }
+
+func TestTunnelEvent(t *testing.T) {
+	expected := `{"data":{"ip":"10.96.0.10","service":"default/nginx"},"datacontenttype":"application/json","id":"random-id","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.tunnel.service.patched"}`
+	expected += "\n"
+
+	buf := bytes.NewBuffer([]byte{})
+	SetOutputFile(buf)
+	defer func() { SetOutputFile(os.Stdout) }()
+
+	GetUUID = func() string {
+		return "random-id"
+	}
+
+	PrintTunnelEvent(TunnelServicePatched, map[string]string{"service": "default/nginx", "ip": "10.96.0.10"})
+	actual := buf.Bytes()
+
+	tests.CompareJSON(t, actual, []byte(expected))
+}
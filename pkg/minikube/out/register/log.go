@@ -154,3 +154,33 @@ func NewErrorExitCode(err string, exitcode int, additionalData ...map[string]str
 func (s *Error) Type() string {
 	return "io.k8s.sigs.minikube.error"
 }
+
+// Tunnel event kinds, appended to the tunnel event type
+const (
+	TunnelRouteAdded     = "route.added"
+	TunnelRouteRemoved   = "route.removed"
+	TunnelRouteConflict  = "route.conflict"
+	TunnelServicePatched = "service.patched"
+	TunnelServiceCleared = "service.cleared"
+	TunnelHostState      = "host.state"
+)
+
+// TunnelEvent will be used to notify the user of changes to a running tunnel
+type TunnelEvent struct {
+	kind string
+	data map[string]string
+}
+
+// Type returns the cloud events compatible type of this struct
+func (s *TunnelEvent) Type() string {
+	return "io.k8s.sigs.minikube.tunnel." + s.kind
+}
+
+// NewTunnelEvent returns a new TunnelEvent type
+func NewTunnelEvent(kind string, data map[string]string) *TunnelEvent {
+	d := map[string]string{}
+	for k, v := range data {
+		d[k] = strings.TrimSpace(v)
+	}
+	return &TunnelEvent{kind: kind, data: d}
+}
@@ -56,6 +56,11 @@ const (
 	PowerOff  RegStep = "PowerOff"
 	Pausing   RegStep = "Pausing"
 	Unpausing RegStep = "Unpausing"
+
+	// Upgrading
+	Upgrading             RegStep = "Upgrading Kubernetes"
+	UpgradingControlPlane RegStep = "Upgrading Control Plane Nodes"
+	UpgradingWorkers      RegStep = "Upgrading Worker Nodes"
 )
 
 // RegStep is a type representing a distinct step of `minikube start`
@@ -103,6 +108,7 @@ func init() {
 			Pausing:   {Pausing, Done},
 			Unpausing: {Unpausing, Done},
 			Deleting:  {Deleting, Stopping, Done, Purging},
+			Upgrading: {Upgrading, UpgradingControlPlane, UpgradingWorkers, Done},
 		},
 	}
 }
@@ -136,7 +142,7 @@ func (r *Register) currentStep() string {
 
 // SetStep sets the current step
 func (r *Register) SetStep(s RegStep) {
-	defer trace.StartSpan(string(s))
+	defer trace.StartStep(string(s))
 	if r.first == RegStep("") {
 		_, ok := r.steps[s]
 		if ok {
@@ -144,8 +150,6 @@ func (r *Register) SetStep(s RegStep) {
 		} else {
 			klog.Errorf("unexpected first step: %q", r.first)
 		}
-	} else {
-		trace.EndSpan(string(r.current))
 	}
 
 	r.current = s
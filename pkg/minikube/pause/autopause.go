@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+// AutoPauseStatus is the state reported by the auto-pause daemon on its /status endpoint
+type AutoPauseStatus struct {
+	// Paused is true if the daemon has paused the cluster
+	Paused bool `json:"paused"`
+	// PausedSince is when the cluster was last paused by the daemon, zero while unpaused
+	PausedSince time.Time `json:"pausedSince,omitzero"`
+	// LastActivity is when the daemon last saw an apiserver request or busy workloads
+	LastActivity time.Time `json:"lastActivity"`
+	// Pauses is the number of times the daemon paused the cluster
+	Pauses int `json:"pauses"`
+	// Unpauses is the number of times the daemon unpaused the cluster
+	Unpauses int `json:"unpauses"`
+}
+
+// QueryAutoPause returns the state of the auto-pause daemon listening on port inside the node
+func QueryAutoPause(r command.Runner, port int) (*AutoPauseStatus, error) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/status", port)
+	rr, err := r.RunCmd(exec.Command("curl", "-sSf", "--max-time", "2", url))
+	if err != nil {
+		return nil, fmt.Errorf("query auto-pause: %w", err)
+	}
+	st := &AutoPauseStatus{}
+	if err := json.Unmarshal(rr.Stdout.Bytes(), st); err != nil {
+		return nil, fmt.Errorf("unmarshal auto-pause status: %w", err)
+	}
+	return st, nil
+}
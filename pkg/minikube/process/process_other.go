@@ -20,6 +20,7 @@ package process
 
 import (
 	"os"
+	"os/exec"
 	"syscall"
 )
 
@@ -51,3 +52,8 @@ func killPid(pid int) error {
 	}
 	return p.Kill()
 }
+
+// Detach makes cmd start in its own session, so that it outlives the calling terminal.
+func Detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
@@ -18,6 +18,10 @@ package process
 
 import (
 	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
 )
 
 func pidExists(pid int) (bool, error) {
@@ -38,3 +42,8 @@ func killPid(pid int) error {
 	}
 	return p.Kill()
 }
+
+// Detach makes cmd start without a console, so that it outlives the calling terminal.
+func Detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: windows.DETACHED_PROCESS | windows.CREATE_NEW_PROCESS_GROUP}
+}
@@ -92,6 +92,8 @@ var (
 
 	// minikube failed to update internal configuration, such as the cached images config map
 	InternalAddConfig = Kind{ID: "MK_ADD_CONFIG", ExitCode: ExProgramError}
+	// minikube failed to query the audit log
+	InternalAuditQuery = Kind{ID: "MK_AUDIT_QUERY", ExitCode: ExProgramError}
 	// minikube failed to create a cluster bootstrapper
 	InternalBootstrapper = Kind{ID: "MK_BOOTSTRAPPER", ExitCode: ExProgramError}
 	// minikube failed to list cached images
@@ -278,6 +280,8 @@ var (
 	HostKubeconfigDeleteCtx = Kind{ID: "HOST_KUBECONFIG_DELETE_CTX", ExitCode: ExHostConfig}
 	// minikube failed to launch a kubectl proxy
 	HostKubectlProxy = Kind{ID: "HOST_KUBECTL_PROXY", ExitCode: ExHostError}
+	// minikube failed to export a directory with the NFS server of the host
+	HostMountNFS = Kind{ID: "HOST_MOUNT_NFS", ExitCode: ExHostError}
 	// minikube failed to write mount pid
 	HostMountPid = Kind{ID: "HOST_MOUNT_PID", ExitCode: ExHostError}
 	// minikube was passed a path to a host directory that does not exist
@@ -286,6 +290,14 @@ var (
 	HostPathStat = Kind{ID: "HOST_PATH_STAT", ExitCode: ExHostError}
 	// minikube failed to purge minikube config directories
 	HostPurge = Kind{ID: "HOST_PURGE", ExitCode: ExHostError}
+	// minikube failed to manage the registry caches on the host
+	HostRegistryCache = Kind{ID: "HOST_REGISTRY_CACHE", ExitCode: ExHostError}
+	// minikube failed to read the registry credentials of the host
+	HostRegistryCreds = Kind{
+		ID:       "HOST_REGISTRY_CREDS",
+		ExitCode: ExHostConfig,
+		Advice:   translate.T("Check that the credentials files are in the docker config.json format, and that the docker-credential helpers they name are in the PATH."),
+	}
 	// minikube failed to persist profile config
 	HostSaveProfile = Kind{ID: "HOST_SAVE_PROFILE", ExitCode: ExHostConfig}
 	// Host doesn't support 9p
@@ -374,6 +386,31 @@ var (
 	GuestNodeAdd = Kind{ID: "GUEST_NODE_ADD", ExitCode: ExGuestError}
 	// minikube failed to remove a node from the cluster
 	GuestNodeDelete = Kind{ID: "GUEST_NODE_DELETE", ExitCode: ExGuestError}
+	// minikube failed to scale a node pool
+	GuestNodePoolScale = Kind{
+		ID:       "GUEST_NODE_POOL_SCALE",
+		ExitCode: ExGuestError,
+		Advice: translate.T(`Nodes whose pods could not be evicted, for example because of a PodDisruptionBudget, are kept in the pool.
+Check the budgets with "kubectl get pdb -A", or increase --drain-timeout, then run "minikube nodepool scale" again.`),
+	}
+	// minikube failed to shape the network of a node
+	GuestNetworkShape = Kind{ID: "GUEST_NETWORK_SHAPE", ExitCode: ExGuestError}
+	// the kernel of a node lacks the modules needed to shape its network
+	GuestNetworkShapeModule = Kind{
+		ID:       "GUEST_NETWORK_SHAPE_MODULE",
+		ExitCode: ExGuestUnsupported,
+		Advice: translate.T(`With the docker and podman drivers, the nodes share the kernel of the host, which needs the sch_netem and sch_tbf modules:
+
+	sudo modprobe -a sch_netem sch_tbf
+
+With the other drivers, the ISO of the node was built without them. Upgrade minikube, then run "minikube delete" and "minikube start" to get a newer ISO.`),
+	}
+	// minikube failed to inject or revert a chaos fault
+	GuestChaos = Kind{
+		ID:       "GUEST_CHAOS",
+		ExitCode: ExGuestError,
+		Advice:   translate.T(`Run "minikube chaos list" to see the faults still in place, and "minikube chaos recover" to revert them.`),
+	}
 	// minikube failed to provision a node
 	GuestNodeProvision = Kind{ID: "GUEST_NODE_PROVISION", ExitCode: ExGuestError}
 	// minikube failed to retrieve information for a cluster node
@@ -388,12 +425,20 @@ var (
 	GuestProvision = Kind{ID: "GUEST_PROVISION", ExitCode: ExGuestError}
 	// docker container exited prematurely during provisioning
 	GuestProvisionContainerExited = Kind{ID: "GUEST_PROVISION_CONTAINER_EXITED", ExitCode: ExGuestError}
+	// minikube failed to list the snapshots of a cluster
+	GuestSnapshotList = Kind{ID: "GUEST_SNAPSHOT_LIST", ExitCode: ExGuestError}
+	// minikube failed to restore a snapshot of a cluster
+	GuestSnapshotRestore = Kind{ID: "GUEST_SNAPSHOT_RESTORE", ExitCode: ExGuestError}
+	// minikube failed to save a snapshot of a cluster
+	GuestSnapshotSave = Kind{ID: "GUEST_SNAPSHOT_SAVE", ExitCode: ExGuestError}
 	// minikube failed to start a node with current driver
 	GuestStart = Kind{ID: "GUEST_START", ExitCode: ExGuestError}
 	// minikube failed to get docker machine status
 	GuestStatus = Kind{ID: "GUEST_STATUS", ExitCode: ExGuestError}
 	// stopping the cluster process timed out
 	GuestStopTimeout = Kind{ID: "GUEST_STOP_TIMEOUT", ExitCode: ExGuestTimeout}
+	// minikube failed to sync a directory with the nodes
+	GuestSync = Kind{ID: "GUEST_SYNC", ExitCode: ExGuestError}
 	// minikube failed to unpause the cluster process
 	GuestUnpause = Kind{ID: "GUEST_UNPAUSE", ExitCode: ExGuestError}
 	// minikube failed to check if Kubernetes containers are paused
@@ -411,6 +456,8 @@ var (
 	IfMountIP = Kind{ID: "IF_MOUNT_IP", ExitCode: ExLocalNetworkError}
 	// minikube failed to parse or find port for mount
 	IfMountPort = Kind{ID: "IF_MOUNT_PORT", ExitCode: ExLocalNetworkError}
+	// minikube failed to serve the cluster autoscaler cloud provider on the host
+	IfAutoscaler = Kind{ID: "IF_AUTOSCALER", ExitCode: ExLocalNetworkError}
 	// minikube failed to access an ssh client on the host machine
 	IfSSHClient = Kind{ID: "IF_SSH_CLIENT", ExitCode: ExLocalNetworkError}
 	// minikube failed to create a dedicated network
@@ -443,6 +490,10 @@ var (
 
 	// minikube failed to enable the current container runtime
 	RuntimeEnable = Kind{ID: "RUNTIME_ENABLE", ExitCode: ExRuntimeError}
+	// minikube failed to enable the current container runtime with the configuration patch of the user
+	RuntimeConfigPatch = Kind{ID: "RUNTIME_CONFIG_PATCH", ExitCode: ExRuntimeError,
+		Advice: translate.T("Check the file given to --runtime-config-patch against the logs of the container runtime, from 'minikube ssh -- sudo journalctl -xeu <runtime>', then run 'minikube start --runtime-config-patch' with a fixed file, or with an empty value to remove the patch."),
+	}
 	// minikube failed to cache images for the current container runtime
 	RuntimeCache = Kind{ID: "RUNTIME_CACHE", ExitCode: ExRuntimeError}
 	// minikube failed to start an ssh-agent when executing docker-env
@@ -460,6 +511,8 @@ var (
 	SvcTunnelStart = Kind{ID: "SVC_TUNNEL_START", ExitCode: ExSvcError}
 	// minikube could not stop an active tunnel
 	SvcTunnelStop = Kind{ID: "SVC_TUNNEL_STOP", ExitCode: ExSvcError}
+	// minikube could not query the status of a running tunnel
+	SvcTunnelStatus = Kind{ID: "SVC_TUNNEL_STATUS", ExitCode: ExSvcError}
 	// another instance of tunnel already running
 	SvcTunnelAlreadyRunning = Kind{ID: "TUNNEL_ALREADY_RUNNING", ExitCode: ExSvcConflict, Style: style.Usage}
 	// minikube was unable to access the service url
@@ -508,6 +561,12 @@ var (
 		`),
 		Style: style.SeeNoEvil,
 	}
+	// minikube failed to upgrade the Kubernetes version of a node
+	KubernetesUpgrade = Kind{
+		ID:       "K8S_UPGRADE_FAILED",
+		ExitCode: ExControlPlaneError,
+		Advice:   translate.T("Nodes that were upgraded already are recorded in the profile, run the same 'minikube upgrade' command again to resume from the node that failed"),
+	}
 
 	NotFoundCriDockerd = Kind{
 		ID:       "NOT_FOUND_CRI_DOCKERD",
@@ -35,6 +35,7 @@ import (
 	"k8s.io/minikube/pkg/libmachine/drivers"
 
 	"k8s.io/minikube/pkg/drivers/common/mac"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/drivers/kvm"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/download"
@@ -72,6 +73,15 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	macAddr := mac.FromName(name)
 	privateMACAddr := mac.FromName(name + "-private")
 	klog.Infof("Using mac address %s, private mac address %s", macAddr, privateMACAddr)
+
+	var mounts []*virtiofs.Mount
+	if cc.MountType == virtiofs.MountType {
+		var err error
+		if mounts, err = virtiofs.ValidateMountString(cc.MountString); err != nil {
+			return nil, err
+		}
+	}
+
 	return kvm.Driver{
 		BaseDriver: &drivers.BaseDriver{
 			MachineName: name,
@@ -93,6 +103,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		ConnectionURI:  cc.KVMQemuURI,
 		NUMANodeCount:  cc.KVMNUMACount,
 		ExtraDisks:     cc.ExtraDisks,
+		VirtiofsMounts: mounts,
 	}, nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/drivers/common/mac"
+	"k8s.io/minikube/pkg/drivers/common/virtiofs"
 	"k8s.io/minikube/pkg/drivers/qemu"
 	"k8s.io/minikube/pkg/libmachine/drivers"
 
@@ -166,6 +167,13 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	macAddr := mac.FromName(name)
 	klog.Infof("Using mac address %s", macAddr)
 
+	var mounts []*virtiofs.Mount
+	if cc.MountType == virtiofs.MountType {
+		if mounts, err = virtiofs.ValidateMountString(cc.MountString); err != nil {
+			return nil, err
+		}
+	}
+
 	return qemu.Driver{
 		BaseDriver: &drivers.BaseDriver{
 			MachineName: name,
@@ -192,6 +200,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		SocketVMNetPath:       cc.SocketVMnetPath,
 		SocketVMNetClientPath: cc.SocketVMnetClientPath,
 		ExtraDisks:            cc.ExtraDisks,
+		VirtiofsMounts:        mounts,
 	}, nil
 }
 
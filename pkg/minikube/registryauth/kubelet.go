@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryauth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+// KubeletConfig is the file the kubelet reads the credentials to pull images with from,
+// whatever the container runtime
+const KubeletConfig = "/var/lib/kubelet/config.json"
+
+// HostPodmanConfig returns the auth file of podman on the host, which is in $REGISTRY_AUTH_FILE,
+// or in the containers directory of $XDG_RUNTIME_DIR on Linux and of ~/.config elsewhere
+func HostPodmanConfig() (DockerConfig, error) {
+	if f := os.Getenv("REGISTRY_AUTH_FILE"); f != "" {
+		return DockerConfig{Path: f}, nil
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" && runtime.GOOS == "linux" {
+		return DockerConfig{Path: filepath.Join(dir, "containers", "auth.json")}, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return DockerConfig{}, err
+	}
+	return DockerConfig{Path: filepath.Join(home, ".config", "containers", "auth.json")}, nil
+}
+
+// HostSources returns the docker and podman configs of the host that exist, podman overriding docker
+func HostSources() ([]Source, error) {
+	var sources []Source
+	for _, config := range []func() (DockerConfig, error){HostDockerConfig, HostPodmanConfig} {
+		d, err := config()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(d.Path); errors.Is(err, os.ErrNotExist) {
+			klog.Infof("skipping %s, which does not exist", d.Path)
+			continue
+		}
+		sources = append(sources, d)
+	}
+	return sources, nil
+}
+
+// CopyHostCredentials writes the registry credentials of the host into the kubelet config of the node,
+// and returns how many registries it holds credentials for
+func CopyHostCredentials(r command.Runner) (int, error) {
+	sources, err := HostSources()
+	if err != nil {
+		return 0, err
+	}
+	creds, err := Merge(sources...)
+	if err != nil {
+		return 0, err
+	}
+	data, err := DockerConfigJSON(creds)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.Copy(assets.NewMemoryAssetTarget(data, KubeletConfig, "0600")); err != nil {
+		return 0, fmt.Errorf("copy %s: %w", KubeletConfig, err)
+	}
+	return len(creds), nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryauth
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+func TestCopyHostCredentials(t *testing.T) {
+	fakeHelpers(t, nil)
+	dockerDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dockerDir, "config.json"), []byte(`{"auths": {"a.io": {"username": "docker", "password": "1"}, "b.io": {"username": "docker", "password": "1"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	podmanAuth := filepath.Join(t.TempDir(), "auth.json")
+	if err := os.WriteFile(podmanAuth, []byte(`{"auths": {"b.io": {"username": "podman", "password": "2"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("DOCKER_CONFIG", dockerDir)
+	t.Setenv("REGISTRY_AUTH_FILE", podmanAuth)
+
+	r := command.NewFakeCommandRunner()
+	n, err := CopyHostCredentials(r)
+	if err != nil || n != 2 {
+		t.Fatalf("CopyHostCredentials() = %d, %v, want 2 registries", n, err)
+	}
+	data, err := r.GetFileToContents(assets.NewMemoryAssetTarget(nil, KubeletConfig, "0600").GetSourcePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := writeConfig(t, data).Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Credential{{Server: "a.io", Username: "docker", Password: "1"}, {Server: "b.io", Username: "podman", Password: "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("kubelet config credentials = %+v, want %+v", got, want)
+	}
+
+	// hosts without any config get an empty kubelet config, dropping the credentials copied earlier
+	t.Setenv("DOCKER_CONFIG", t.TempDir())
+	t.Setenv("REGISTRY_AUTH_FILE", filepath.Join(t.TempDir(), "missing.json"))
+	if n, err := CopyHostCredentials(r); err != nil || n != 0 {
+		t.Errorf("CopyHostCredentials() without configs = %d, %v, want 0 registries", n, err)
+	}
+}
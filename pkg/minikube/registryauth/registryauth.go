@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registryauth reads container registry credentials, from docker config files and credential helpers,
+// so that they can be handed over to the cluster
+package registryauth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// tokenUsername is the username of credential helpers returning an identity token instead of a password,
+// which the kubelet cannot use
+const tokenUsername = "<token>"
+
+// Credential is the username and password of a registry
+type Credential struct {
+	// Server is the host of the registry, as found in image references, or the URL of Docker Hub
+	Server   string
+	Username string
+	Password string
+}
+
+// Source provides registry credentials
+type Source interface {
+	// Credentials returns the credentials of the source, resolving them again on every call
+	Credentials() ([]Credential, error)
+}
+
+// authEntry is an entry of the auths of a docker config
+type authEntry struct {
+	Auth          string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+}
+
+// dockerConfig is the part of a docker config.json holding credentials
+type dockerConfig struct {
+	Auths       map[string]authEntry `json:"auths"`
+	CredsStore  string               `json:"credsStore,omitempty"`
+	CredHelpers map[string]string    `json:"credHelpers,omitempty"`
+}
+
+// helperCredential is the output of the get action of a credential helper
+type helperCredential struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// runHelper runs an action of a docker credential helper, such as "get", with the input on stdin
+var runHelper = func(helper, action, input string) ([]byte, error) {
+	cmd := exec.Command("docker-credential-"+helper, action)
+	cmd.Stdin = strings.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("docker-credential-%s %s: %w: %s", helper, action, err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// DockerConfig is a file in the format of the docker config.json, whose credentials are either inline in its auths,
+// or held by the credential helpers it names
+type DockerConfig struct {
+	Path string
+}
+
+// HostDockerConfig returns the docker config of the host, which is in $DOCKER_CONFIG or ~/.docker
+func HostDockerConfig() (DockerConfig, error) {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return DockerConfig{Path: filepath.Join(dir, "config.json")}, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return DockerConfig{}, err
+	}
+	return DockerConfig{Path: filepath.Join(home, ".docker", "config.json")}, nil
+}
+
+// Credentials implements Source, asking the credential helpers for the credentials they hold
+func (d DockerConfig) Credentials() ([]Credential, error) {
+	data, err := os.ReadFile(d.Path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", d.Path, err)
+	}
+
+	creds := map[string]Credential{}
+	for server, a := range cfg.Auths {
+		c, err := a.credential(server)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Path, err)
+		}
+		if c != nil {
+			creds[server] = *c
+		}
+	}
+
+	// the credentials store holds the credentials of every registry without a helper of its own,
+	// the auths then only list the registries logged in to
+	if cfg.CredsStore != "" {
+		servers, err := storeServers(cfg.CredsStore)
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			if _, ok := cfg.CredHelpers[server]; ok {
+				continue
+			}
+			if err := addHelperCredential(creds, cfg.CredsStore, server); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for server, helper := range cfg.CredHelpers {
+		if err := addHelperCredential(creds, helper, server); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted(creds), nil
+}
+
+// credential returns the credential of an auths entry, or nil if it has none the kubelet can use
+func (a authEntry) credential(server string) (*Credential, error) {
+	c := Credential{Server: server, Username: a.Username, Password: a.Password}
+	if a.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(a.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("decode the auth of %s: %w", server, err)
+		}
+		user, pass, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, fmt.Errorf("the auth of %s is not in the username:password form", server)
+		}
+		c.Username, c.Password = user, pass
+	}
+	if c.Username == "" && c.Password == "" {
+		if a.IdentityToken != "" {
+			klog.Warningf("skipping the identity token of %s, which cannot be used to pull images", server)
+		}
+		return nil, nil
+	}
+	return &c, nil
+}
+
+// storeServers returns the servers a credentials store holds credentials for
+func storeServers(store string) ([]string, error) {
+	out, err := runHelper(store, "list", "")
+	if err != nil {
+		return nil, err
+	}
+	var list map[string]string
+	if err := json.Unmarshal(out, &list); err != nil {
+		return nil, fmt.Errorf("parse the output of docker-credential-%s list: %w", store, err)
+	}
+	var servers []string
+	for server := range list {
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// addHelperCredential asks the helper for the credential of the server
+func addHelperCredential(creds map[string]Credential, helper, server string) error {
+	out, err := runHelper(helper, "get", server)
+	if err != nil {
+		return err
+	}
+	var hc helperCredential
+	if err := json.Unmarshal(out, &hc); err != nil {
+		return fmt.Errorf("parse the output of docker-credential-%s get: %w", helper, err)
+	}
+	if hc.Username == tokenUsername {
+		klog.Warningf("skipping the identity token of %s, which cannot be used to pull images", server)
+		return nil
+	}
+	creds[server] = Credential{Server: server, Username: hc.Username, Password: hc.Secret}
+	return nil
+}
+
+func sorted(creds map[string]Credential) []Credential {
+	list := make([]Credential, 0, len(creds))
+	for _, c := range creds {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Server < list[j].Server })
+	return list
+}
+
+// Merge returns the credentials of all the sources, the later sources overriding the earlier ones for the same server
+func Merge(sources ...Source) ([]Credential, error) {
+	creds := map[string]Credential{}
+	var errs []error
+	for _, s := range sources {
+		list, err := s.Credentials()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, c := range list {
+			creds[c.Server] = c
+		}
+	}
+	return sorted(creds), errors.Join(errs...)
+}
+
+// DockerConfigJSON returns the credentials in the format of the .dockerconfigjson of pull secrets,
+// which is also the format of the config.json read by the kubelet
+func DockerConfigJSON(creds []Credential) ([]byte, error) {
+	cfg := dockerConfig{Auths: map[string]authEntry{}}
+	for _, c := range creds {
+		cfg.Auths[c.Server] = authEntry{
+			Username: c.Username,
+			Password: c.Password,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password)),
+		}
+	}
+	return json.Marshal(cfg)
+}
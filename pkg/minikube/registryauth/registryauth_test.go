@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryauth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeConfig(t *testing.T, cfg string) DockerConfig {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(cfg), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return DockerConfig{Path: path}
+}
+
+// fakeHelpers replaces the credential helpers with ones answering from the given credentials, by helper and server
+func fakeHelpers(t *testing.T, helpers map[string]map[string]helperCredential) {
+	orig := runHelper
+	t.Cleanup(func() { runHelper = orig })
+	runHelper = func(helper, action, input string) ([]byte, error) {
+		creds, ok := helpers[helper]
+		if !ok {
+			return nil, fmt.Errorf("docker-credential-%s: not found", helper)
+		}
+		switch action {
+		case "list":
+			list := map[string]string{}
+			for server, c := range creds {
+				list[server] = c.Username
+			}
+			return json.Marshal(list)
+		case "get":
+			c, ok := creds[input]
+			if !ok {
+				return nil, fmt.Errorf("credentials not found in native keychain")
+			}
+			return json.Marshal(c)
+		}
+		return nil, fmt.Errorf("unknown action %s", action)
+	}
+}
+
+func TestDockerConfigCredentials(t *testing.T) {
+	fakeHelpers(t, map[string]map[string]helperCredential{
+		"desktop": {
+			"https://index.docker.io/v1/": {Username: "hubuser", Secret: "hubpass"},
+			"quay.io":                     {Username: "<token>", Secret: "identity"},
+		},
+		"ecr-login": {
+			"123456789012.dkr.ecr.us-east-1.amazonaws.com": {Username: "AWS", Secret: "ecrtoken"},
+		},
+	})
+	auth := base64.StdEncoding.EncodeToString([]byte("ci:s3cret"))
+	d := writeConfig(t, `{
+	"auths": {
+		"registry.example.com": {"auth": "`+auth+`"},
+		"ghcr.io": {"username": "bot", "password": "ghp"},
+		"https://index.docker.io/v1/": {},
+		"token.example.com": {"identitytoken": "abc"}
+	},
+	"credsStore": "desktop",
+	"credHelpers": {"123456789012.dkr.ecr.us-east-1.amazonaws.com": "ecr-login"}
+}`)
+
+	got, err := d.Credentials()
+	if err != nil {
+		t.Fatalf("Credentials: %v", err)
+	}
+	want := []Credential{
+		{Server: "123456789012.dkr.ecr.us-east-1.amazonaws.com", Username: "AWS", Password: "ecrtoken"},
+		{Server: "ghcr.io", Username: "bot", Password: "ghp"},
+		{Server: "https://index.docker.io/v1/", Username: "hubuser", Password: "hubpass"},
+		{Server: "registry.example.com", Username: "ci", Password: "s3cret"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Credentials() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDockerConfigErrors(t *testing.T) {
+	fakeHelpers(t, nil)
+	for name, cfg := range map[string]string{
+		"invalid json":     `{"auths":`,
+		"invalid auth":     `{"auths": {"r.io": {"auth": "not base64!"}}}`,
+		"auth without pwd": `{"auths": {"r.io": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("user")) + `"}}}`,
+		"missing helper":   `{"credHelpers": {"r.io": "missing"}}`,
+	} {
+		if _, err := writeConfig(t, cfg).Credentials(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := (DockerConfig{Path: filepath.Join(t.TempDir(), "missing.json")}).Credentials(); err == nil {
+		t.Error("expected an error reading a missing config")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	fakeHelpers(t, nil)
+	first := writeConfig(t, `{"auths": {"a.io": {"username": "a", "password": "1"}, "b.io": {"username": "b", "password": "1"}}}`)
+	second := writeConfig(t, `{"auths": {"b.io": {"username": "b", "password": "2"}}}`)
+	missing := DockerConfig{Path: filepath.Join(t.TempDir(), "missing.json")}
+
+	got, err := Merge(first, missing, second)
+	if err == nil {
+		t.Error("expected the error of the missing config")
+	}
+	want := []Credential{{Server: "a.io", Username: "a", Password: "1"}, {Server: "b.io", Username: "b", Password: "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDockerConfigJSON(t *testing.T) {
+	fakeHelpers(t, nil)
+	creds := []Credential{{Server: "a.io", Username: "a", Password: "p:w"}}
+	data, err := DockerConfigJSON(creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the output is a docker config itself
+	got, err := writeConfig(t, string(data)).Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, creds) {
+		t.Errorf("round trip = %+v, want %+v", got, creds)
+	}
+}
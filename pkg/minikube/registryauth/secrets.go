@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryauth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typed_core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// PullSecretName is the name of the pull secret holding the credentials in the namespaces
+const PullSecretName = "registry-creds-dockerconfig"
+
+// defaultServiceAccount is the service account of the pods that do not name one
+const defaultServiceAccount = "default"
+
+// SyncPullSecrets creates or updates the pull secret in the namespaces, and adds it to the image pull secrets
+// of their default service account, the way the registry-creds addon does with the secrets of its providers.
+// The namespaces that do not exist yet are skipped, and get the secret on a later sync.
+func SyncPullSecrets(ctx context.Context, client typed_core.CoreV1Interface, namespaces []string, dockerConfigJSON []byte) error {
+	var errs []error
+	for _, name := range namespaces {
+		ns, err := client.Namespaces().Get(ctx, name, meta.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			klog.Infof("namespace %s does not exist yet", name)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("namespace %s: %w", name, err))
+			continue
+		}
+		if ns.Status.Phase == core.NamespaceTerminating {
+			continue
+		}
+		if err := syncPullSecret(ctx, client, name, dockerConfigJSON); err != nil {
+			errs = append(errs, fmt.Errorf("namespace %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func syncPullSecret(ctx context.Context, client typed_core.CoreV1Interface, namespace string, dockerConfigJSON []byte) error {
+	secrets := client.Secrets(namespace)
+	secret, err := secrets.Get(ctx, PullSecretName, meta.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		secret = &core.Secret{
+			ObjectMeta: meta.ObjectMeta{
+				Name:   PullSecretName,
+				Labels: map[string]string{"app": "registry-creds", "kubernetes.io/minikube-addons": "registry-creds"},
+			},
+			Type: core.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{core.DockerConfigJsonKey: dockerConfigJSON},
+		}
+		if _, err := secrets.Create(ctx, secret, meta.CreateOptions{}); err != nil {
+			return fmt.Errorf("create secret: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("get secret: %w", err)
+	case !bytes.Equal(secret.Data[core.DockerConfigJsonKey], dockerConfigJSON):
+		secret.Data = map[string][]byte{core.DockerConfigJsonKey: dockerConfigJSON}
+		if _, err := secrets.Update(ctx, secret, meta.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update secret: %w", err)
+		}
+	}
+
+	serviceaccounts := client.ServiceAccounts(namespace)
+	sa, err := serviceaccounts.Get(ctx, defaultServiceAccount, meta.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// the service account controller has not created it yet
+		klog.Infof("namespace %s has no %s service account yet", namespace, defaultServiceAccount)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get service account: %w", err)
+	}
+	if slices.ContainsFunc(sa.ImagePullSecrets, func(r core.LocalObjectReference) bool { return r.Name == PullSecretName }) {
+		return nil
+	}
+	sa.ImagePullSecrets = append(sa.ImagePullSecrets, core.LocalObjectReference{Name: PullSecretName})
+	if _, err := serviceaccounts.Update(ctx, sa, meta.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update service account: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryauth
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSyncPullSecrets(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(
+		&core.Namespace{ObjectMeta: meta.ObjectMeta{Name: "default"}},
+		&core.Namespace{ObjectMeta: meta.ObjectMeta{Name: "kube-system"}},
+		&core.Namespace{ObjectMeta: meta.ObjectMeta{Name: "gone"}, Status: core.NamespaceStatus{Phase: core.NamespaceTerminating}},
+		&core.ServiceAccount{
+			ObjectMeta:       meta.ObjectMeta{Name: "default", Namespace: "default"},
+			ImagePullSecrets: []core.LocalObjectReference{{Name: "own"}},
+		},
+		&core.ServiceAccount{ObjectMeta: meta.ObjectMeta{Name: "builder", Namespace: "default"}},
+	)
+
+	// a namespace that does not exist yet is skipped
+	namespaces := []string{"default", "gone", "later"}
+	for _, data := range []string{`{"auths":{}}`, `{"auths":{"a.io":{}}}`} {
+		if err := SyncPullSecrets(ctx, client.CoreV1(), namespaces, []byte(data)); err != nil {
+			t.Fatalf("SyncPullSecrets: %v", err)
+		}
+		secret, err := client.CoreV1().Secrets("default").Get(ctx, PullSecretName, meta.GetOptions{})
+		if err != nil {
+			t.Fatalf("get secret: %v", err)
+		}
+		if secret.Type != core.SecretTypeDockerConfigJson || string(secret.Data[core.DockerConfigJsonKey]) != data {
+			t.Errorf("secret = %s %q, want %s %q", secret.Type, secret.Data[core.DockerConfigJsonKey], core.SecretTypeDockerConfigJson, data)
+		}
+	}
+
+	if _, err := client.CoreV1().Secrets("gone").Get(ctx, PullSecretName, meta.GetOptions{}); err == nil {
+		t.Error("a terminating namespace should not get the secret")
+	}
+	if _, err := client.CoreV1().Secrets("kube-system").Get(ctx, PullSecretName, meta.GetOptions{}); err == nil {
+		t.Error("a namespace that did not opt in should not get the secret")
+	}
+
+	// only the default service account gets the secret, and syncing twice must not add it twice
+	for name, want := range map[string][]string{"default": {"own", PullSecretName}, "builder": nil} {
+		sa, err := client.CoreV1().ServiceAccounts("default").Get(ctx, name, meta.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, s := range sa.ImagePullSecrets {
+			got = append(got, s.Name)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("image pull secrets of %s = %v, want %v", name, got, want)
+		}
+	}
+
+	// a namespace that opts in later gets the secret on the next sync
+	if _, err := client.CoreV1().Namespaces().Create(ctx, &core.Namespace{ObjectMeta: meta.ObjectMeta{Name: "later"}}, meta.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SyncPullSecrets(ctx, client.CoreV1(), namespaces, []byte(`{"auths":{}}`)); err != nil {
+		t.Fatalf("SyncPullSecrets: %v", err)
+	}
+	if _, err := client.CoreV1().Secrets("later").Get(ctx, PullSecretName, meta.GetOptions{}); err != nil {
+		t.Errorf("a namespace created since the last sync should get the secret: %v", err)
+	}
+}
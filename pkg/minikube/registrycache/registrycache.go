@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registrycache runs pull-through registry caches on the host, shared by all the profiles
+package registrycache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+const (
+	// firstPort is the host port of the first cache, the next caches get the next ports
+	firstPort = 5100
+	// registryPort is the port the registry listens on inside of its container
+	registryPort = 5000
+	// dataDir is where the registry stores the cached blobs inside of its container
+	dataDir = "/var/lib/registry"
+	// Label marks the containers and volumes of the caches
+	Label = "minikube.sigs.k8s.io/registry-cache"
+)
+
+// Cache is the pull-through cache of one upstream registry
+type Cache struct {
+	// Registry is the upstream registry, as it appears in image names, e.g. docker.io
+	Registry string
+	// Port is the port the cache is published on, on the host
+	Port int
+	// OCIBin is the container engine the cache runs in
+	OCIBin string
+	// Addresses are the IPs of the host the cache is published on, where the nodes of the clusters reach the host
+	Addresses []string `json:",omitempty"`
+}
+
+// Name is the name of the container and of the volume of the cache
+func (c Cache) Name() string {
+	return "minikube-registry-cache-" + strings.NewReplacer(":", "-", "/", "-").Replace(c.Registry)
+}
+
+// MirrorURL is the URL the nodes reach the cache at
+func (c Cache) MirrorURL() string {
+	return fmt.Sprintf("http://%s:%d", constants.HostAlias, c.Port)
+}
+
+// Upstream returns the URL of the registry that serves the images of the registry name
+func Upstream(registry string) string {
+	if registry == "docker.io" {
+		return "https://registry-1.docker.io"
+	}
+	return "https://" + registry
+}
+
+// image returns the image of the registry, which is kept in sync with the registry addon
+func image() string {
+	a := assets.Addons["registry"]
+	return path.Join(a.Registries["Registry"], a.Images["Registry"])
+}
+
+// runCmd runs the container engine, stubbed out by the tests
+var runCmd = func(ociBin string, args ...string) (string, error) {
+	cmd := oci.PrefixCmd(exec.Command(ociBin, args...))
+	klog.Infof("Run: %s", cmd.Args)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("%s: %w: %s", strings.Join(cmd.Args, " "), err, out)
+	}
+	return string(out), nil
+}
+
+// lookPath finds the container engines on the host, stubbed out by the tests
+var lookPath = exec.LookPath
+
+// OCIBin returns the container engine to run the caches in: the one of the driver if it is a container driver,
+// else whichever of docker and podman is installed on the host
+func OCIBin(driverName string) (string, error) {
+	if driverName == oci.Docker || driverName == oci.Podman {
+		return driverName, nil
+	}
+	for _, bin := range []string{oci.Docker, oci.Podman} {
+		if _, err := lookPath(bin); err == nil {
+			return bin, nil
+		}
+	}
+	return "", errors.New("the registry cache needs docker or podman on the host")
+}
+
+func stateFile() string {
+	return localpath.MakeMiniPath("cache", "registry", "caches.json")
+}
+
+// Caches returns the caches that have been created, whether they are running or not
+func Caches() ([]Cache, error) {
+	data, err := os.ReadFile(stateFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var caches []Cache
+	return caches, json.Unmarshal(data, &caches)
+}
+
+func saveCaches(caches []Cache) error {
+	if err := os.MkdirAll(filepath.Dir(stateFile()), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(caches, "", "    ")
+	if err != nil {
+		return err
+	}
+	return lock.WriteFile(stateFile(), data, 0644)
+}
+
+// acquire locks the caches against other minikube processes, which start their clusters concurrently
+func acquire() (lock.Releaser, error) {
+	spec := lock.PathMutexSpec(filepath.Join(filepath.Dir(stateFile()), "caches"))
+	spec.Timeout = 5 * time.Minute
+	klog.Infof("acquiring lock for registry caches: %+v", spec)
+	releaser, err := lock.Acquire(spec)
+	if err != nil {
+		return nil, fmt.Errorf("acquire lock for registry caches %+v: %w", spec, err)
+	}
+	return releaser, nil
+}
+
+// nextPort returns the lowest port which is not used by any of the caches
+func nextPort(caches []Cache) int {
+	port := firstPort
+	for slices.ContainsFunc(caches, func(c Cache) bool { return c.Port == port }) {
+		port++
+	}
+	return port
+}
+
+// Ensure makes sure the cache of the registry is running and published on the address of the host,
+// creating it the first time
+func Ensure(ociBin string, registry string, address string) (Cache, error) {
+	releaser, err := acquire()
+	if err != nil {
+		return Cache{}, err
+	}
+	defer releaser.Release()
+
+	caches, err := Caches()
+	if err != nil {
+		return Cache{}, fmt.Errorf("reading registry caches: %w", err)
+	}
+	i := slices.IndexFunc(caches, func(c Cache) bool { return c.Registry == registry })
+	if i < 0 {
+		c := Cache{Registry: registry, Port: nextPort(caches), OCIBin: ociBin, Addresses: []string{address}}
+		if err := create(c); err != nil {
+			return Cache{}, err
+		}
+		return c, saveCaches(append(caches, c))
+	}
+
+	c := caches[i]
+	if !slices.Contains(c.Addresses, address) {
+		// the ports of a container can't be changed, so publish it again on every address, keeping the cached blobs of its volume
+		klog.Infof("publishing registry cache %s on %s as well", c.Name(), address)
+		c.Addresses = append(c.Addresses, address)
+		if _, err := runCmd(c.OCIBin, "rm", "-f", c.Name()); err != nil {
+			return Cache{}, fmt.Errorf("removing registry cache %s: %w", c.Name(), err)
+		}
+		if err := create(c); err != nil {
+			return Cache{}, err
+		}
+		caches[i] = c
+		return c, saveCaches(caches)
+	}
+	running, err := runCmd(c.OCIBin, "container", "inspect", "--format={{.State.Running}}", c.Name())
+	if err != nil {
+		// the container was removed behind our back, so create it again with the same port and data
+		klog.Infof("registry cache %s is gone, recreating it: %v", c.Name(), err)
+		return c, create(c)
+	}
+	if ok, _ := strconv.ParseBool(strings.TrimSpace(running)); !ok {
+		if _, err := runCmd(c.OCIBin, "start", c.Name()); err != nil {
+			return Cache{}, fmt.Errorf("starting registry cache %s: %w", c.Name(), err)
+		}
+	}
+	return c, nil
+}
+
+// create runs the container of the cache, keeping the cached blobs in a volume.
+// The cache is only published on the addresses of the host the nodes reach it at, never on all of its interfaces.
+func create(c Cache) error {
+	klog.Infof("creating registry cache %s for %s on %v port %d", c.Name(), c.Registry, c.Addresses, c.Port)
+	args := []string{"run", "-d",
+		"--name", c.Name(),
+		"--restart", "always",
+		"--label", fmt.Sprintf("%s=%s", Label, c.Name()),
+	}
+	for _, a := range c.Addresses {
+		args = append(args, "-p", fmt.Sprintf("%s:%d:%d", a, c.Port, registryPort))
+	}
+	args = append(args,
+		"-v", fmt.Sprintf("%s:%s", c.Name(), dataDir),
+		"-e", "REGISTRY_PROXY_REMOTEURL="+Upstream(c.Registry),
+		image(),
+	)
+	if _, err := runCmd(c.OCIBin, args...); err != nil {
+		return fmt.Errorf("creating registry cache %s: %w", c.Name(), err)
+	}
+	return nil
+}
+
+// Mirrors ensures the caches of the registries are running on the address of the host and returns their mirror URLs by registry.
+// A cache that fails to start is left out, as the nodes can still pull from the upstream registry.
+func Mirrors(ociBin string, address string, registries []string) (map[string]string, error) {
+	mirrors := map[string]string{}
+	var errs []error
+	for _, r := range registries {
+		c, err := Ensure(ociBin, r, address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		mirrors[r] = c.MirrorURL()
+	}
+	return mirrors, errors.Join(errs...)
+}
+
+// Status is the state of a cache
+type Status struct {
+	Cache
+	Running bool
+	// Size is how many bytes the cached blobs take, -1 if unknown
+	Size int64
+}
+
+// Statuses returns the state of every cache
+func Statuses() ([]Status, error) {
+	caches, err := Caches()
+	if err != nil {
+		return nil, err
+	}
+	var statuses []Status
+	for _, c := range caches {
+		s := Status{Cache: c, Size: -1}
+		if out, err := runCmd(c.OCIBin, "container", "inspect", "--format={{.State.Running}}", c.Name()); err == nil {
+			s.Running, _ = strconv.ParseBool(strings.TrimSpace(out))
+		}
+		if s.Running {
+			if out, err := runCmd(c.OCIBin, "exec", c.Name(), "du", "-sk", dataDir); err == nil {
+				s.Size = parseDU(out)
+			}
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// parseDU returns the bytes in the output of "du -sk", -1 if it can't be parsed
+func parseDU(out string) int64 {
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return -1
+	}
+	kb, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return kb * 1024
+}
+
+// Prune removes the caches of the registries along with their cached blobs, or every cache if none is given
+func Prune(registries ...string) ([]Cache, error) {
+	releaser, err := acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer releaser.Release()
+
+	caches, err := Caches()
+	if err != nil {
+		return nil, err
+	}
+	var pruned []Cache
+	var errs []error
+	kept := slices.DeleteFunc(slices.Clone(caches), func(c Cache) bool {
+		if len(registries) > 0 && !slices.Contains(registries, c.Registry) {
+			return false
+		}
+		if _, err := runCmd(c.OCIBin, "rm", "-f", "-v", c.Name()); err != nil {
+			klog.Warningf("removing container of registry cache %s: %v", c.Name(), err)
+		}
+		if _, err := runCmd(c.OCIBin, "volume", "rm", "-f", c.Name()); err != nil {
+			errs = append(errs, fmt.Errorf("removing registry cache %s: %w", c.Name(), err))
+			return false
+		}
+		pruned = append(pruned, c)
+		return true
+	})
+	if len(kept) != len(caches) {
+		if err := saveCaches(kept); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return pruned, errors.Join(errs...)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrycache
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+// fakeEngine stands in for the container engine, keeping track of the containers that exist
+type fakeEngine struct {
+	running map[string]bool
+	calls   []string
+}
+
+func (f *fakeEngine) run(_ string, args ...string) (string, error) {
+	call := strings.Join(args, " ")
+	f.calls = append(f.calls, call)
+	name := args[len(args)-1]
+	switch args[0] {
+	case "run":
+		f.running[args[3]] = true
+		return "id", nil
+	case "start":
+		f.running[name] = true
+	case "container":
+		running, ok := f.running[name]
+		if !ok {
+			return "", errors.New("no such container")
+		}
+		if running {
+			return "true\n", nil
+		}
+		return "false\n", nil
+	case "exec":
+		return "2048\t/var/lib/registry\n", nil
+	case "rm":
+		delete(f.running, name)
+	}
+	return "", nil
+}
+
+func fake(t *testing.T) *fakeEngine {
+	tests.MakeTempDir(t)
+	f := &fakeEngine{running: map[string]bool{}}
+	orig := runCmd
+	runCmd = f.run
+	t.Cleanup(func() { runCmd = orig })
+	return f
+}
+
+func TestUpstream(t *testing.T) {
+	for registry, want := range map[string]string{
+		"docker.io":       "https://registry-1.docker.io",
+		"registry.k8s.io": "https://registry.k8s.io",
+		"quay.io":         "https://quay.io",
+	} {
+		if got := Upstream(registry); got != want {
+			t.Errorf("Upstream(%q) = %q, want %q", registry, got, want)
+		}
+	}
+}
+
+func TestEnsure(t *testing.T) {
+	f := fake(t)
+
+	hub, err := Ensure("docker", "docker.io", "192.168.49.1")
+	if err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	k8s, err := Ensure("docker", "registry.k8s.io", "192.168.49.1")
+	if err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if hub.Port != firstPort || k8s.Port != firstPort+1 {
+		t.Errorf("ports = %d, %d, want %d, %d", hub.Port, k8s.Port, firstPort, firstPort+1)
+	}
+	if got, want := hub.MirrorURL(), "http://host.minikube.internal:5100"; got != want {
+		t.Errorf("MirrorURL = %q, want %q", got, want)
+	}
+	if !strings.Contains(f.calls[0], "-p 192.168.49.1:5100:5000 -v minikube-registry-cache-docker.io:/var/lib/registry -e REGISTRY_PROXY_REMOTEURL=https://registry-1.docker.io docker.io/registry:") {
+		t.Errorf("unexpected run: %s", f.calls[0])
+	}
+
+	// a stopped cache is started again, keeping its port
+	f.running[hub.Name()] = false
+	again, err := Ensure("docker", "docker.io", "192.168.49.1")
+	if err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if diff := cmp.Diff(hub, again); diff != "" {
+		t.Errorf("Ensure changed the cache (-want +got):\n%s", diff)
+	}
+	if !f.running[hub.Name()] {
+		t.Errorf("cache %s was not started", hub.Name())
+	}
+
+	// a cluster reaching the host at another address gets the cache published there as well
+	f.calls = nil
+	hub, err = Ensure("docker", "docker.io", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if got, want := hub.Addresses, []string{"192.168.49.1", "127.0.0.1"}; !cmp.Equal(got, want) {
+		t.Errorf("Addresses = %v, want %v", got, want)
+	}
+	if len(f.calls) != 2 || f.calls[0] != "rm -f minikube-registry-cache-docker.io" || !strings.Contains(f.calls[1], "-p 192.168.49.1:5100:5000 -p 127.0.0.1:5100:5000 -v minikube-registry-cache-docker.io:") {
+		t.Errorf("unexpected calls republishing the cache: %v", f.calls)
+	}
+
+	caches, err := Caches()
+	if err != nil {
+		t.Fatalf("Caches: %v", err)
+	}
+	if diff := cmp.Diff([]Cache{hub, k8s}, caches); diff != "" {
+		t.Errorf("Caches mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestStatusesAndPrune(t *testing.T) {
+	f := fake(t)
+	mirrors, err := Mirrors("podman", "127.0.0.1", []string{"docker.io", "quay.io"})
+	if err != nil {
+		t.Fatalf("Mirrors: %v", err)
+	}
+	if len(mirrors) != 2 {
+		t.Fatalf("Mirrors = %v, want 2 mirrors", mirrors)
+	}
+	f.running["minikube-registry-cache-quay.io"] = false
+
+	statuses, err := Statuses()
+	if err != nil {
+		t.Fatalf("Statuses: %v", err)
+	}
+	if !statuses[0].Running || statuses[0].Size != 2048*1024 {
+		t.Errorf("status of docker.io = %+v, want running with 2MiB", statuses[0])
+	}
+	if statuses[1].Running || statuses[1].Size != -1 {
+		t.Errorf("status of quay.io = %+v, want stopped with unknown size", statuses[1])
+	}
+
+	pruned, err := Prune("quay.io")
+	if err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+	if len(pruned) != 1 || pruned[0].Registry != "quay.io" {
+		t.Errorf("Prune pruned %v, want quay.io", pruned)
+	}
+	caches, err := Caches()
+	if err != nil {
+		t.Fatalf("Caches: %v", err)
+	}
+	if len(caches) != 1 || caches[0].Registry != "docker.io" {
+		t.Errorf("Caches = %v, want docker.io only", caches)
+	}
+
+	// the port of a pruned cache is reused
+	c, err := Ensure("podman", "ghcr.io", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if c.Port != firstPort+1 {
+		t.Errorf("port = %d, want %d", c.Port, firstPort+1)
+	}
+}
+
+func TestOCIBin(t *testing.T) {
+	orig := lookPath
+	t.Cleanup(func() { lookPath = orig })
+	lookPath = func(bin string) (string, error) {
+		if bin == "podman" {
+			return "/usr/bin/podman", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	for driverName, want := range map[string]string{"docker": "docker", "kvm2": "podman", "podman": "podman"} {
+		got, err := OCIBin(driverName)
+		if err != nil || got != want {
+			t.Errorf("OCIBin(%q) = %q, %v, want %q", driverName, got, err, want)
+		}
+	}
+	lookPath = func(string) (string, error) { return "", errors.New("not found") }
+	if _, err := OCIBin("qemu2"); err == nil {
+		t.Errorf("OCIBin without any engine should fail")
+	}
+}
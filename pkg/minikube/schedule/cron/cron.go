@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron parses the recurring schedules used to start and stop clusters.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Spec is a parsed recurring schedule with minute granularity
+type Spec struct {
+	minute, hour, dom, month, dow uint64
+	// anyDom and anyDow record an unrestricted day field, see matchesDay
+	anyDom, anyDow bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12}
+	dowField    = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// dayAliases are the day lists accepted by the "HH:MM [days]" form
+var dayAliases = map[string]string{
+	"daily":    "*",
+	"weekdays": "1-5",
+	"weekends": "0,6",
+}
+
+// Parse parses either a five field cron expression ("30 8 * * 1-5") or the
+// shorter "HH:MM [days]" form, where days is daily, weekdays, weekends or a
+// day of week list such as "mon,wed,fri". Times are in the local timezone.
+func Parse(s string) (*Spec, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) > 0 && strings.Contains(fields[0], ":") {
+		expr, err := expandClock(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", s, err)
+		}
+		fields = strings.Fields(expr)
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 cron fields or \"HH:MM [days]\"", s)
+	}
+
+	sp := &Spec{}
+	var err error
+	for i, f := range []struct {
+		bits  *uint64
+		field field
+	}{
+		{&sp.minute, minuteField},
+		{&sp.hour, hourField},
+		{&sp.dom, domField},
+		{&sp.month, monthField},
+		{&sp.dow, dowField},
+	} {
+		if *f.bits, err = parseField(fields[i], f.field); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", s, err)
+		}
+	}
+	// 7 is an alias for sunday
+	if sp.dow&(1<<7) != 0 {
+		sp.dow |= 1
+	}
+	sp.anyDom = fields[2] == "*"
+	sp.anyDow = fields[4] == "*"
+	return sp, nil
+}
+
+// expandClock converts the "HH:MM [days]" form into a cron expression
+func expandClock(fields []string) (string, error) {
+	if len(fields) > 2 {
+		return "", fmt.Errorf("expected \"HH:MM [days]\"")
+	}
+	t, err := time.Parse("15:04", fields[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid time of day %q", fields[0])
+	}
+	days := "*"
+	if len(fields) == 2 {
+		days = fields[1]
+		if alias, ok := dayAliases[days]; ok {
+			days = alias
+		}
+	}
+	return fmt.Sprintf("%d %d * * %s", t.Minute(), t.Hour(), days), nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bit set
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(s, ",") {
+		rng, step := part, 1
+		if r, st, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(st)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", st, f.name)
+			}
+			rng, step = r, n
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			start, end, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(start); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = f.value(end); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				// "5/15" means every 15 starting at 5
+				hi = f.max
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", rng, f.name)
+			}
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// value parses a single number or name of a field
+func (f field) value(s string) (int, error) {
+	if n, ok := f.names[s]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s %d out of range %d-%d", f.name, n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// matchesDay follows cron semantics: if both day fields are restricted, either may match
+func (s *Spec) matchesDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDom || s.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time after t that matches the schedule, or the zero
+// time if there is none within the next five years (for example "0 0 31 2 *").
+func (s *Spec) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
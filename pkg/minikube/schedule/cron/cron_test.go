@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// a Friday
+	from := time.Date(2026, 10, 16, 18, 30, 0, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"19:00 weekdays", time.Date(2026, 10, 16, 19, 0, 0, 0, time.UTC)},
+		{"08:30 weekdays", time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)},
+		{"10:00 weekends", time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)},
+		{"18:30", time.Date(2026, 10, 17, 18, 30, 0, 0, time.UTC)},
+		{"09:00 mon,wed", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 10, 16, 18, 45, 0, 0, time.UTC)},
+		{"0 0 1 1 *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)},
+		// day of month or day of week when both are restricted
+		{"0 6 1 * mon", time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tc.spec, err)
+			}
+			if got := s.Next(from); !got.Equal(tc.want) {
+				t.Errorf("Next(%s) = %s, want %s", from, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"* * * *",
+		"25:00 weekdays",
+		"19:00 sometimes",
+		"19:00 weekdays now",
+		"60 * * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"0 0 0 * *",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) expected an error", s)
+		}
+	}
+}
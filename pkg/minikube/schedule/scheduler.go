@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/process"
+	"k8s.io/minikube/pkg/minikube/schedule/cron"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// Actions a recurring schedule can run
+const (
+	ActionStart = "start"
+	ActionStop  = "stop"
+)
+
+// schedulerPIDFile is the pid file of the per-user scheduler process
+func schedulerPIDFile() string {
+	return localpath.MakeMiniPath("scheduler.pid")
+}
+
+// schedulerLog is where the scheduler process and the commands it runs write their output
+func schedulerLog() string {
+	return localpath.MakeMiniPath("logs", "scheduler.log")
+}
+
+// Validate returns an error if s is not a valid recurring schedule
+func Validate(s config.Schedule) error {
+	if s.Action != ActionStart && s.Action != ActionStop {
+		return fmt.Errorf("invalid action %q, must be %q or %q", s.Action, ActionStart, ActionStop)
+	}
+	_, err := cron.Parse(s.Spec)
+	return err
+}
+
+// Next returns the next scheduled action of a cluster after t, and a zero time if there is none
+func Next(schedules []config.Schedule, t time.Time) (config.Schedule, time.Time) {
+	var next config.Schedule
+	var at time.Time
+	for _, s := range schedules {
+		spec, err := cron.Parse(s.Spec)
+		if err != nil {
+			klog.Warningf("skipping schedule %+v: %v", s, err)
+			continue
+		}
+		n := spec.Next(t)
+		if !n.IsZero() && (at.IsZero() || n.Before(at)) {
+			next, at = s, n
+		}
+	}
+	return next, at
+}
+
+// due returns the latest action of each profile that was scheduled in (since, now].
+// Earlier actions that were missed, for example while the host was asleep, are superseded.
+func due(profiles []*config.Profile, since, now time.Time) map[string]string {
+	actions := map[string]string{}
+	for _, p := range profiles {
+		if p.Config == nil {
+			continue
+		}
+		var latest time.Time
+		for _, s := range p.Config.Schedules {
+			spec, err := cron.Parse(s.Spec)
+			if err != nil {
+				klog.Warningf("skipping schedule %+v of %s: %v", s, p.Name, err)
+				continue
+			}
+			for n := spec.Next(since); !n.IsZero() && !n.After(now); n = spec.Next(n) {
+				if !n.Before(latest) {
+					latest = n
+					actions[p.Name] = s.Action
+				}
+			}
+		}
+	}
+	return actions
+}
+
+// hasSchedules returns true if any profile has a recurring schedule
+func hasSchedules(profiles []*config.Profile) bool {
+	for _, p := range profiles {
+		if p.Config != nil && len(p.Config.Schedules) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SchedulerRunning returns true if the scheduler process of this user is alive
+func SchedulerRunning() bool {
+	pid, err := process.ReadPidfile(schedulerPIDFile())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			klog.Warningf("reading scheduler pid: %v", err)
+		}
+		return false
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return false
+	}
+	exists, err := process.Exists(pid, filepath.Base(exe))
+	if err != nil {
+		klog.Warningf("checking scheduler process %d: %v", pid, err)
+	}
+	return exists
+}
+
+// lockScheduler serializes starting the scheduler and its exit, which start, stop --schedule and status may race on
+func lockScheduler() (lock.Releaser, error) {
+	spec := lock.PathMutexSpec(schedulerPIDFile())
+	releaser, err := lock.Acquire(spec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to acquire scheduler lock: %w", err)
+	}
+	return releaser, nil
+}
+
+// EnsureScheduler starts the per-user scheduler process, unless it is already running
+func EnsureScheduler() error {
+	releaser, err := lockScheduler()
+	if err != nil {
+		return err
+	}
+	defer releaser.Release()
+
+	if SchedulerRunning() {
+		return nil
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locating minikube: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(schedulerLog()), 0o755); err != nil {
+		return err
+	}
+	logfile, err := os.OpenFile(schedulerLog(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening scheduler log: %w", err)
+	}
+	defer logfile.Close()
+
+	cmd := exec.Command(exe, "schedule", "run")
+	cmd.Stdout = logfile
+	cmd.Stderr = logfile
+	process.Detach(cmd)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting scheduler: %w", err)
+	}
+	klog.Infof("started scheduler with pid %d", cmd.Process.Pid)
+	if err := process.WritePidfile(schedulerPIDFile(), cmd.Process.Pid); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+// ResumeScheduler starts the scheduler again if any profile has a recurring schedule,
+// as the scheduler process does not survive a reboot or the end of the user session
+func ResumeScheduler(profiles []*config.Profile) error {
+	if !hasSchedules(profiles) {
+		return nil
+	}
+	return EnsureScheduler()
+}
+
+// RunScheduler reconciles the recurring schedules of all profiles once a minute, starting
+// and stopping clusters as scheduled. It returns once no profile has a schedule left.
+func RunScheduler() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locating minikube: %w", err)
+	}
+	since := time.Now()
+	for {
+		profiles, err := config.ListValidProfiles()
+		if err != nil {
+			klog.Warningf("listing profiles: %v", err)
+		}
+		if !hasSchedules(profiles) && exitScheduler() {
+			klog.Infof("no schedules left, exiting")
+			return nil
+		}
+
+		now := time.Now()
+		for profile, action := range due(profiles, since, now) {
+			klog.Infof("running scheduled %s of %s", action, profile)
+			cmd := exec.Command(exe, action, "-p", profile)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			if err := cmd.Run(); err != nil {
+				klog.Errorf("scheduled %s of %s failed: %v", action, profile, err)
+			}
+		}
+		since = now
+
+		// wake up at the start of the next minute
+		time.Sleep(time.Until(time.Now().Truncate(time.Minute).Add(time.Minute)))
+	}
+}
+
+// exitScheduler removes the pid file of the scheduler if it still belongs to this process, unless a schedule was
+// added meanwhile, and returns whether the scheduler can exit
+func exitScheduler() bool {
+	releaser, err := lockScheduler()
+	if err != nil {
+		klog.Warningf("not exiting: %v", err)
+		return false
+	}
+	defer releaser.Release()
+
+	// a schedule may have been added after the profiles were listed, relying on this process to run it
+	profiles, err := config.ListValidProfiles()
+	if err != nil {
+		klog.Warningf("listing profiles: %v", err)
+	}
+	if hasSchedules(profiles) {
+		return false
+	}
+	if pid, err := process.ReadPidfile(schedulerPIDFile()); err == nil && pid == os.Getpid() {
+		if err := os.Remove(schedulerPIDFile()); err != nil {
+			klog.Warningf("removing scheduler pid file: %v", err)
+		}
+	}
+	return true
+}
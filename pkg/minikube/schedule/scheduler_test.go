@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/process"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+func TestDue(t *testing.T) {
+	profiles := []*config.Profile{
+		{Name: "work", Config: &config.ClusterConfig{Schedules: []config.Schedule{
+			{Action: ActionStop, Spec: "19:00 weekdays"},
+			{Action: ActionStart, Spec: "08:30 weekdays"},
+		}}},
+		{Name: "nightly", Config: &config.ClusterConfig{Schedules: []config.Schedule{
+			{Action: ActionStart, Spec: "0 2 * * *"},
+		}}},
+		{Name: "invalid"},
+	}
+	// Friday evening
+	friday := time.Date(2026, 10, 16, 18, 59, 0, 0, time.Local)
+
+	tests := []struct {
+		name       string
+		since, now time.Time
+		want       map[string]string
+	}{
+		{
+			name:  "nothing due",
+			since: friday.Add(-time.Minute),
+			now:   friday,
+			want:  map[string]string{},
+		},
+		{
+			name:  "stop at 19:00",
+			since: friday,
+			now:   friday.Add(time.Minute),
+			want:  map[string]string{"work": ActionStop},
+		},
+		{
+			name:  "asleep over the weekend",
+			since: friday,
+			now:   time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local),
+			want:  map[string]string{"work": ActionStart, "nightly": ActionStart},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := due(profiles, tc.since, tc.now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("due() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	schedules := []config.Schedule{
+		{Action: ActionStop, Spec: "19:00 weekdays"},
+		{Action: ActionStart, Spec: "08:30 weekdays"},
+		{Action: ActionStart, Spec: "not a schedule"},
+	}
+	friday := time.Date(2026, 10, 16, 20, 0, 0, 0, time.Local)
+	s, at := Next(schedules, friday)
+	if s.Action != ActionStart || !at.Equal(time.Date(2026, 10, 19, 8, 30, 0, 0, time.Local)) {
+		t.Errorf("Next() = %s at %s, want start at Monday 08:30", s.Action, at)
+	}
+	if _, at := Next(nil, friday); !at.IsZero() {
+		t.Errorf("Next(nil) = %s, want zero time", at)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(config.Schedule{Action: ActionStop, Spec: "19:00 weekdays"}); err != nil {
+		t.Errorf("Validate: unexpected error: %v", err)
+	}
+	if err := Validate(config.Schedule{Action: "pause", Spec: "19:00"}); err == nil {
+		t.Errorf("Validate: expected an error for an unknown action")
+	}
+	if err := Validate(config.Schedule{Action: ActionStart, Spec: "later"}); err == nil {
+		t.Errorf("Validate: expected an error for an invalid schedule")
+	}
+}
+
+func TestResumeSchedulerWithoutSchedules(t *testing.T) {
+	tests.MakeTempDir(t)
+	profiles := []*config.Profile{{Name: "p1", Config: &config.ClusterConfig{}}, {Name: "invalid"}}
+	if err := ResumeScheduler(profiles); err != nil {
+		t.Fatalf("ResumeScheduler: %v", err)
+	}
+	if _, err := os.Stat(schedulerPIDFile()); !os.IsNotExist(err) {
+		t.Errorf("the scheduler should not be started without schedules, got: %v", err)
+	}
+}
+
+func TestExitScheduler(t *testing.T) {
+	tests.MakeTempDir(t)
+
+	// the pid file of another scheduler is left alone
+	if err := process.WritePidfile(schedulerPIDFile(), os.Getpid()+1); err != nil {
+		t.Fatal(err)
+	}
+	if !exitScheduler() {
+		t.Fatalf("exitScheduler = false without schedules")
+	}
+	if _, err := os.Stat(schedulerPIDFile()); err != nil {
+		t.Errorf("the pid file of another scheduler was removed: %v", err)
+	}
+
+	if err := process.WritePidfile(schedulerPIDFile(), os.Getpid()); err != nil {
+		t.Fatal(err)
+	}
+	if !exitScheduler() {
+		t.Fatalf("exitScheduler = false without schedules")
+	}
+	if _, err := os.Stat(schedulerPIDFile()); !os.IsNotExist(err) {
+		t.Errorf("the pid file of the scheduler was not removed: %v", err)
+	}
+}
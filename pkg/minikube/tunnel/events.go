@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+)
+
+// emitEvent prints a tunnel CloudEvent if JSON output is enabled
+func emitEvent(kind string, data map[string]string) {
+	if !out.JSON {
+		return
+	}
+	register.PrintTunnelEvent(kind, data)
+}
+
+// jsonReporter reports tunnel errors as CloudEvents, the state changes themselves
+// are emitted where they happen
+type jsonReporter struct {
+	lastErrors map[string]string
+}
+
+func (r *jsonReporter) Report(tunnelState *Status) {
+	errs := map[string]error{
+		"minikube":              tunnelState.MinikubeError,
+		"router":                tunnelState.RouteError,
+		"loadbalancer emulator": tunnelState.LoadBalancerEmulatorError,
+	}
+	if r.lastErrors == nil {
+		r.lastErrors = map[string]string{}
+	}
+	for source, err := range errs {
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		if msg == r.lastErrors[source] {
+			continue
+		}
+		r.lastErrors[source] = msg
+		if msg != "" {
+			register.PrintWarning(source + ": " + msg)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/libmachine/host"
+	"k8s.io/minikube/pkg/libmachine/state"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+// recordEvents captures the CloudEvents printed while fn runs and returns their types
+func recordEvents(t *testing.T, fn func()) []string {
+	t.Helper()
+	buf := bytes.NewBuffer([]byte{})
+	register.SetOutputFile(buf)
+	out.SetJSON(true)
+	defer func() {
+		register.SetOutputFile(os.Stdout)
+		out.SetJSON(false)
+	}()
+
+	fn()
+
+	var types []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var ev struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			t.Fatalf("invalid event %q: %v", line, err)
+		}
+		types = append(types, ev.Type)
+	}
+	return types
+}
+
+func newEventTestTunnel(t *testing.T, router *fakeRouter) *tunnel {
+	t.Helper()
+	machineName := "testmachine"
+	machineAPI := &tests.MockAPI{
+		FakeStore: tests.FakeStore{
+			Hosts: map[string]*host.Host{
+				machineName: {
+					Driver: &tests.MockDriver{
+						CurrentState: state.Running,
+						IP:           "1.2.3.4",
+					},
+				},
+			},
+		},
+	}
+	configLoader := &stubConfigLoader{
+		c: &config.ClusterConfig{
+			KubernetesConfig: config.KubernetesConfig{
+				ServiceCIDR: "1.2.3.4/5",
+			}},
+	}
+	registry, cleanup := createTestRegistry(t)
+	t.Cleanup(cleanup)
+
+	tun, err := newTunnel(machineName, machineAPI, configLoader, newStubCoreClient(nil), registry, router)
+	if err != nil {
+		t.Fatalf("error creating tunnel: %s", err)
+	}
+	tun.reporter = &recordingReporter{}
+	return tun
+}
+
+func TestTunnelEvents(t *testing.T) {
+	tun := newEventTestTunnel(t, &fakeRouter{})
+
+	got := recordEvents(t, func() {
+		tun.update()
+		tun.update()
+		tun.cleanup()
+	})
+	want := []string{
+		"io.k8s.sigs.minikube.tunnel.host.state",
+		"io.k8s.sigs.minikube.tunnel.route.added",
+		"io.k8s.sigs.minikube.tunnel.route.removed",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestTunnelConflictEvents(t *testing.T) {
+	router := &fakeRouter{rt: routingTable{{
+		route: unsafeParseRoute("1.2.3.5", "1.2.3.4/5"),
+		line:  "conflicting line",
+	}}}
+	tun := newEventTestTunnel(t, router)
+
+	got := recordEvents(t, func() {
+		tun.update()
+		tun.update()
+	})
+	want := []string{
+		"io.k8s.sigs.minikube.tunnel.host.state",
+		"io.k8s.sigs.minikube.tunnel.route.conflict",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestJSONReporter(t *testing.T) {
+	r := &jsonReporter{}
+	got := recordEvents(t, func() {
+		r.Report(&Status{RouteError: errors.New("conflicting route")})
+		r.Report(&Status{RouteError: errors.New("conflicting route")})
+		r.Report(&Status{})
+		r.Report(&Status{RouteError: errors.New("conflicting route")})
+	})
+	want := []string{
+		"io.k8s.sigs.minikube.warning",
+		"io.k8s.sigs.minikube.warning",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
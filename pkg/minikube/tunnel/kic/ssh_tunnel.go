@@ -19,7 +19,10 @@ package kic
 import (
 	"context"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -45,6 +48,9 @@ type SSHTunnel struct {
 	LoadBalancerEmulator tunnel.LoadBalancerEmulator
 	conns                map[string]*sshConn
 	connsToStop          map[string]*sshConn
+
+	// mu guards conns and connsToStop against concurrent Status calls
+	mu sync.Mutex
 }
 
 // NewSSHTunnel creates and returns a new SSHTunnel instance.
@@ -97,6 +103,7 @@ func (t *SSHTunnel) Start() error {
 			klog.Errorf("error listing ingresses: %v", err)
 		}
 
+		t.mu.Lock()
 		t.markConnectionsToBeStopped()
 
 		for _, svc := range services.Items {
@@ -110,12 +117,28 @@ func (t *SSHTunnel) Start() error {
 		}
 
 		t.stopMarkedConnections()
+		t.mu.Unlock()
 
 		// TODO: which time to use?
 		time.Sleep(1 * time.Second)
 	}
 }
 
+// Status returns the services and ingresses currently tunneled
+func (t *SSHTunnel) Status() *tunnel.Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st := &tunnel.Status{
+		TunnelID:      tunnel.ID{Pid: os.Getpid()},
+		MinikubeState: tunnel.Running,
+	}
+	for _, conn := range t.conns {
+		st.PatchedServices = append(st.PatchedServices, conn.service)
+	}
+	sort.Strings(st.PatchedServices)
+	return st
+}
+
 func (t *SSHTunnel) markConnectionsToBeStopped() {
 	for _, conn := range t.conns {
 		t.connsToStop[conn.name] = conn
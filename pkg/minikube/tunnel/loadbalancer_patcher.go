@@ -26,6 +26,7 @@ import (
 	typed_core "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/out/register"
 )
 
 // requestSender is an interface exposed for testing what requests are sent through the k8s REST client
@@ -120,6 +121,10 @@ func (l *LoadBalancerEmulator) updateServiceIP(restClient rest.Interface, svc co
 		klog.Errorf("error patching %s with IP %s: %s", svc.Name, ip, err)
 	} else {
 		klog.Infof("Patched %s with IP %s", svc.Name, ip)
+		emitEvent(register.TunnelServicePatched, map[string]string{
+			"service": svc.Namespace + "/" + svc.Name,
+			"ip":      ip,
+		})
 	}
 	return result, err
 }
@@ -142,8 +147,14 @@ func (l *LoadBalancerEmulator) cleanupService(restClient rest.Interface, svc cor
 	}
 	request := l.patchConverter.convert(restClient, patch)
 	result, err := l.requestSender.send(request)
+	if err != nil {
+		return result, err
+	}
 	klog.Infof("Removed load balancer ingress from %s.", svc.Name)
-	return result, err
+	emitEvent(register.TunnelServiceCleared, map[string]string{
+		"service": svc.Namespace + "/" + svc.Name,
+	})
+	return result, nil
 
 }
 
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/out"
 )
 
 // reporter that reports the status of a tunnel
@@ -86,8 +87,11 @@ func (r *simpleReporter) Report(tunnelState *Status) {
 	}
 }
 
-func newReporter(out io.Writer) reporter {
+func newReporter(w io.Writer) reporter {
+	if out.JSON {
+		return &jsonReporter{}
+	}
 	return &simpleReporter{
-		out: out,
+		out: w,
 	}
 }
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// StatusReport is the state of a running tunnel, as served on its status socket
+type StatusReport struct {
+	Machine         string            `json:"machine"`
+	Pid             int               `json:"pid"`
+	Route           string            `json:"route,omitempty"`
+	MinikubeState   string            `json:"minikubeState"`
+	PatchedServices []string          `json:"patchedServices"`
+	Errors          map[string]string `json:"errors,omitempty"`
+	StartTime       time.Time         `json:"startTime"`
+}
+
+// NewStatusReport returns the serializable report of a tunnel status
+func NewStatusReport(st *Status) *StatusReport {
+	r := &StatusReport{
+		Machine:         st.TunnelID.MachineName,
+		Pid:             st.TunnelID.Pid,
+		MinikubeState:   st.MinikubeState.String(),
+		PatchedServices: st.PatchedServices,
+		Errors:          map[string]string{},
+	}
+	if st.TunnelID.Route != nil && st.TunnelID.Route.DestCIDR != nil {
+		r.Route = st.TunnelID.Route.String()
+	}
+	for name, err := range map[string]error{
+		"minikube":              st.MinikubeError,
+		"router":                st.RouteError,
+		"loadbalancer emulator": st.LoadBalancerEmulatorError,
+	} {
+		if err != nil {
+			r.Errors[name] = err.Error()
+		}
+	}
+	return r
+}
+
+// SocketPath returns the path of the status socket of the tunnel of a profile
+func SocketPath(profile string) string {
+	return filepath.Join(localpath.Profile(profile), "tunnel.sock")
+}
+
+// ServeStatus serves the state returned by status on the unix socket at path, until ctx is done.
+// A POST to /stop calls stop, which is expected to cancel ctx.
+func ServeStatus(ctx context.Context, path string, status func() *StatusReport, stop func()) error {
+	// a socket left behind by a tunnel that was killed
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", path, err)
+	}
+
+	started := time.Now()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		r := status()
+		r.StartTime = started
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(r); err != nil {
+			klog.Warningf("failed to write tunnel status: %v", err)
+		}
+	})
+	mux.HandleFunc("POST /stop", func(w http.ResponseWriter, _ *http.Request) {
+		klog.Info("stop requested over the status socket")
+		w.WriteHeader(http.StatusAccepted)
+		stop()
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			klog.Warningf("failed to close tunnel status server: %v", err)
+		}
+		os.Remove(path)
+	}()
+	go func() {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			klog.Errorf("tunnel status server: %v", err)
+		}
+	}()
+	return nil
+}
+
+// statusClient returns an http client that talks to the status socket at path
+func statusClient(path string) *http.Client {
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			},
+		},
+	}
+}
+
+// ErrNotRunning is returned when no tunnel is serving its status for a profile
+var ErrNotRunning = errors.New("no tunnel is running")
+
+// QueryStatus returns the state of the running tunnel of a profile
+func QueryStatus(profile string) (*StatusReport, error) {
+	resp, err := statusClient(SocketPath(profile)).Get("http://tunnel/status")
+	if err != nil {
+		klog.Infof("querying tunnel status: %v", err)
+		return nil, ErrNotRunning
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tunnel status: %s", resp.Status)
+	}
+	r := &StatusReport{}
+	if err := json.NewDecoder(resp.Body).Decode(r); err != nil {
+		return nil, fmt.Errorf("decoding tunnel status: %w", err)
+	}
+	return r, nil
+}
+
+// RequestStop asks the running tunnel of a profile to clean up and exit
+func RequestStop(profile string) error {
+	resp, err := statusClient(SocketPath(profile)).Post("http://tunnel/stop", "", nil)
+	if err != nil {
+		klog.Infof("requesting tunnel stop: %v", err)
+		return ErrNotRunning
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("tunnel stop: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNewStatusReport(t *testing.T) {
+	_, cidr, _ := net.ParseCIDR("10.96.0.0/12")
+	st := &Status{
+		TunnelID: ID{
+			Route:       &Route{Gateway: net.ParseIP("192.168.49.2"), DestCIDR: cidr},
+			MachineName: "minikube",
+			Pid:         42,
+		},
+		MinikubeState:   Running,
+		RouteError:      errors.New("route exists"),
+		PatchedServices: []string{"nginx"},
+	}
+	want := &StatusReport{
+		Machine:         "minikube",
+		Pid:             42,
+		Route:           "10.96.0.0/12 -> 192.168.49.2",
+		MinikubeState:   "Running",
+		PatchedServices: []string{"nginx"},
+		Errors:          map[string]string{"router": "route exists"},
+	}
+	if diff := cmp.Diff(want, NewStatusReport(st)); diff != "" {
+		t.Errorf("NewStatusReport mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestServeStatus(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("MINIKUBE_HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".minikube", "profiles", "p1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := QueryStatus("p1"); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("QueryStatus before serving = %v, want ErrNotRunning", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	report := &StatusReport{Machine: "p1", Pid: 7, MinikubeState: "Running", PatchedServices: []string{"web"}}
+	if err := ServeStatus(ctx, SocketPath("p1"), func() *StatusReport { return report }, cancel); err != nil {
+		t.Fatalf("ServeStatus: %v", err)
+	}
+
+	got, err := QueryStatus("p1")
+	if err != nil {
+		t.Fatalf("QueryStatus: %v", err)
+	}
+	if got.StartTime.IsZero() {
+		t.Errorf("QueryStatus returned no start time")
+	}
+	if diff := cmp.Diff(report, got, cmpopts.IgnoreFields(StatusReport{}, "StartTime")); diff != "" {
+		t.Errorf("QueryStatus mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := RequestStop("p1"); err != nil {
+		t.Fatalf("RequestStop: %v", err)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("stop request did not cancel the tunnel")
+	}
+	removed := func() bool {
+		_, err := os.Stat(SocketPath("p1"))
+		return os.IsNotExist(err)
+	}
+	for i := 0; i < 50 && !removed(); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !removed() {
+		t.Errorf("socket %s was not removed after stop", SocketPath("p1"))
+	}
+}
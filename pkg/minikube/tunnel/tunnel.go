@@ -29,6 +29,7 @@ import (
 	"k8s.io/minikube/pkg/libmachine/host"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/out/register"
 )
 
 // tunnel represents the basic API for a tunnel: periodically the state of the tunnel
@@ -76,9 +77,7 @@ func newTunnel(machineName string, machineAPI libmachine.API, configLoader confi
 			TunnelID:      id,
 			MinikubeState: state,
 		},
-		reporter: &simpleReporter{
-			out: os.Stdout,
-		},
+		reporter: newReporter(os.Stdout),
 	}, nil
 
 }
@@ -92,6 +91,8 @@ type tunnel struct {
 	registry             *persistentRegistry
 
 	status *Status
+	// stateReported is set once the host state has been emitted as an event
+	stateReported bool
 }
 
 func (t *tunnel) cleanup() *Status {
@@ -101,6 +102,7 @@ func (t *tunnel) cleanup() *Status {
 		t.status.RouteError = fmt.Errorf("error cleaning up route: %v", err)
 		klog.V(3).Info(t.status.RouteError.Error())
 	} else {
+		emitEvent(register.TunnelRouteRemoved, t.routeEventData())
 		err = t.registry.Remove(t.status.TunnelID.Route)
 		if err != nil {
 			klog.V(3).Infof("error removing route from registry: %v", err)
@@ -115,8 +117,20 @@ func (t *tunnel) cleanup() *Status {
 func (t *tunnel) update() *Status {
 	klog.V(3).Info("updating tunnel status...")
 	var h *host.Host
+	lastState := t.status.MinikubeState
 	t.status.MinikubeState, h, t.status.MinikubeError = t.clusterInspector.getStateAndHost()
 	defer t.clusterInspector.machineAPI.Close()
+	if !t.stateReported || lastState != t.status.MinikubeState {
+		t.stateReported = true
+		data := map[string]string{
+			"machine": t.status.TunnelID.MachineName,
+			"state":   t.status.MinikubeState.String(),
+		}
+		if t.status.MinikubeError != nil {
+			data["error"] = t.status.MinikubeError.Error()
+		}
+		emitEvent(register.TunnelHostState, data)
+	}
 	if t.status.MinikubeState == Running {
 		klog.V(3).Infof("minikube is running, trying to add route%s", t.status.TunnelID.Route)
 		setupRoute(t, h)
@@ -141,6 +155,7 @@ func setupRoute(t *tunnel, h *host.Host) {
 		if t.status.RouteError != nil {
 			return
 		}
+		emitEvent(register.TunnelRouteAdded, t.routeEventData())
 		// the route was added successfully, we need to make sure the registry has it too
 		// this might fail in race conditions, when another process created this tunnel
 		if err := t.registry.Register(&t.status.TunnelID); err != nil {
@@ -161,7 +176,13 @@ func setupRoute(t *tunnel, h *host.Host) {
 	// error scenarios
 
 	if len(conflict) > 0 {
-		t.status.RouteError = fmt.Errorf("conflicting route: %s", conflict)
+		err := fmt.Errorf("conflicting route: %s", conflict)
+		if t.status.RouteError == nil || t.status.RouteError.Error() != err.Error() {
+			data := t.routeEventData()
+			data["conflict"] = conflict
+			emitEvent(register.TunnelRouteConflict, data)
+		}
+		t.status.RouteError = err
 		return
 	}
 
@@ -190,6 +211,14 @@ func setupRoute(t *tunnel, h *host.Host) {
 
 }
 
+func (t *tunnel) routeEventData() map[string]string {
+	data := map[string]string{"machine": t.status.TunnelID.MachineName}
+	if t.status.TunnelID.Route != nil {
+		data["route"] = t.status.TunnelID.Route.String()
+	}
+	return data
+}
+
 func setupBridge(t *tunnel) {
 	command := exec.Command("ifconfig", "bridge100")
 	klog.Infof("About to run command: %s\n", command.Args)
@@ -18,6 +18,7 @@ package tunnel
 
 import (
 	"path/filepath"
+	"sync"
 	"time"
 
 	"context"
@@ -37,6 +38,9 @@ type Manager struct {
 	delay    time.Duration
 	registry *persistentRegistry
 	router   router
+
+	mu     sync.Mutex
+	status *Status
 }
 
 // stateCheckInterval defines how frequently the cluster and route states are checked
@@ -112,6 +116,9 @@ func (mgr *Manager) run(ctx context.Context, t controller, ready, check, done ch
 			}
 			status := t.update()
 			klog.V(4).Infof("minikube status: %s", status)
+			mgr.mu.Lock()
+			mgr.status = status.Clone()
+			mgr.mu.Unlock()
 			if status.MinikubeState != Running {
 				klog.Infof("minikube status: %s, cleaning up and quitting...", status.MinikubeState)
 				mgr.cleanup(t)
@@ -122,6 +129,16 @@ func (mgr *Manager) run(ctx context.Context, t controller, ready, check, done ch
 	}
 }
 
+// Status returns the tunnel status of the latest check, or nil before the first check
+func (mgr *Manager) Status() *Status {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if mgr.status == nil {
+		return nil
+	}
+	return mgr.status.Clone()
+}
+
 func (mgr *Manager) cleanup(t controller) {
 	t.cleanup()
 }
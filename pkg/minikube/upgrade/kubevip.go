@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
+)
+
+// kubeVipManifest is the static pod manifest of kube-vip on control-plane nodes of HA clusters
+var kubeVipManifest = path.Join(vmpath.GuestManifestsDir, kubevip.Manifest)
+
+// vipHolder returns the node whose kube-vip owns the VIP, or an empty string if there is none
+func vipHolder(ctx context.Context, client kubernetes.Interface) (string, error) {
+	lease, err := client.CoordinationV1().Leases(meta.NamespaceSystem).Get(ctx, kubevip.LeaseName, meta.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get kube-vip lease: %w", err)
+	}
+	if lease.Spec.HolderIdentity == nil {
+		return "", nil
+	}
+	return *lease.Spec.HolderIdentity, nil
+}
+
+// handOffVIP moves the VIP away from a control-plane node before it is upgraded, so that the API server stays
+// reachable through the VIP while the node restarts its own. kube-vip of the node is stopped by removing its
+// static pod manifest, which releases the lease to the kube-vip of another control-plane node.
+func handOffVIP(ctx context.Context, client kubernetes.Interface, r command.Runner, name string, timeout time.Duration) error {
+	holder, err := vipHolder(ctx, client)
+	if err != nil {
+		return err
+	}
+	if holder != name {
+		klog.Infof("the VIP is owned by %q, nothing to hand off from %q", holder, name)
+		return nil
+	}
+
+	out.Styled(style.Waiting, "Moving the VIP away from node {{.node}} ...", out.V{"node": name})
+	if _, err := r.RunCmd(exec.Command("sudo", "rm", "-f", kubeVipManifest)); err != nil {
+		return fmt.Errorf("stop kube-vip: %w", err)
+	}
+	moved := func(ctx context.Context) (bool, error) {
+		holder, err := vipHolder(ctx, client)
+		if err != nil {
+			klog.Warningf("%v (will retry)", err)
+			return false, nil
+		}
+		return holder != "" && holder != name, nil
+	}
+	if err := wait.PollUntilContextTimeout(ctx, kconst.APICallRetryInterval, timeout, true, moved); err != nil {
+		return fmt.Errorf("no other control-plane node took over the VIP from %q: %w", name, err)
+	}
+	return nil
+}
+
+// upgradeKubeVip writes the kube-vip static pod manifest of an upgraded control-plane node and waits for kube-vip to run again
+func upgradeKubeVip(ctx context.Context, client kubernetes.Interface, r command.Runner, cc config.ClusterConfig, name string, timeout time.Duration) error {
+	manifest, err := kubevip.Configure(cc, r, nil, false)
+	if err != nil {
+		return fmt.Errorf("generate kube-vip manifest: %w", err)
+	}
+	if err := bsutil.CopyFiles(r, []assets.CopyableFile{assets.NewMemoryAssetTarget(manifest, kubeVipManifest, "0600")}); err != nil {
+		return fmt.Errorf("copy kube-vip manifest: %w", err)
+	}
+
+	// kube-vip runs as a static pod, which the API server shows as a mirror pod named after the node
+	pod := "kube-vip-" + name
+	running := func(ctx context.Context) (bool, error) {
+		p, err := client.CoreV1().Pods(meta.NamespaceSystem).Get(ctx, pod, meta.GetOptions{})
+		if err != nil {
+			klog.Warningf("error getting pod %q (will retry): %v", pod, err)
+			return false, nil
+		}
+		return p.Status.Phase == core.PodRunning, nil
+	}
+	if err := wait.PollUntilContextTimeout(ctx, kconst.APICallRetryInterval, timeout, true, running); err != nil {
+		return fmt.Errorf("kube-vip of node %q did not start: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coordination "k8s.io/api/coordination/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+func vipLease(holder string) *coordination.Lease {
+	return &coordination.Lease{
+		ObjectMeta: meta.ObjectMeta{Name: kubevip.LeaseName, Namespace: meta.NamespaceSystem},
+		Spec:       coordination.LeaseSpec{HolderIdentity: &holder},
+	}
+}
+
+func TestHandOffVIP(t *testing.T) {
+	ctx := context.Background()
+	stop := "sudo rm -f " + kubeVipManifest
+
+	t.Run("held by another node", func(t *testing.T) {
+		cs := fake.NewSimpleClientset(vipLease("p1-m02"))
+		// kube-vip is left alone, the fake runner fails any command
+		if err := handOffVIP(ctx, cs, command.NewFakeCommandRunner(), "p1", time.Second); err != nil {
+			t.Fatalf("handOffVIP: %v", err)
+		}
+	})
+
+	t.Run("taken over", func(t *testing.T) {
+		cs := fake.NewSimpleClientset(vipLease("p1"))
+		r := command.NewFakeCommandRunner()
+		r.SetCommandToOutput(map[string]string{stop: ""})
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			if _, err := cs.CoordinationV1().Leases(meta.NamespaceSystem).Update(ctx, vipLease("p1-m03"), meta.UpdateOptions{}); err != nil {
+				t.Errorf("update lease: %v", err)
+			}
+		}()
+		if err := handOffVIP(ctx, cs, r, "p1", 5*time.Second); err != nil {
+			t.Fatalf("handOffVIP: %v", err)
+		}
+	})
+
+	t.Run("not taken over", func(t *testing.T) {
+		cs := fake.NewSimpleClientset(vipLease("p1"))
+		r := command.NewFakeCommandRunner()
+		r.SetCommandToOutput(map[string]string{stop: ""})
+		if err := handOffVIP(ctx, cs, r, "p1", time.Second); err == nil {
+			t.Fatal("expected an error when no other control-plane node takes over the VIP")
+		}
+	})
+}
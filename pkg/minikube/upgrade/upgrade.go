@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package upgrade performs rolling kubeadm upgrades of the Kubernetes version of a cluster
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blang/semver/v4"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/libmachine"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util"
+	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
+)
+
+var (
+	// ErrUpToDate is returned when all nodes already run the requested version
+	ErrUpToDate = errors.New("the cluster already runs the requested Kubernetes version")
+	// ErrDowngrade is returned when the requested version is older than the cluster version and downgrades are not allowed
+	ErrDowngrade = errors.New("the requested Kubernetes version is older than the cluster version")
+	// ErrMinorSkew is returned when the requested version is more than one minor version away from the cluster version
+	ErrMinorSkew = errors.New("kubeadm can only change the Kubernetes version by one minor version at a time")
+)
+
+// InProgressError is returned when a previous upgrade to another version has not completed yet
+type InProgressError struct {
+	Version string
+}
+
+func (e *InProgressError) Error() string {
+	return fmt.Sprintf("an upgrade to Kubernetes %s has not completed yet", e.Version)
+}
+
+// Options are the options of a cluster upgrade
+type Options struct {
+	// Bootstrapper is the name of the bootstrapper of the cluster
+	Bootstrapper string
+	// AllowDowngrade allows moving to an older Kubernetes version
+	AllowDowngrade bool
+	// DrainTimeout is how long to wait for a node to be drained
+	DrainTimeout time.Duration
+	// NodeTimeout is how long to wait for an upgraded node to be ready
+	NodeTimeout time.Duration
+}
+
+// Validate checks that the cluster can be moved to the target Kubernetes version.
+// Once the primary control-plane node is upgraded the cluster config already has the target version,
+// nodes still on an older version mean an upgrade is in progress, which can only be resumed.
+func Validate(cc config.ClusterConfig, target string, allowDowngrade bool) error {
+	current := cc.KubernetesConfig.KubernetesVersion
+	if len(Plan(cc, current)) > 0 && target != current {
+		return &InProgressError{Version: current}
+	}
+
+	tv, err := util.ParseKubernetesVersion(target)
+	if err != nil {
+		return fmt.Errorf("parsing target version %q: %w", target, err)
+	}
+	cv, err := util.ParseKubernetesVersion(current)
+	if err != nil {
+		return fmt.Errorf("parsing cluster version %q: %w", current, err)
+	}
+	if len(Plan(cc, target)) == 0 {
+		return ErrUpToDate
+	}
+	oldest := semver.MustParse(constants.OldestKubernetesVersion[1:])
+	if tv.LT(oldest) {
+		return fmt.Errorf("kubernetes %s is older than the oldest supported version %s", target, constants.OldestKubernetesVersion)
+	}
+	if tv.Major != cv.Major || tv.Minor > cv.Minor+1 || tv.Minor+1 < cv.Minor {
+		return ErrMinorSkew
+	}
+	if tv.LT(cv) && !allowDowngrade {
+		return ErrDowngrade
+	}
+	return nil
+}
+
+// Plan returns the nodes which are not on the target version yet, in the order they have to be upgraded:
+// the primary control-plane node first, then the other control-plane nodes and finally the workers.
+func Plan(cc config.ClusterConfig, target string) []config.Node {
+	var cps, workers []config.Node
+	for _, n := range cc.Nodes {
+		switch {
+		case n.KubernetesVersion == target:
+			continue
+		case n.ControlPlane:
+			cps = append(cps, n)
+		default:
+			workers = append(workers, n)
+		}
+	}
+	return append(cps, workers...)
+}
+
+// Run upgrades the nodes of the cluster one at a time, each one is cordoned, drained, upgraded and uncordoned.
+// Progress is saved to the profile after every node, so that a failed upgrade can be resumed by running it again.
+func Run(ctx context.Context, api libmachine.API, cc *config.ClusterConfig, target string, opts Options) error {
+	nodes := Plan(*cc, target)
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		return fmt.Errorf("kubernetes client: %w", err)
+	}
+
+	for i, n := range nodes {
+		name := config.MachineName(*cc, n)
+		if n.ControlPlane {
+			register.Reg.SetStep(register.UpgradingControlPlane)
+		} else {
+			register.Reg.SetStep(register.UpgradingWorkers)
+		}
+		out.Step(style.Waiting, "Upgrading node {{.node}} from {{.from}} to {{.to}} ({{.index}}/{{.total}}) ...",
+			out.V{"node": name, "from": n.KubernetesVersion, "to": target, "index": i + 1, "total": len(nodes)})
+
+		ucc := *cc
+		ucc.KubernetesConfig.KubernetesVersion = target
+		if err := upgradeNode(ctx, api, client, ucc, n, opts); err != nil {
+			return fmt.Errorf("node %s: %w", name, err)
+		}
+
+		// the cluster moves to the new version together with its primary control-plane node
+		if config.IsPrimaryControlPlane(*cc, n) {
+			cc.KubernetesConfig.KubernetesVersion = target
+		}
+		n.KubernetesVersion = target
+		if err := config.SaveNode(cc, &n); err != nil {
+			return fmt.Errorf("save node %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func upgradeNode(ctx context.Context, api libmachine.API, client *kubernetes.Clientset, cc config.ClusterConfig, n config.Node, opts Options) error {
+	name := config.MachineName(cc, n)
+	h, err := machine.LoadHost(api, name)
+	if err != nil {
+		return fmt.Errorf("load host: %w", err)
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return fmt.Errorf("command runner: %w", err)
+	}
+	bs, err := cluster.Bootstrapper(api, opts.Bootstrapper, cc, r)
+	if err != nil {
+		return fmt.Errorf("bootstrapper: %w", err)
+	}
+
+	out.Styled(style.Stopping, "Draining node {{.node}} ...", out.V{"node": name})
+	if err := node.Cordon(ctx, client, name); err != nil {
+		return err
+	}
+	if err := node.Drain(ctx, client, name, opts.DrainTimeout); err != nil {
+		return err
+	}
+
+	ha := n.ControlPlane && config.IsHA(cc)
+	if ha {
+		if err := handOffVIP(ctx, client, r, name, opts.NodeTimeout); err != nil {
+			return err
+		}
+	}
+
+	if err := bs.UpgradeNode(ctx, cc, n, opts.AllowDowngrade); err != nil {
+		return err
+	}
+
+	if err := kverify.WaitNodeCondition(client, name, core.NodeReady, opts.NodeTimeout); err != nil {
+		return err
+	}
+	if err := waitKubeletVersion(ctx, client, name, cc.KubernetesConfig.KubernetesVersion, opts.NodeTimeout); err != nil {
+		return err
+	}
+	if ha {
+		if err := upgradeKubeVip(ctx, client, r, cc, name, opts.NodeTimeout); err != nil {
+			return err
+		}
+	}
+
+	if err := node.Uncordon(ctx, client, name); err != nil {
+		return err
+	}
+	out.Styled(style.Ready, "Node {{.node}} runs Kubernetes {{.version}}", out.V{"node": name, "version": cc.KubernetesConfig.KubernetesVersion})
+	return nil
+}
+
+// waitKubeletVersion waits for the kubelet of the node to report the version
+func waitKubeletVersion(ctx context.Context, client kubernetes.Interface, name, version string, timeout time.Duration) error {
+	klog.Infof("waiting up to %v for the kubelet of node %q to report version %s ...", timeout, name, version)
+	check := func(ctx context.Context) (bool, error) {
+		node, err := client.CoreV1().Nodes().Get(ctx, name, meta.GetOptions{})
+		if err != nil {
+			klog.Warningf("error getting node %q (will retry): %v", name, err)
+			return false, nil
+		}
+		return node.Status.NodeInfo.KubeletVersion == version, nil
+	}
+	if err := wait.PollUntilContextTimeout(ctx, kconst.APICallRetryInterval, timeout, true, check); err != nil {
+		return fmt.Errorf("kubelet of node %q did not report version %s: %w", name, version, err)
+	}
+	return nil
+}
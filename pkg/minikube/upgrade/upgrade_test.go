@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func testCluster(version string, nodeVersions ...string) config.ClusterConfig {
+	cc := config.ClusterConfig{Name: "p1", KubernetesConfig: config.KubernetesConfig{KubernetesVersion: version}}
+	names := []string{"", "m02", "m03", "m04"}
+	for i, v := range nodeVersions {
+		cc.Nodes = append(cc.Nodes, config.Node{Name: names[i], KubernetesVersion: v, ControlPlane: i < 2, Worker: i >= 2})
+	}
+	return cc
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name   string
+		cc     config.ClusterConfig
+		target string
+		want   []string
+	}{
+		{"all nodes", testCluster("v1.34.1", "v1.34.1", "v1.34.1", "v1.34.1", "v1.34.1"), "v1.35.0", []string{"", "m02", "m03", "m04"}},
+		{"resume", testCluster("v1.35.0", "v1.35.0", "v1.35.0", "v1.34.1", "v1.34.1"), "v1.35.0", []string{"m03", "m04"}},
+		{"up to date", testCluster("v1.35.0", "v1.35.0", "v1.35.0"), "v1.35.0", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, n := range Plan(tc.cc, tc.target) {
+				got = append(got, n.Name)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Plan() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cc             config.ClusterConfig
+		target         string
+		allowDowngrade bool
+		wantErr        error
+	}{
+		{"patch", testCluster("v1.34.1", "v1.34.1", "v1.34.1"), "v1.34.2", false, nil},
+		{"minor", testCluster("v1.34.1", "v1.34.1", "v1.34.1"), "v1.35.0", false, nil},
+		{"skip minor", testCluster("v1.33.1", "v1.33.1"), "v1.35.0", false, ErrMinorSkew},
+		{"up to date", testCluster("v1.35.0", "v1.35.0"), "v1.35.0", false, ErrUpToDate},
+		{"downgrade", testCluster("v1.35.0", "v1.35.0"), "v1.34.1", false, ErrDowngrade},
+		{"allowed downgrade", testCluster("v1.35.0", "v1.35.0"), "v1.34.1", true, nil},
+		{"resume", testCluster("v1.35.0", "v1.35.0", "v1.34.1"), "v1.35.0", false, nil},
+		{"in progress", testCluster("v1.35.0", "v1.35.0", "v1.34.1"), "v1.35.1", false, &InProgressError{Version: "v1.35.0"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.cc, tc.target, tc.allowDowngrade)
+			var inProgress *InProgressError
+			switch {
+			case tc.wantErr == nil:
+				if err != nil {
+					t.Errorf("Validate() = %v, want no error", err)
+				}
+			case errors.As(tc.wantErr, &inProgress):
+				var got *InProgressError
+				if !errors.As(err, &got) || got.Version != inProgress.Version {
+					t.Errorf("Validate() = %v, want %v", err, tc.wantErr)
+				}
+			case !errors.Is(err, tc.wantErr):
+				t.Errorf("Validate() = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// FileEnvVar is the name of the env variable that overrides where the file tracer writes spans
+const FileEnvVar = "MINIKUBE_TRACE_FILE"
+
+// fileSpan is the JSON representation of a span written by the file tracer
+type fileSpan struct {
+	Name         string            `json:"name"`
+	TraceID      string            `json:"traceID"`
+	SpanID       string            `json:"spanID"`
+	ParentSpanID string            `json:"parentSpanID,omitempty"`
+	Start        time.Time         `json:"start"`
+	End          time.Time         `json:"end"`
+	Duration     string            `json:"duration"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+// fileExporter writes each span as a line of JSON to a local file
+type fileExporter struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+func newFileExporter(path string) (*fileExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileExporter{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+// ExportSpans writes spans to the file, one JSON object per line
+func (e *fileExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.f == nil {
+		return nil
+	}
+	for _, s := range spans {
+		fs := fileSpan{
+			Name:     s.Name(),
+			TraceID:  s.SpanContext().TraceID().String(),
+			SpanID:   s.SpanContext().SpanID().String(),
+			Start:    s.StartTime(),
+			End:      s.EndTime(),
+			Duration: s.EndTime().Sub(s.StartTime()).String(),
+		}
+		if s.Parent().IsValid() {
+			fs.ParentSpanID = s.Parent().SpanID().String()
+		}
+		for _, kv := range s.Attributes() {
+			if fs.Attributes == nil {
+				fs.Attributes = map[string]string{}
+			}
+			fs.Attributes[string(kv.Key)] = kv.Value.Emit()
+		}
+		if err := e.enc.Encode(fs); err != nil {
+			return fmt.Errorf("writing span %s: %w", fs.Name, err)
+		}
+	}
+	return nil
+}
+
+// Shutdown closes the file
+func (e *fileExporter) Shutdown(_ context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.f == nil {
+		return nil
+	}
+	err := e.f.Close()
+	e.f = nil
+	return err
+}
+
+// initFileTracer writes spans as JSON lines to $MINIKUBE_TRACE_FILE, or to minikube's logs directory
+func initFileTracer() (*otelTracer, error) {
+	path := os.Getenv(FileEnvVar)
+	if path == "" {
+		path = filepath.Join(localpath.MiniPath(), "logs", "trace.json")
+	}
+	exporter, err := newFileExporter(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening trace file: %w", err)
+	}
+	return newOtelTracer(exporter)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileTracer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	t.Setenv(FileEnvVar, path)
+
+	tr, err := getTracer("file")
+	if err != nil {
+		t.Fatalf("getTracer: %v", err)
+	}
+	tr.StartStep("Selecting Driver")
+	ctx, node := tr.Start(context.Background(), "node.Start", String("node", "minikube"))
+	_, host := tr.Start(ctx, "machine.StartHost")
+	host.End()
+	node.End()
+	tr.Cleanup()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read trace file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d spans, want 4:\n%s", len(lines), data)
+	}
+	spans := map[string]fileSpan{}
+	for _, l := range lines {
+		var s fileSpan
+		if err := json.Unmarshal([]byte(l), &s); err != nil {
+			t.Fatalf("unmarshal %q: %v", l, err)
+		}
+		spans[s.Name] = s
+	}
+	tests := []struct {
+		child  string
+		parent string
+	}{
+		{child: "Selecting Driver", parent: parentSpanName},
+		{child: "node.Start", parent: "Selecting Driver"},
+		{child: "machine.StartHost", parent: "node.Start"},
+	}
+	for _, tc := range tests {
+		child, ok := spans[tc.child]
+		if !ok {
+			t.Fatalf("missing span %q in %v", tc.child, spans)
+		}
+		parent, ok := spans[tc.parent]
+		if !ok {
+			t.Fatalf("missing span %q in %v", tc.parent, spans)
+		}
+		if child.ParentSpanID != parent.SpanID || child.TraceID != parent.TraceID {
+			t.Errorf("span %+v is not a child of %+v", child, parent)
+		}
+	}
+	if got := spans["node.Start"].Attributes["node"]; got != "minikube" {
+		t.Errorf("node attribute = %q, want %q", got, "minikube")
+	}
+}
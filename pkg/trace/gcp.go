@@ -17,15 +17,9 @@ limitations under the License.
 package trace
 
 import (
-	"context"
 	"fmt"
 	"os"
 
-	"go.opentelemetry.io/otel"
-	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	"go.opentelemetry.io/otel/trace"
-	"k8s.io/klog/v2"
-
 	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
 )
 
@@ -37,43 +31,7 @@ const (
 	parentSpanName = "minikube start"
 )
 
-type gcpTracer struct {
-	projectID string
-	parentCtx context.Context
-	trace.Tracer
-	spans   map[string]trace.Span
-	cleanup func(context.Context) error
-}
-
-// StartSpan starts a span for the next step of
-// `minikube start` via the GCP tracer
-func (t *gcpTracer) StartSpan(name string) {
-	_, span := t.Start(t.parentCtx, name)
-	t.spans[name] = span
-}
-
-// EndSpan ends the most recent span, indicating
-// that one step of `minikube start` has completed
-func (t *gcpTracer) EndSpan(name string) {
-	span, ok := t.spans[name]
-	if !ok {
-		klog.Warningf("cannot end span %s as it was never started", name)
-		return
-	}
-	span.End()
-}
-
-func (t *gcpTracer) Cleanup() {
-	span, ok := t.spans[parentSpanName]
-	if ok {
-		span.End()
-	}
-	if err := t.cleanup(context.Background()); err != nil {
-		klog.Warningf("Fail to cleanup the trace: %s", err)
-	}
-}
-
-func initGCPTracer() (*gcpTracer, error) {
+func initGCPTracer() (*otelTracer, error) {
 	projectID := os.Getenv(ProjectEnvVar)
 	if projectID == "" {
 		return nil, fmt.Errorf("GCP tracer requires a valid GCP project id set via the %s env variable", ProjectEnvVar)
@@ -86,23 +44,5 @@ func initGCPTracer() (*gcpTracer, error) {
 		return nil, fmt.Errorf("installing pipeline: %w", err)
 	}
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
-	)
-
-	otel.SetTracerProvider(tp)
-
-	t := otel.Tracer(parentSpanName)
-
-	ctx, span := t.Start(context.Background(), parentSpanName)
-	return &gcpTracer{
-		projectID: projectID,
-		parentCtx: ctx,
-		cleanup:   tp.Shutdown,
-		Tracer:    t,
-		spans: map[string]trace.Span{
-			parentSpanName: span,
-		},
-	}, nil
+	return newOtelTracer(exporter)
 }
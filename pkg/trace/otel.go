@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/klog/v2"
+)
+
+// otelTracer records `minikube start` as a single OpenTelemetry trace and hands it to an exporter.
+// Each registered step is a child of the parent span, and spans started without a parent in their
+// context become children of the step that is running at the time.
+type otelTracer struct {
+	trace.Tracer
+	parentCtx context.Context
+	parent    trace.Span
+	cleanup   func(context.Context) error
+
+	mu      sync.Mutex
+	stepCtx context.Context
+	step    trace.Span
+}
+
+// Start starts a span as a child of the span in ctx, or of the current step
+func (t *otelTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, trace.SpanContextFromContext(t.currentStep()))
+	}
+	ctx, span := t.Tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	return ctx, &Span{span: span}
+}
+
+// StartStep ends the running step, if any, and starts the next step of `minikube start`
+func (t *otelTracer) StartStep(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.step != nil {
+		t.step.End()
+	}
+	t.stepCtx, t.step = t.Tracer.Start(t.parentCtx, name)
+}
+
+func (t *otelTracer) currentStep() context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stepCtx == nil {
+		return t.parentCtx
+	}
+	return t.stepCtx
+}
+
+// Cleanup ends the running step and the parent span, and flushes all spans to the exporter
+func (t *otelTracer) Cleanup() {
+	t.mu.Lock()
+	if t.step != nil {
+		t.step.End()
+		t.step = nil
+	}
+	t.mu.Unlock()
+	t.parent.End()
+	if err := t.cleanup(context.Background()); err != nil {
+		klog.Warningf("Fail to cleanup the trace: %s", err)
+	}
+}
+
+// newOtelTracer installs a tracer provider batching spans to exporter and starts the parent span
+func newOtelTracer(exporter sdktrace.SpanExporter) (*otelTracer, error) {
+	// service.name defaults to minikube, but can be overridden via OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES
+	res, err := resource.New(context.Background(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attribute.String("service.name", "minikube")),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+
+	t := otel.Tracer(parentSpanName)
+
+	ctx, span := t.Start(context.Background(), parentSpanName)
+	return &otelTracer{
+		Tracer:    t,
+		parentCtx: ctx,
+		parent:    span,
+		cleanup:   tp.Shutdown,
+	}, nil
+}
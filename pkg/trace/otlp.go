@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// initOTLPTracer exports spans to an OpenTelemetry collector. The endpoint, headers and
+// TLS settings are read by the exporter from the standard OTEL_EXPORTER_OTLP_* env variables.
+func initOTLPTracer() (*otelTracer, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch p := otlpProtocol(); p {
+	case "grpc":
+		exporter, err = otlptracegrpc.New(context.Background())
+	case "http/protobuf":
+		exporter, err = otlptracehttp.New(context.Background())
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, valid protocols include: [grpc http/protobuf]", p)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+	}
+	return newOtelTracer(exporter)
+}
+
+// otlpProtocol returns the transport requested via the OTEL env variables, defaulting to http/protobuf
+func otlpProtocol() string {
+	for _, env := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		if p := os.Getenv(env); p != "" {
+			return p
+		}
+	}
+	return "http/protobuf"
+}
@@ -17,7 +17,12 @@ limitations under the License.
 package trace
 
 import (
+	"context"
 	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -25,11 +30,50 @@ var (
 )
 
 type minikubeTracer interface {
-	StartSpan(string)
-	EndSpan(string)
+	Start(context.Context, string, ...Attribute) (context.Context, *Span)
+	StartStep(string)
 	Cleanup()
 }
 
+// Attribute describes a traced operation, such as the driver or node it ran against
+type Attribute = attribute.KeyValue
+
+// String returns a string valued Attribute
+func String(key, value string) Attribute {
+	return attribute.String(key, value)
+}
+
+// Span is an operation started by Start.
+// A nil Span does nothing, so callers do not need to check if tracing is enabled.
+type Span struct {
+	span trace.Span
+}
+
+// SetAttributes adds attributes to the span once they are known
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attrs...)
+}
+
+// RecordError marks the span as failed with err, if err is not nil
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End completes the span
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.span.End()
+}
+
 // Initialize initializes the global tracer variable
 func Initialize(t string) error {
 	tr, err := getTracer(t)
@@ -44,26 +88,32 @@ func getTracer(t string) (minikubeTracer, error) {
 	switch t {
 	case "gcp":
 		return initGCPTracer()
+	case "otlp":
+		return initOTLPTracer()
+	case "file":
+		return initFileTracer()
 	case "":
 		return nil, nil
 	}
-	return nil, fmt.Errorf("%s is not a valid tracer, valid tracers include: [gcp]", t)
+	return nil, fmt.Errorf("%s is not a valid tracer, valid tracers include: [gcp otlp file]", t)
 }
 
-// StartSpan starts a span with the given name
-func StartSpan(name string) {
+// Start starts a span as a child of the span carried by ctx. If ctx does not carry a span,
+// the span is a child of the current step. The returned context carries the new span,
+// and should be passed on to nested operations, including ones running in other goroutines.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
 	if tracer == nil {
-		return
+		return ctx, nil
 	}
-	tracer.StartSpan(name)
+	return tracer.Start(ctx, name, attrs...)
 }
 
-// EndSpan ends a span with the given name
-func EndSpan(name string) {
+// StartStep ends the running step and starts a span for the next step of a command
+func StartStep(name string) {
 	if tracer == nil {
 		return
 	}
-	tracer.EndSpan(name)
+	tracer.StartStep(name)
 }
 
 // Cleanup is responsible for trace related cleanup,
@@ -26,6 +26,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -71,6 +72,7 @@ func TestMultiNode(t *testing.T) {
 			{"MultiNodeLabels", validateMultiNodeLabels},
 			{"ProfileList", validateProfileListWithMultiNode},
 			{"CopyFile", validateCopyFileWithMultiNode},
+			{"NetworkPartition", validateNetworkPartition},
 			{"StopNode", validateStopRunningNode},
 			{"StartAfterStop", validateStartNodeAfterStop},
 			{"RestartKeepsNodes", validateRestartKeepsNodes},
@@ -218,6 +220,59 @@ func validateCopyFileWithMultiNode(ctx context.Context, t *testing.T, profile st
 	}
 }
 
+// validateNetworkPartition cuts the second node off from the cluster with minikube chaos, then recovers it
+func validateNetworkPartition(ctx context.Context, t *testing.T, profile string) {
+	if !DockerDriver() || runtime.GOOS != "linux" {
+		t.Skipf("skipping: network partition is only tested with the docker driver on Linux")
+	}
+
+	rr, err := Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "ip"))
+	if err != nil {
+		t.Fatalf("failed to get the control-plane IP. args %q : %v", rr.Command(), err)
+	}
+	healthz := fmt.Sprintf("curl -sk --max-time 5 https://%s:8443/healthz", strings.TrimSpace(rr.Stdout.String()))
+	reachable := func() bool {
+		_, err := Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "ssh", "-n", "m02", healthz))
+		return err == nil
+	}
+
+	if !reachable() {
+		t.Fatalf("the API server should be reachable from m02 before the partition")
+	}
+
+	rr, err = Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "chaos", "network-partition", "m02", "--alsologtostderr"))
+	if err != nil {
+		t.Fatalf("failed to partition m02. args %q : %v", rr.Command(), err)
+	}
+	if reachable() {
+		t.Errorf("the API server should not be reachable from m02 during the partition")
+	}
+
+	rr, err = Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "chaos", "list"))
+	if err != nil {
+		t.Errorf("failed to list faults. args %q : %v", rr.Command(), err)
+	}
+	if !strings.Contains(rr.Stdout.String(), "network-partition") {
+		t.Errorf("expected the partition in the fault list, got: %s", rr.Stdout.String())
+	}
+
+	rr, err = Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "audit", "--command", "chaos"))
+	if err != nil {
+		t.Errorf("failed to query the audit log. args %q : %v", rr.Command(), err)
+	}
+	if !strings.Contains(rr.Stdout.String(), "network-partition") {
+		t.Errorf("expected the partition in the audit log, got: %s", rr.Stdout.String())
+	}
+
+	rr, err = Run(t, exec.CommandContext(ctx, Target(), "-p", profile, "chaos", "recover", "m02", "--alsologtostderr"))
+	if err != nil {
+		t.Fatalf("failed to recover m02. args %q : %v", rr.Command(), err)
+	}
+	if !reachable() {
+		t.Errorf("the API server should be reachable from m02 after recovering")
+	}
+}
+
 // validateMultiNodeLabels check if all node labels were configured correctly
 func validateMultiNodeLabels(ctx context.Context, t *testing.T, profile string) {
 	// docs: Get the node labels from the cluster with `kubectl get nodes`